@@ -116,8 +116,10 @@ func GetDefaultAgentName() string {
 // An Agent receives and acts on messages from a Sensu Backend.
 type Agent struct {
 	allowList         []allowList
+	allowListMu       sync.RWMutex
 	api               *http.Server
 	assetGetter       asset.Getter
+	assetCache        assetCache
 	backendSelector   BackendSelector
 	config            *Config
 	connected         bool
@@ -131,6 +133,7 @@ type Agent struct {
 	header            http.Header
 	inProgress        map[string]*corev2.CheckConfig
 	inProgressMu      *sync.Mutex
+	lastExecuted      map[string]time.Time
 	localEntityConfig *corev3.EntityConfig
 	statsdServer      StatsdServer
 	sendq             chan *transport.Message
@@ -171,6 +174,7 @@ func NewAgentContext(ctx context.Context, config *Config) (*Agent, error) {
 		entityConfigCh:  make(chan struct{}),
 		inProgress:      make(map[string]*corev2.CheckConfig),
 		inProgressMu:    &sync.Mutex{},
+		lastExecuted:    make(map[string]time.Time),
 		sendq:           make(chan *transport.Message, 10),
 		systemInfo:      &corev2.System{},
 		unmarshal:       agentd.UnmarshalJSON,
@@ -181,6 +185,7 @@ func NewAgentContext(ctx context.Context, config *Config) (*Agent, error) {
 
 	agent.statsdServer = NewStatsdServer(agent)
 	agent.handler.AddHandler(transport.MessageTypeEntityConfig, agent.handleEntityConfig)
+	agent.handler.AddHandler(transport.MessageTypeAssetPrefetch, agent.handleAssetPrefetch)
 
 	// We don't check for errors here and let the agent get created regardless
 	// of system info status.
@@ -196,11 +201,9 @@ func NewAgentContext(ctx context.Context, config *Config) (*Agent, error) {
 		return nil, fmt.Errorf("error creating agent: %s", err)
 	}
 
-	allowList, err := readAllowList(config.AllowList, ioutil.ReadFile)
-	if err != nil {
+	if err := agent.loadAllowList(); err != nil {
 		return nil, err
 	}
-	agent.allowList = allowList
 
 	if config.PrometheusBinding != "" {
 		go func() {
@@ -345,6 +348,16 @@ func (a *Agent) Run(ctx context.Context) error {
 			trustedCAFile = a.config.TLS.TrustedCAFile
 		}
 		assetManager := asset.NewManager(a.config.CacheDir, trustedCAFile, a.getAgentEntity(), &a.wg)
+		if len(a.config.AssetsPublicKeys) > 0 {
+			verifier, err := newAssetsSignatureVerifier(a.config.AssetsPublicKeys)
+			if err != nil {
+				return err
+			}
+			assetManager.SignatureVerifier = verifier
+		}
+		assetManager.CacheMaxSize = a.config.AssetsCacheMaxSize
+		assetManager.CacheUnusedTTL = a.config.AssetsCacheUnusedTTL
+		a.assetCache = assetManager
 		limit := a.config.AssetsRateLimit
 		if limit == 0 {
 			limit = rate.Limit(asset.DefaultAssetsRateLimit)
@@ -376,6 +389,9 @@ func (a *Agent) Run(ctx context.Context) error {
 	go a.connectionManager(ctx, cancel)
 	go a.refreshSystemInfoPeriodically(ctx)
 	go a.handleAPIQueue(ctx)
+	if a.config.AllowList != "" {
+		go a.watchAllowList(ctx)
+	}
 
 	// Wait for context to complete
 	<-ctx.Done()
@@ -395,6 +411,24 @@ func (a *Agent) Run(ctx context.Context) error {
 	return nil
 }
 
+// newAssetsSignatureVerifier returns a verifier trusting the public keys found
+// at the given paths.
+func newAssetsSignatureVerifier(paths []string) (*asset.MinisignVerifier, error) {
+	publicKeys := make([]string, 0, len(paths))
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read assets public key: %s", err)
+		}
+		publicKeys = append(publicKeys, string(b))
+	}
+	verifier, err := asset.NewMinisignVerifier(publicKeys...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid assets public key: %s", err)
+	}
+	return verifier, nil
+}
+
 func (a *Agent) connectionManager(ctx context.Context, cancel context.CancelFunc) {
 	defer logger.Info("shutting down connection manager")
 	for {
@@ -1,19 +1,61 @@
 package agent
 
 import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// allowListMatchExact matches the exec and the args of an allow list entry
+	// as they are written in the command (default)
+	allowListMatchExact = "exact"
+
+	// allowListMatchGlob matches the exec and the args of an allow list entry as
+	// glob patterns, where * matches any sequence of characters and ? matches
+	// any single character, except shell metacharacters
+	allowListMatchGlob = "glob"
+
+	// allowListMatchRegex matches the exec and the args of an allow list entry
+	// as regular expressions. Commands containing shell metacharacters never
+	// match them.
+	allowListMatchRegex = "regex"
+
+	// allowListShellChars are the characters that let a command run by the
+	// shell run other commands, which the wildcards of glob patterns can't
+	// match
+	allowListShellChars = ";&|$`<>()\\\n\r"
+
+	// allowListSignatureSuffix is appended to the path of the allow list to
+	// get the path of its detached signature
+	allowListSignatureSuffix = ".sig"
+)
+
+// allowListReloadInterval is the interval at which the allow list files are
+// checked for changes
+var allowListReloadInterval = 10 * time.Second
+
 type allowList struct {
 	Exec      string   `yaml:"exec" json:"exec"`
 	Args      []string `yaml:"args" json:"args"`
 	Sha512    string   `yaml:"sha512" json:"sha512"`
 	EnableEnv bool     `yaml:"enable_env" json:"enable_env"`
+	Match     string   `yaml:"match" json:"match"`
+
+	// execPattern and argsPatterns are compiled from the exec and the args of
+	// glob and regex entries
+	execPattern  *regexp.Regexp
+	argsPatterns []*regexp.Regexp
 }
 
 func readAllowList(path string, readBytes func(string) ([]byte, error)) ([]allowList, error) {
@@ -36,9 +78,11 @@ func readAllowList(path string, readBytes func(string) ([]byte, error)) ([]allow
 			if err != nil {
 				return nil, err
 			}
-			for _, al := range allowList {
-				err = al.validate()
-				if err != nil {
+			for i := range allowList {
+				if err := allowList[i].validate(); err != nil {
+					return nil, err
+				}
+				if err := allowList[i].compile(); err != nil {
 					return nil, err
 				}
 			}
@@ -59,11 +103,223 @@ func (al *allowList) validate() error {
 		return errors.New("args cannot be empty")
 	}
 
+	switch al.Match {
+	case "", allowListMatchExact, allowListMatchGlob, allowListMatchRegex:
+	default:
+		return fmt.Errorf("match must be one of %q, %q or %q", allowListMatchExact, allowListMatchGlob, allowListMatchRegex)
+	}
+
+	return nil
+}
+
+// compile compiles the exec and the args of glob and regex entries.
+func (al *allowList) compile() error {
+	var compile func(string) (*regexp.Regexp, error)
+	switch al.Match {
+	case allowListMatchGlob:
+		compile = compileGlob
+	case allowListMatchRegex:
+		compile = compileRegex
+	default:
+		return nil
+	}
+	var err error
+	if al.execPattern, err = compile(al.Exec); err != nil {
+		return fmt.Errorf("invalid exec %q: %s", al.Exec, err)
+	}
+	al.argsPatterns = make([]*regexp.Regexp, 0, len(al.Args))
+	for _, arg := range al.Args {
+		pattern, err := compile(arg)
+		if err != nil {
+			return fmt.Errorf("invalid args %q: %s", arg, err)
+		}
+		al.argsPatterns = append(al.argsPatterns, pattern)
+	}
+	return nil
+}
+
+func compileGlob(glob string) (*regexp.Regexp, error) {
+	wildcard := "[^" + regexp.QuoteMeta(allowListShellChars) + "]"
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(wildcard + "*")
+		case '?':
+			b.WriteString(wildcard)
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
+
+func compileRegex(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + expr + ")$")
+}
+
+// matchPatterns returns whether the executable of the command matches the exec
+// pattern, and its arguments, separated by single spaces, match one of the args
+// patterns. Commands spanning several lines never match, nor do commands
+// containing shell metacharacters for regex entries.
+func (al *allowList) matchPatterns(command string) bool {
+	if strings.ContainsAny(command, "\n\r") {
+		return false
+	}
+	if al.Match == allowListMatchRegex && strings.ContainsAny(command, allowListShellChars) {
+		return false
+	}
+	fields := strings.Fields(command)
+	if len(fields) == 0 || al.execPattern == nil {
+		return false
+	}
+	if !al.execPattern.MatchString(fields[0]) {
+		return false
+	}
+	args := strings.Join(fields[1:], " ")
+	for _, pattern := range al.argsPatterns {
+		if pattern.MatchString(args) {
+			return true
+		}
+	}
+	return false
+}
+
+// readSignedAllowList reads the allow list at path after verifying its
+// detached signature, found next to it with the ".sig" suffix, against the
+// public key. The signature is not verified if no public key is given.
+func readSignedAllowList(path string, publicKey ed25519.PublicKey, readBytes func(string) ([]byte, error)) ([]allowList, error) {
+	if publicKey == nil {
+		return readAllowList(path, readBytes)
+	}
+	if path == "" {
+		return nil, errors.New("an allow list public key requires an allow list")
+	}
+	bytes, err := readBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := readBytes(path + allowListSignatureSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the allow list signature: %s", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the allow list signature: %s", err)
+	}
+	if !ed25519.Verify(publicKey, bytes, signature) {
+		return nil, errors.New("the allow list signature is invalid")
+	}
+	// Parse the verified content, rather than reading the file again
+	return readAllowList(path, func(string) ([]byte, error) {
+		return bytes, nil
+	})
+}
+
+// readAllowListPublicKey reads the base64 encoded ed25519 public key at path,
+// if any.
+func readAllowListPublicKey(path string, readBytes func(string) ([]byte, error)) (ed25519.PublicKey, error) {
+	if path == "" {
+		return nil, nil
+	}
+	encoded, err := readBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the allow list public key: %s", err)
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("the allow list public key must be %d bytes long", ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// loadAllowList reads the allow list of the agent configuration, and replaces
+// the current one if it is valid. An empty allow list, as read from a file
+// being written, never replaces a non-empty one.
+func (a *Agent) loadAllowList() error {
+	publicKey, err := readAllowListPublicKey(a.config.AllowListPublicKey, ioutil.ReadFile)
+	if err != nil {
+		return err
+	}
+	allowList, err := readSignedAllowList(a.config.AllowList, publicKey, ioutil.ReadFile)
+	if err != nil {
+		return err
+	}
+	a.allowListMu.Lock()
+	defer a.allowListMu.Unlock()
+	if len(allowList) == 0 && len(a.allowList) != 0 {
+		return errors.New("the allow list is empty")
+	}
+	a.allowList = allowList
 	return nil
 }
 
+// allowListEnabled returns whether the commands of checks and hooks must match
+// the allow list, which is the case when the agent is configured with one,
+// even if it is empty.
+func (a *Agent) allowListEnabled() bool {
+	return a.config.AllowList != ""
+}
+
+// watchAllowList reloads the allow list whenever its file or its signature
+// changes, until the context is done. The current allow list is kept when the
+// new one cannot be loaded.
+func (a *Agent) watchAllowList(ctx context.Context) {
+	stamp := allowListStamp(a.config.AllowList)
+	ticker := time.NewTicker(allowListReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		current := allowListStamp(a.config.AllowList)
+		if current == stamp {
+			continue
+		}
+		stamp = current
+		if err := a.loadAllowList(); err != nil {
+			logger.WithError(err).Error("could not reload the allow list, keeping the current one")
+			continue
+		}
+		logger.WithField("path", a.config.AllowList).Info("reloaded the allow list")
+	}
+}
+
+// allowListStamp identifies the versions of the allow list and its signature
+// by their modification times and sizes.
+func allowListStamp(path string) string {
+	var stamp strings.Builder
+	for _, p := range []string{path, path + allowListSignatureSuffix} {
+		if info, err := os.Stat(p); err == nil {
+			fmt.Fprintf(&stamp, "%d:%d", info.ModTime().UnixNano(), info.Size())
+		}
+		stamp.WriteString(";")
+	}
+	return stamp.String()
+}
+
+// getAllowList returns the current allow list of the agent.
+func (a *Agent) getAllowList() []allowList {
+	a.allowListMu.RLock()
+	defer a.allowListMu.RUnlock()
+	return a.allowList
+}
+
 func (a *Agent) matchAllowList(command string) (allowList, bool) {
-	for _, al := range a.allowList {
+	for _, al := range a.getAllowList() {
+		if al.Match == allowListMatchGlob || al.Match == allowListMatchRegex {
+			if al.matchPatterns(command) {
+				return al, true
+			}
+			continue
+		}
 		remaining := command
 		if strings.Contains(command, al.Exec) {
 			remaining = strings.Replace(remaining, al.Exec, "", -1)
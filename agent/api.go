@@ -12,6 +12,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sensu/lasr"
+	"github.com/sensu/sensu-go/asset"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/version"
@@ -48,6 +49,7 @@ func registerRoutes(a *Agent, r *mux.Router) {
 	r.HandleFunc("/events", addEvent(a)).Methods(http.MethodPost)
 	r.HandleFunc("/healthz", healthz(a.Connected)).Methods(http.MethodGet)
 	r.HandleFunc("/version", versionShow()).Methods(http.MethodGet)
+	r.HandleFunc("/assets", assetsList(a)).Methods(http.MethodGet)
 	r.Handle("/metrics", promhttp.Handler())
 }
 
@@ -85,6 +87,31 @@ func versionShow() http.HandlerFunc {
 	}
 }
 
+// assetCache lists the assets installed in the cache
+type assetCache interface {
+	CachedAssets() ([]asset.CachedAsset, error)
+}
+
+// assetsList returns the assets installed in the agent cache and their sizes
+func assetsList(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assets := []asset.CachedAsset{}
+		if a.assetCache != nil {
+			cached, err := a.assetCache.CachedAssets()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			assets = append(assets, cached...)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(assets); err != nil {
+			logger.WithError(err).Error("error encoding cached assets")
+		}
+	}
+}
+
 func (a *Agent) handleAPIQueue(ctx context.Context) {
 	if a.config.CacheDir == os.DevNull {
 		return
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/asset"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -156,3 +157,56 @@ func TestVersion(t *testing.T) {
 		})
 	}
 }
+
+type mockAssetCache struct {
+	assets []asset.CachedAsset
+}
+
+func (m *mockAssetCache) CachedAssets() ([]asset.CachedAsset, error) {
+	return m.assets, nil
+}
+
+func TestAssetsList(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cache    assetCache
+		expected []asset.CachedAsset
+	}{
+		{
+			"without assets manager",
+			nil,
+			[]asset.CachedAsset{},
+		},
+		{
+			"with cached assets",
+			&mockAssetCache{assets: []asset.CachedAsset{{Name: "foo", SHA512: "sha", Path: "/cache/sha", Size: 42}}},
+			[]asset.CachedAsset{{Name: "foo", SHA512: "sha", Path: "/cache/sha", Size: 42}},
+		},
+	}
+
+	for _, tc := range testCases {
+		testName := fmt.Sprintf("list assets %s", tc.desc)
+		t.Run(testName, func(t *testing.T) {
+			config, cleanup := FixtureConfig()
+			defer cleanup()
+			agent, err := NewAgent(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			agent.assetCache = tc.cache
+
+			r, err := http.NewRequest("GET", "/assets", nil)
+			assert.NoError(t, err)
+
+			router := mux.NewRouter()
+			registerRoutes(agent, router)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var assets []asset.CachedAsset
+			assert.NoError(t, json.NewDecoder(w.Body).Decode(&assets))
+			assert.Equal(t, tc.expected, assets)
+		})
+	}
+}
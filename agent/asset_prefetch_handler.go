@@ -0,0 +1,37 @@
+package agent
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// handleAssetPrefetch fetches and verifies the assets required by the checks
+// targeting the agent, ahead of their first execution
+func (a *Agent) handleAssetPrefetch(ctx context.Context, payload []byte) error {
+	var assets corev2.AssetList
+	if err := a.unmarshal(payload, &assets); err != nil {
+		return err
+	}
+
+	// The assets are disabled or the asset manager is not started yet
+	if a.assetGetter == nil {
+		return nil
+	}
+
+	for i := range assets.Assets {
+		prefetched := &assets.Assets[i]
+		fields := logrus.Fields{
+			"asset":     prefetched.Name,
+			"namespace": prefetched.Namespace,
+		}
+		if _, err := a.assetGetter.Get(ctx, prefetched); err != nil {
+			logger.WithFields(fields).WithError(err).Warn("could not prefetch asset")
+			continue
+		}
+		logger.WithFields(fields).Debug("prefetched asset")
+	}
+
+	return nil
+}
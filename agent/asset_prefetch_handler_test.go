@@ -0,0 +1,54 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/asset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAssetGetter struct {
+	mu      sync.Mutex
+	fetched []string
+}
+
+func (m *mockAssetGetter) Get(ctx context.Context, a *corev2.Asset) (*asset.RuntimeAsset, error) {
+	if a.Name == "invalid" {
+		return nil, errors.New("invalid asset")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fetched = append(m.fetched, a.Name)
+	return &asset.RuntimeAsset{Name: a.Name}, nil
+}
+
+func TestHandleAssetPrefetch(t *testing.T) {
+	config, cleanup := FixtureConfig()
+	defer cleanup()
+	agent, err := NewAgent(config)
+	require.NoError(t, err)
+
+	assets := &corev2.AssetList{
+		Assets: []corev2.Asset{
+			*corev2.FixtureAsset("invalid"),
+			*corev2.FixtureAsset("foo"),
+		},
+	}
+	payload, err := agent.marshal(assets)
+	require.NoError(t, err)
+
+	// The assets are ignored without asset manager
+	require.NoError(t, agent.handleAssetPrefetch(context.Background(), payload))
+
+	getter := &mockAssetGetter{}
+	agent.assetGetter = getter
+	require.NoError(t, agent.handleAssetPrefetch(context.Background(), payload))
+	assert.Equal(t, []string{"foo"}, getter.fetched)
+
+	assert.Error(t, agent.handleAssetPrefetch(context.Background(), []byte("invalid")))
+}
@@ -66,9 +66,28 @@ func (a *Agent) handleCheck(ctx context.Context, payload []byte) error {
 		return nil
 	}
 
+	entity := a.getAgentEntity()
+
+	// Merge the check overrides that select the agent entity
+	interval := checkConfig.Interval
+	applied, err := corev2.ApplyCheckOverrides(checkConfig, request.Overrides, entity)
+	if err != nil {
+		sendFailure(fmt.Errorf("error while applying check overrides: %s", err))
+		return nil
+	}
+	if len(applied) > 0 {
+		logger.WithFields(logrus.Fields{
+			"check":     checkConfig.Name,
+			"overrides": applied,
+		}).Debug("applied check overrides")
+	}
+	if checkConfig.Cron == "" && checkConfig.Interval > interval && !a.checkIntervalElapsed(request, interval) {
+		logger.Debug("skipping check execution until its overridden interval elapses: ", checkConfig.Name)
+		return nil
+	}
+
 	logger.Info("scheduling check execution: ", checkConfig.Name)
 
-	entity := a.getAgentEntity()
 	go a.executeCheck(ctx, request, entity)
 
 	return nil
@@ -86,6 +105,39 @@ func (a *Agent) checkInProgress(req *corev2.CheckRequest) bool {
 	return ok
 }
 
+// checkIntervalElapsed returns whether the interval of the check, overridden
+// from the given scheduling interval, elapsed since its last execution, in
+// which case the execution is recorded. Half of the scheduling interval is
+// tolerated, to absorb the jitter of the check requests.
+func (a *Agent) checkIntervalElapsed(req *corev2.CheckRequest, scheduled uint32) bool {
+	a.inProgressMu.Lock()
+	defer a.inProgressMu.Unlock()
+	key := checkKey(req)
+	now := time.Now()
+	interval := time.Duration(req.Config.Interval)*time.Second - time.Duration(scheduled)*time.Second/2
+	if last, ok := a.lastExecuted[key]; ok && now.Sub(last) < interval {
+		return false
+	}
+	a.lastExecuted[key] = now
+	return true
+}
+
+// checkSandbox returns the sandbox of the check command execution, if the check
+// has one
+func checkSandbox(check *corev2.CheckConfig) *command.Sandbox {
+	if check.Sandbox == nil {
+		return nil
+	}
+	return &command.Sandbox{
+		CPUTime:   check.Sandbox.CPUTime,
+		Memory:    check.Sandbox.Memory,
+		OpenFiles: check.Sandbox.OpenFiles,
+		User:      check.Sandbox.User,
+		Group:     check.Sandbox.Group,
+		Cgroup:    check.Sandbox.Cgroup,
+	}
+}
+
 func checkKey(request *corev2.CheckRequest) string {
 	parts := []string{request.Config.Name}
 	if len(request.Config.ProxyEntityName) > 0 {
@@ -160,7 +212,7 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 	// Match check against allow list
 	var matchedEntry allowList
 	var match bool
-	if len(a.allowList) != 0 {
+	if a.allowListEnabled() {
 		logger.WithFields(fields).Debug("matching check against agent allow list")
 		matchedEntry, match = a.matchAllowList(checkConfig.Command)
 		if !match {
@@ -178,7 +230,9 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 	} else {
 		logger.WithFields(fields).Debug("fetching assets for check")
 		var err error
-		assets, err = asset.GetAll(ctx, a.assetGetter, checkAssets)
+		// The secrets of the check provide the credentials of the asset stores
+		assetsCtx := asset.ContextWithSecrets(ctx, secrets)
+		assets, err = asset.GetAll(assetsCtx, a.assetGetter, checkAssets)
 		if err != nil {
 			a.sendFailure(event, fmt.Errorf("error getting assets for check: %s", err))
 			return
@@ -226,6 +280,7 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 		InProgress:   a.inProgress,
 		InProgressMu: a.inProgressMu,
 		Name:         checkConfig.Name,
+		Sandbox:      checkSandbox(checkConfig),
 	}
 
 	// If stdin is true, add JSON event data to command execution.
@@ -53,6 +53,47 @@ func TestHandleCheck(t *testing.T) {
 	assert.NoError(agent.handleCheck(context.TODO(), payload))
 }
 
+func TestHandleCheckOverrides(t *testing.T) {
+	checkConfig := corev2.FixtureCheckConfig("check")
+	override := corev2.FixtureCheckOverride("override", "check")
+	override.Interval = checkConfig.Interval * 2
+
+	request := &corev2.CheckRequest{
+		Config:    checkConfig,
+		Overrides: []corev2.CheckOverride{*override},
+		Issued:    time.Now().Unix(),
+	}
+	payload, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	config, cleanup := FixtureConfig()
+	defer cleanup()
+	agent, err := NewAgent(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ex := &mockexecutor.MockExecutor{}
+	agent.executor = ex
+	ex.Return(command.FixtureExecutionResponse(0, ""), nil)
+	agent.sendq = make(chan *transport.Message, 5)
+
+	// the first request executes the check and records its execution
+	require.NoError(t, agent.handleCheck(context.TODO(), payload))
+	agent.inProgressMu.Lock()
+	executed, ok := agent.lastExecuted[checkKey(request)]
+	agent.inProgressMu.Unlock()
+	require.True(t, ok)
+	require.Eventually(t, func() bool {
+		return !agent.checkInProgress(request)
+	}, time.Second, 10*time.Millisecond)
+
+	// the overridden interval did not elapse, the check is skipped
+	require.NoError(t, agent.handleCheck(context.TODO(), payload))
+	agent.inProgressMu.Lock()
+	assert.Equal(t, executed, agent.lastExecuted[checkKey(request)])
+	agent.inProgressMu.Unlock()
+}
+
 func TestCheckInProgress_GH2704(t *testing.T) {
 	assert := assert.New(t)
 
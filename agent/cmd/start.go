@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/sensu/sensu-go/agent"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/asset"
@@ -39,6 +40,9 @@ const (
 	flagAPIPort                  = "api-port"
 	flagAssetsRateLimit          = "assets-rate-limit"
 	flagAssetsBurstLimit         = "assets-burst-limit"
+	flagAssetsPublicKeys         = "assets-public-keys"
+	flagAssetsCacheMaxSize       = "assets-cache-max-size"
+	flagAssetsCacheUnusedTTL     = "assets-cache-unused-ttl"
 	flagBackendURL               = "backend-url"
 	flagCacheDir                 = "cache-dir"
 	flagConfigFile               = "config-file"
@@ -70,6 +74,7 @@ const (
 	flagLabels                   = "labels"
 	flagAnnotations              = "annotations"
 	flagAllowList                = "allow-list"
+	flagAllowListPublicKey       = "allow-list-public-key"
 	flagBackendHandshakeTimeout  = "backend-handshake-timeout"
 	flagBackendHeartbeatInterval = "backend-heartbeat-interval"
 	flagBackendHeartbeatTimeout  = "backend-heartbeat-timeout"
@@ -110,6 +115,8 @@ func NewAgentConfig(cmd *cobra.Command) (*agent.Config, error) {
 	cfg.API.Port = viper.GetInt(flagAPIPort)
 	cfg.AssetsRateLimit = rate.Limit(viper.GetFloat64(flagAssetsRateLimit))
 	cfg.AssetsBurstLimit = viper.GetInt(flagAssetsBurstLimit)
+	cfg.AssetsPublicKeys = viper.GetStringSlice(flagAssetsPublicKeys)
+	cfg.AssetsCacheUnusedTTL = viper.GetDuration(flagAssetsCacheUnusedTTL)
 	cfg.CacheDir = viper.GetString(flagCacheDir)
 	cfg.Deregister = viper.GetBool(flagDeregister)
 	cfg.DeregistrationHandler = viper.GetString(flagDeregistrationHandler)
@@ -132,6 +139,7 @@ func NewAgentConfig(cmd *cobra.Command) (*agent.Config, error) {
 	cfg.StatsdServer.Handlers = viper.GetStringSlice(flagStatsdEventHandlers)
 	cfg.User = viper.GetString(flagUser)
 	cfg.AllowList = viper.GetString(flagAllowList)
+	cfg.AllowListPublicKey = viper.GetString(flagAllowListPublicKey)
 	cfg.BackendHandshakeTimeout = viper.GetInt(flagBackendHandshakeTimeout)
 	cfg.BackendHeartbeatInterval = viper.GetInt(flagBackendHeartbeatInterval)
 	cfg.BackendHeartbeatTimeout = viper.GetInt(flagBackendHeartbeatTimeout)
@@ -156,6 +164,14 @@ func NewAgentConfig(cmd *cobra.Command) (*agent.Config, error) {
 			flagKeepaliveCriticalTimeout, flagKeepaliveWarningTimeout)
 	}
 
+	if maxSize := viper.GetString(flagAssetsCacheMaxSize); maxSize != "" {
+		size, err := humanize.ParseBytes(maxSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %s", flagAssetsCacheMaxSize, err)
+		}
+		cfg.AssetsCacheMaxSize = int64(size)
+	}
+
 	agentName := viper.GetString(flagAgentName)
 	if agentName != "" {
 		cfg.AgentName = agentName
@@ -405,6 +421,9 @@ func flagSet() *pflag.FlagSet {
 	flagSet.Bool(flagDetectCloudProvider, viper.GetBool(flagDetectCloudProvider), "enable cloud provider detection")
 	flagSet.Float64(flagAssetsRateLimit, viper.GetFloat64(flagAssetsRateLimit), "maximum number of assets fetched per second")
 	flagSet.Int(flagAssetsBurstLimit, viper.GetInt(flagAssetsBurstLimit), "asset fetch burst limit")
+	flagSet.StringSlice(flagAssetsPublicKeys, viper.GetStringSlice(flagAssetsPublicKeys), "comma-delimited list of paths to the minisign public keys trusted to sign assets, which must then be signed. This flag can also be invoked multiple times")
+	flagSet.String(flagAssetsCacheMaxSize, viper.GetString(flagAssetsCacheMaxSize), "maximum size of the assets cache (e.g. 10GB), above which the least recently used assets are removed")
+	flagSet.Duration(flagAssetsCacheUnusedTTL, viper.GetDuration(flagAssetsCacheUnusedTTL), "duration after which the assets not used by any check are removed from the cache")
 	flagSet.Float64(flagEventsRateLimit, viper.GetFloat64(flagEventsRateLimit), "maximum number of events transmitted to the backend through the /events api")
 	flagSet.Int(flagEventsBurstLimit, viper.GetInt(flagEventsBurstLimit), "/events api burst limit")
 	flagSet.String(flagNamespace, viper.GetString(flagNamespace), "agent namespace")
@@ -434,6 +453,7 @@ func flagSet() *pflag.FlagSet {
 	flagSet.StringToStringVar(&labels, flagLabels, nil, "entity labels map")
 	flagSet.StringToStringVar(&annotations, flagAnnotations, nil, "entity annotations map")
 	flagSet.String(flagAllowList, viper.GetString(flagAllowList), "path to agent execution allow list configuration file")
+	flagSet.String(flagAllowListPublicKey, viper.GetString(flagAllowListPublicKey), "path to the ed25519 public key verifying the allow list signature")
 	flagSet.Int(flagBackendHandshakeTimeout, viper.GetInt(flagBackendHandshakeTimeout), "number of seconds the agent should wait when negotiating a new WebSocket connection")
 	flagSet.Int(flagBackendHeartbeatInterval, viper.GetInt(flagBackendHeartbeatInterval), "interval at which the agent should send heartbeats to the backend")
 	flagSet.Int(flagBackendHeartbeatTimeout, viper.GetInt(flagBackendHeartbeatTimeout), "number of seconds the agent should wait for a response to a hearbeat")
@@ -80,6 +80,10 @@ type Config struct {
 	// AllowList is the path to agent execution allow list configuration file.
 	AllowList string
 
+	// AllowListPublicKey is the path to the base64 encoded ed25519 public key
+	// verifying the detached signature of the allow list.
+	AllowListPublicKey string
+
 	// API contains the Sensu client HTTP API configuration
 	API *APIConfig
 
@@ -89,6 +93,18 @@ type Config struct {
 	// AssetsBurstLimit is the maximum amount of burst allowed in a rate interval.
 	AssetsBurstLimit int
 
+	// AssetsPublicKeys is the list of paths to the minisign public keys
+	// trusted to sign assets. Assets must be signed when it is not empty.
+	AssetsPublicKeys []string
+
+	// AssetsCacheMaxSize is the maximum size of the assets cache, in bytes.
+	// The least recently used assets are removed above it. Zero means no limit.
+	AssetsCacheMaxSize int64
+
+	// AssetsCacheUnusedTTL is the duration after which the assets not used by
+	// any check are removed from the cache. Zero means they are kept.
+	AssetsCacheUnusedTTL time.Duration
+
 	// BackendURLs is a list of URLs for the Sensu Backend. Default:
 	// ws://127.0.0.1:8081
 	BackendURLs []string
@@ -81,7 +81,7 @@ func (a *Agent) executeHook(ctx context.Context, hookConfig *corev2.HookConfig,
 	// Match check against allow list
 	var matchedEntry allowList
 	var match bool
-	if len(a.allowList) != 0 {
+	if a.allowListEnabled() {
 		logger.WithFields(fields).Debug("matching hook against agent allow list")
 		matchedEntry, match = a.matchAllowList(hookConfig.Command)
 		if !match {
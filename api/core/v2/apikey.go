@@ -2,8 +2,10 @@ package v2
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/google/uuid"
 	stringsutil "github.com/sensu/sensu-go/api/core/v2/internal/stringutil"
@@ -39,9 +41,92 @@ func (a *APIKey) Validate() error {
 		return fmt.Errorf("api key name: %s", err)
 	}
 
+	if a.ExpiresAt < 0 {
+		return fmt.Errorf("api key expiration cannot be negative")
+	}
+
+	for i := range a.Rules {
+		// Split the verbs, resources and resource names
+		a.Rules[i].Verbs = split(a.Rules[i].Verbs)
+		a.Rules[i].Resources = split(a.Rules[i].Resources)
+
+		// Validate the verbs
+		if err := validateVerbs(a.Rules[i].Verbs); err != nil {
+			return fmt.Errorf("api key rule: %s", err)
+		}
+	}
+
+	for _, cidr := range a.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("api key allowed cidr: %s", err)
+		}
+	}
+
 	return nil
 }
 
+// IsExpired returns true if the API key has an expiration time and it has
+// been reached at the given time.
+func (a *APIKey) IsExpired(now time.Time) bool {
+	return a.ExpiresAt > 0 && now.Unix() >= a.ExpiresAt
+}
+
+// AddressAllowed returns true if the API key can be used from the given IP
+// address. Any address is allowed when the API key has no allowed CIDRs.
+func (a *APIKey) AddressAllowed(ip net.IP) bool {
+	if len(a.AllowedCIDRs) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range a.AllowedCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Permits returns true if the scope of the API key allows the given verb on
+// the given resource within the given namespace. An API key without
+// namespaces or rules is not restricted by them; its user permissions still
+// apply.
+func (a *APIKey) Permits(namespace, resource, resourceName, verb string) bool {
+	if len(a.Namespaces) > 0 {
+		if namespace == "" {
+			// Requests spanning all namespaces, and requests on cluster-wide
+			// resources such as api keys, users or cluster role bindings,
+			// would escape the namespaces of the key. Only reading the
+			// namespaces themselves is permitted.
+			if resource != NamespacesResource || (verb != "get" && verb != "list") {
+				return false
+			}
+		} else if !stringsutil.InArray(namespace, a.Namespaces) {
+			return false
+		}
+	}
+	if len(a.Rules) == 0 {
+		return true
+	}
+	for _, rule := range a.Rules {
+		if rule.VerbMatches(verb) && rule.ResourceMatches(resource) && rule.ResourceNameMatches(resourceName) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsScoped returns true if the API key is restricted beyond the permissions
+// of its user, by namespaces, rules, an expiration time or allowed CIDRs.
+func (a *APIKey) IsScoped() bool {
+	return len(a.Namespaces) > 0 || len(a.Rules) > 0 || a.ExpiresAt > 0 || len(a.AllowedCIDRs) > 0
+}
+
 // FixtureAPIKey returns a testing fixture for an APIKey struct.
 func FixtureAPIKey(name string, username string) *APIKey {
 	return &APIKey{
@@ -33,7 +33,23 @@ type APIKey struct {
 	// Username is the username associated with the API key.
 	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	// CreatedAt is a timestamp which the API key was created.
-	CreatedAt            int64    `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ExpiresAt is a timestamp after which the API key is no longer valid. A
+	// value of zero means the API key never expires.
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Namespaces restricts the API key to the listed namespaces. An empty list
+	// means the API key is not restricted to any namespace.
+	Namespaces []string `protobuf:"bytes,5,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Rules restricts the verbs, resources and resource names the API key can
+	// be used for. An empty list means the API key carries all of the
+	// permissions of its user.
+	Rules []Rule `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"`
+	// AllowedCIDRs restricts the source addresses from which the API key can be
+	// used. An empty list means the API key can be used from any address.
+	AllowedCIDRs []string `protobuf:"bytes,7,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	// LastUsedAt is a timestamp at which the API key was last used to
+	// authenticate a request.
+	LastUsedAt           int64    `protobuf:"varint,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -81,26 +97,34 @@ func init() {
 }
 
 var fileDescriptor_c805b5e2d9435d9b = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4a, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0x4e, 0xcd, 0x2b, 0x2e, 0x85, 0x90, 0xba, 0xe9,
-	0xf9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0xc9, 0xf9, 0x45, 0xa9, 0xfa, 0x65, 0x46, 0x20, 0x76, 0x76,
-	0x6a, 0xa5, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x2f, 0x58, 0x89, 0x1e, 0x48, 0x4e, 0xaf,
-	0xcc, 0x48, 0xca, 0x04, 0xc9, 0x88, 0xf4, 0xfc, 0xf4, 0x7c, 0x7d, 0xb0, 0xaa, 0xa4, 0xd2, 0x34,
-	0x87, 0x32, 0x43, 0x3d, 0x63, 0x3d, 0x43, 0xb0, 0x20, 0x58, 0x0c, 0xcc, 0x82, 0x18, 0x22, 0x65,
-	0x40, 0x9c, 0xc5, 0xb9, 0xa9, 0x25, 0x89, 0x10, 0x1d, 0x4a, 0x4b, 0x18, 0xb9, 0xd8, 0x1c, 0x03,
-	0x3c, 0xbd, 0x53, 0x2b, 0x85, 0x42, 0xb9, 0x38, 0x40, 0x12, 0x29, 0x89, 0x25, 0x89, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x92, 0x7a, 0x28, 0x8e, 0xd2, 0xf3, 0x4f, 0xca, 0x4a, 0x4d, 0x2e,
-	0xf1, 0x4d, 0x2d, 0x49, 0x74, 0x92, 0x3b, 0x71, 0x4f, 0x9e, 0xe1, 0xc2, 0x3d, 0x79, 0xc6, 0x57,
-	0xf7, 0xe4, 0x85, 0x60, 0xda, 0x74, 0xf2, 0x73, 0x33, 0x4b, 0x52, 0x73, 0x0b, 0x4a, 0x2a, 0x83,
-	0xe0, 0x46, 0x09, 0x49, 0x71, 0x71, 0x94, 0x16, 0xa7, 0x16, 0xe5, 0x25, 0xe6, 0xa6, 0x4a, 0x30,
-	0x29, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xf9, 0x42, 0xb2, 0x5c, 0x5c, 0xc9, 0x45, 0xa9, 0x89, 0x25,
-	0xa9, 0x29, 0xf1, 0x89, 0x25, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x9c, 0x50, 0x11, 0xc7,
-	0x12, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x2b, 0x16, 0xc8, 0x33, 0x3a, 0x29, 0xfc, 0x78, 0x28,
-	0xc7, 0xb8, 0xe2, 0x91, 0x1c, 0xe3, 0x8e, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x51, 0x4c, 0x65, 0x46, 0x49,
-	0x6c, 0x60, 0xff, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x05, 0x2e, 0x64, 0x7c, 0x01,
-	0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xbf, 0x6e, 0xd4, 0x30,
+	0x18, 0x3f, 0x37, 0xf4, 0xb8, 0x73, 0xaf, 0x02, 0x99, 0x81, 0x70, 0x12, 0x4e, 0xc4, 0x94, 0x01,
+	0x1c, 0x9a, 0xc2, 0xc2, 0xc4, 0xa5, 0x2c, 0x15, 0x42, 0xa0, 0x48, 0x5d, 0x58, 0x4e, 0x4e, 0xee,
+	0xe3, 0x08, 0x24, 0x75, 0x64, 0x3b, 0x81, 0xdb, 0x18, 0x79, 0x04, 0xc6, 0x8e, 0x7d, 0x04, 0x1e,
+	0xa1, 0x63, 0x9f, 0x20, 0x82, 0xb0, 0xf5, 0x09, 0x18, 0x91, 0x9d, 0xe3, 0x74, 0x30, 0xc1, 0x62,
+	0x7d, 0xfe, 0xfd, 0xfb, 0xfc, 0x93, 0x71, 0xb4, 0xcc, 0xf5, 0xdb, 0x3a, 0x65, 0x99, 0x28, 0x43,
+	0x05, 0xa7, 0xaa, 0xee, 0xcf, 0x07, 0x4b, 0x11, 0xf2, 0x2a, 0x0f, 0x33, 0x21, 0x21, 0x6c, 0x22,
+	0x33, 0xbf, 0x87, 0x15, 0xab, 0xa4, 0xd0, 0x82, 0xec, 0x5b, 0x09, 0x33, 0x1c, 0x6b, 0xa2, 0xe9,
+	0xa3, 0xad, 0x88, 0xa5, 0x58, 0x8a, 0xd0, 0xaa, 0xd2, 0xfa, 0xcd, 0xd3, 0xe6, 0x80, 0x1d, 0xb2,
+	0x03, 0x0b, 0x5a, 0xcc, 0x4e, 0x7d, 0xc8, 0xf4, 0xe1, 0xbf, 0x2d, 0x2e, 0x41, 0xf3, 0xff, 0x73,
+	0xc8, 0x94, 0x67, 0xbd, 0xe3, 0xde, 0x27, 0x07, 0x0f, 0x67, 0xaf, 0x8e, 0x9f, 0xc3, 0x8a, 0x9c,
+	0xe0, 0x91, 0x89, 0x5a, 0x70, 0xcd, 0x5d, 0xe4, 0xa3, 0x60, 0x2f, 0xba, 0xc3, 0xfe, 0xa8, 0xc1,
+	0x5e, 0xa6, 0xef, 0x20, 0xd3, 0x2f, 0x40, 0xf3, 0x98, 0x5e, 0xb4, 0xde, 0xe0, 0xb2, 0xf5, 0xd0,
+	0x55, 0xeb, 0x91, 0xdf, 0xb6, 0xfb, 0xa2, 0xcc, 0x35, 0x94, 0x95, 0x5e, 0x25, 0x9b, 0x28, 0x32,
+	0xc5, 0xa3, 0x5a, 0x81, 0x3c, 0xe5, 0x25, 0xb8, 0x3b, 0x3e, 0x0a, 0xc6, 0xc9, 0xe6, 0x4e, 0xee,
+	0x62, 0x9c, 0x49, 0xe0, 0x1a, 0x16, 0x73, 0xae, 0x5d, 0xc7, 0x47, 0x81, 0x93, 0x8c, 0xd7, 0xc8,
+	0x4c, 0x1b, 0x1a, 0x3e, 0x56, 0xb9, 0x04, 0x65, 0xe8, 0x6b, 0x3d, 0xbd, 0x46, 0x66, 0x9a, 0x50,
+	0x8c, 0x4d, 0x8a, 0xaa, 0x78, 0x06, 0xca, 0xdd, 0xf5, 0x9d, 0x60, 0x9c, 0x6c, 0x21, 0x24, 0xc6,
+	0xbb, 0xb2, 0x2e, 0x40, 0xb9, 0x43, 0xdf, 0x09, 0xf6, 0xa2, 0x5b, 0x7f, 0xb5, 0x49, 0xea, 0x02,
+	0xe2, 0xdb, 0xa6, 0xc7, 0x55, 0xeb, 0xdd, 0xb0, 0xca, 0xad, 0x02, 0xbd, 0x95, 0x3c, 0xc6, 0xfb,
+	0xbc, 0x28, 0xc4, 0x07, 0x58, 0xcc, 0xb3, 0x7c, 0x21, 0x95, 0x7b, 0xdd, 0xac, 0x89, 0x6f, 0x76,
+	0xad, 0x37, 0x99, 0xf5, 0xc4, 0xd1, 0xf1, 0xb3, 0x44, 0x25, 0x93, 0xb5, 0xec, 0xc8, 0xa8, 0x88,
+	0x8f, 0x27, 0x05, 0x57, 0x7a, 0x5e, 0xab, 0xbe, 0xda, 0xc8, 0xbe, 0x1d, 0x1b, 0xec, 0x44, 0x99,
+	0x6e, 0x4f, 0x46, 0x9f, 0xcf, 0xbc, 0xc1, 0xf9, 0x99, 0x87, 0x62, 0xff, 0xe7, 0x77, 0x8a, 0xce,
+	0x3b, 0x8a, 0xbe, 0x76, 0x14, 0x5d, 0x74, 0x14, 0x5d, 0x76, 0x14, 0x7d, 0xeb, 0x28, 0xfa, 0xf2,
+	0x83, 0x0e, 0x5e, 0xef, 0x34, 0x51, 0x3a, 0xb4, 0x7f, 0x75, 0xf8, 0x6b, 0x00, 0x42, 0xb9, 0xf5,
+	0x63, 0x8a, 0x02, 0x00, 0x00,
 }
 
 func (this *APIKey) Equal(that interface{}) bool {
@@ -131,6 +155,36 @@ func (this *APIKey) Equal(that interface{}) bool {
 	if this.CreatedAt != that1.CreatedAt {
 		return false
 	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if len(this.Rules) != len(that1.Rules) {
+		return false
+	}
+	for i := range this.Rules {
+		if !this.Rules[i].Equal(&that1.Rules[i]) {
+			return false
+		}
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
+	if this.LastUsedAt != that1.LastUsedAt {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -142,6 +196,11 @@ type APIKeyFace interface {
 	GetObjectMeta() ObjectMeta
 	GetUsername() string
 	GetCreatedAt() int64
+	GetExpiresAt() int64
+	GetNamespaces() []string
+	GetRules() []Rule
+	GetAllowedCIDRs() []string
+	GetLastUsedAt() int64
 }
 
 func (this *APIKey) Proto() github_com_golang_protobuf_proto.Message {
@@ -164,11 +223,36 @@ func (this *APIKey) GetCreatedAt() int64 {
 	return this.CreatedAt
 }
 
+func (this *APIKey) GetExpiresAt() int64 {
+	return this.ExpiresAt
+}
+
+func (this *APIKey) GetNamespaces() []string {
+	return this.Namespaces
+}
+
+func (this *APIKey) GetRules() []Rule {
+	return this.Rules
+}
+
+func (this *APIKey) GetAllowedCIDRs() []string {
+	return this.AllowedCIDRs
+}
+
+func (this *APIKey) GetLastUsedAt() int64 {
+	return this.LastUsedAt
+}
+
 func NewAPIKeyFromFace(that APIKeyFace) *APIKey {
 	this := &APIKey{}
 	this.ObjectMeta = that.GetObjectMeta()
 	this.Username = that.GetUsername()
 	this.CreatedAt = that.GetCreatedAt()
+	this.ExpiresAt = that.GetExpiresAt()
+	this.Namespaces = that.GetNamespaces()
+	this.Rules = that.GetRules()
+	this.AllowedCIDRs = that.GetAllowedCIDRs()
+	this.LastUsedAt = that.GetLastUsedAt()
 	return this
 }
 
@@ -196,6 +280,48 @@ func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LastUsedAt != 0 {
+		i = encodeVarintApikey(dAtA, i, uint64(m.LastUsedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for iNdEx := len(m.AllowedCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCIDRs[iNdEx])
+			copy(dAtA[i:], m.AllowedCIDRs[iNdEx])
+			i = encodeVarintApikey(dAtA, i, uint64(len(m.AllowedCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApikey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintApikey(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintApikey(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.CreatedAt != 0 {
 		i = encodeVarintApikey(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -241,8 +367,34 @@ func NewPopulatedAPIKey(r randyApikey, easy bool) *APIKey {
 	if r.Intn(2) == 0 {
 		this.CreatedAt *= -1
 	}
+	this.ExpiresAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ExpiresAt *= -1
+	}
+	v2 := r.Intn(10)
+	this.Namespaces = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Namespaces[i] = string(randStringApikey(r))
+	}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(5)
+		this.Rules = make([]Rule, v3)
+		for i := 0; i < v3; i++ {
+			v4 := NewPopulatedRule(r, easy)
+			this.Rules[i] = *v4
+		}
+	}
+	v5 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v5)
+	for i := 0; i < v5; i++ {
+		this.AllowedCIDRs[i] = string(randStringApikey(r))
+	}
+	this.LastUsedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastUsedAt *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedApikey(r, 4)
+		this.XXX_unrecognized = randUnrecognizedApikey(r, 9)
 	}
 	return this
 }
@@ -266,9 +418,9 @@ func randUTF8RuneApikey(r randyApikey) rune {
 	return rune(ru + 61)
 }
 func randStringApikey(r randyApikey) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
 		tmps[i] = randUTF8RuneApikey(r)
 	}
 	return string(tmps)
@@ -290,11 +442,11 @@ func randFieldApikey(dAtA []byte, r randyApikey, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApikey(dAtA, uint64(key))
-		v3 := r.Int63()
+		v7 := r.Int63()
 		if r.Intn(2) == 0 {
-			v3 *= -1
+			v7 *= -1
 		}
-		dAtA = encodeVarintPopulateApikey(dAtA, uint64(v3))
+		dAtA = encodeVarintPopulateApikey(dAtA, uint64(v7))
 	case 1:
 		dAtA = encodeVarintPopulateApikey(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -334,6 +486,30 @@ func (m *APIKey) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovApikey(uint64(m.CreatedAt))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovApikey(uint64(m.ExpiresAt))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovApikey(uint64(l))
+		}
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovApikey(uint64(l))
+		}
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovApikey(uint64(l))
+		}
+	}
+	if m.LastUsedAt != 0 {
+		n += 1 + sovApikey(uint64(m.LastUsedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -459,6 +635,142 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApikey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, Rule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			m.LastUsedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApikey(dAtA[iNdEx:])
@@ -1,8 +1,10 @@
 package v2
 
 import (
+	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -80,3 +82,174 @@ func TestAPIKeyFields(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIKeyValidateScope(t *testing.T) {
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+
+	a.ExpiresAt = -1
+	assert.Error(t, a.Validate())
+	a.ExpiresAt = 0
+
+	a.Rules = []Rule{{Verbs: []string{"foo"}, Resources: []string{"checks"}}}
+	assert.Error(t, a.Validate())
+	a.Rules = []Rule{{Verbs: []string{"get,list"}, Resources: []string{"checks"}}}
+	assert.NoError(t, a.Validate())
+	assert.Equal(t, []string{"get", "list"}, a.Rules[0].Verbs)
+
+	a.AllowedCIDRs = []string{"10.0.0.1"}
+	assert.Error(t, a.Validate())
+	a.AllowedCIDRs = []string{"10.0.0.0/8"}
+	assert.NoError(t, a.Validate())
+}
+
+func TestAPIKeyIsExpired(t *testing.T) {
+	now := time.Now()
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	assert.False(t, a.IsExpired(now))
+
+	a.ExpiresAt = now.Add(time.Hour).Unix()
+	assert.False(t, a.IsExpired(now))
+
+	a.ExpiresAt = now.Add(-time.Hour).Unix()
+	assert.True(t, a.IsExpired(now))
+}
+
+func TestAPIKeyAddressAllowed(t *testing.T) {
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	assert.True(t, a.AddressAllowed(net.ParseIP("192.168.1.1")))
+
+	a.AllowedCIDRs = []string{"10.0.0.0/8", "127.0.0.1/32"}
+	assert.True(t, a.AddressAllowed(net.ParseIP("10.1.2.3")))
+	assert.True(t, a.AddressAllowed(net.ParseIP("127.0.0.1")))
+	assert.False(t, a.AddressAllowed(net.ParseIP("192.168.1.1")))
+	assert.False(t, a.AddressAllowed(nil))
+}
+
+func TestAPIKeyPermits(t *testing.T) {
+	tests := []struct {
+		name         string
+		namespaces   []string
+		rules        []Rule
+		namespace    string
+		resource     string
+		resourceName string
+		verb         string
+		want         bool
+	}{
+		{
+			name:      "unrestricted key",
+			namespace: "default",
+			resource:  "checks",
+			verb:      "delete",
+			want:      true,
+		},
+		{
+			name:       "allowed namespace",
+			namespaces: []string{"dev"},
+			namespace:  "dev",
+			resource:   "checks",
+			verb:       "get",
+			want:       true,
+		},
+		{
+			name:       "forbidden namespace",
+			namespaces: []string{"dev"},
+			namespace:  "default",
+			resource:   "checks",
+			verb:       "get",
+			want:       false,
+		},
+		{
+			name:       "all namespaces of a namespaced resource",
+			namespaces: []string{"dev"},
+			resource:   "events",
+			verb:       "list",
+			want:       false,
+		},
+		{
+			name:       "cluster-wide resource",
+			namespaces: []string{"dev"},
+			resource:   "namespaces",
+			verb:       "list",
+			want:       true,
+		},
+		{
+			name:       "cluster-wide write of a namespace",
+			namespaces: []string{"dev"},
+			resource:   "namespaces",
+			verb:       "delete",
+			want:       false,
+		},
+		{
+			name:         "api keys of a namespace-restricted key",
+			namespaces:   []string{"dev"},
+			resource:     "apikeys",
+			resourceName: "226f9e06-9d54-45c6-a9f6-4206bfa7ccf6",
+			verb:         "update",
+			want:         false,
+		},
+		{
+			name:       "users of a namespace-restricted key",
+			namespaces: []string{"dev"},
+			resource:   "users",
+			verb:       "list",
+			want:       false,
+		},
+		{
+			name:       "cluster role bindings of a namespace-restricted key",
+			namespaces: []string{"dev"},
+			resource:   "clusterrolebindings",
+			verb:       "create",
+			want:       false,
+		},
+		{
+			name:      "allowed by rule",
+			rules:     []Rule{{Verbs: []string{"get", "list"}, Resources: []string{"checks"}}},
+			namespace: "default",
+			resource:  "checks",
+			verb:      "list",
+			want:      true,
+		},
+		{
+			name:      "forbidden verb",
+			rules:     []Rule{{Verbs: []string{"get", "list"}, Resources: []string{"checks"}}},
+			namespace: "default",
+			resource:  "checks",
+			verb:      "delete",
+			want:      false,
+		},
+		{
+			name:      "forbidden resource",
+			rules:     []Rule{{Verbs: []string{"get", "list"}, Resources: []string{"checks"}}},
+			namespace: "default",
+			resource:  "handlers",
+			verb:      "get",
+			want:      false,
+		},
+		{
+			name:         "forbidden resource name",
+			rules:        []Rule{{Verbs: []string{"get"}, Resources: []string{"checks"}, ResourceNames: []string{"check-cpu"}}},
+			namespace:    "default",
+			resource:     "checks",
+			resourceName: "check-mem",
+			verb:         "get",
+			want:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+			a.Namespaces = tt.namespaces
+			a.Rules = tt.rules
+			got := a.Permits(tt.namespace, tt.resource, tt.resourceName, tt.verb)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAPIKeyIsScoped(t *testing.T) {
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	assert.False(t, a.IsScoped())
+	a.Rules = []Rule{{Verbs: []string{"get"}, Resources: []string{"checks"}}}
+	assert.True(t, a.IsScoped())
+}
@@ -43,10 +43,13 @@ type Asset struct {
 	ObjectMeta `protobuf:"bytes,8,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
 	// Headers is a collection of key/value string pairs used as HTTP headers
 	// for asset retrieval.
-	Headers              map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Headers map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// SignatureURL is the location of the detached minisign signature of the
+	// asset, verified by the agents configured with trusted public keys
+	SignatureURL         string   `protobuf:"bytes,10,opt,name=signature_url,json=signatureUrl,proto3" json:"signature_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Asset) Reset()         { *m = Asset{} }
@@ -95,10 +98,13 @@ type AssetBuild struct {
 	Filters []string `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters"`
 	// Headers is a collection of key/value string pairs used as HTTP headers
 	// for asset retrieval.
-	Headers              map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Headers map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// SignatureURL is the location of the detached minisign signature of the
+	// asset, verified by the agents configured with trusted public keys
+	SignatureURL         string   `protobuf:"bytes,10,opt,name=signature_url,json=signatureUrl,proto3" json:"signature_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AssetBuild) Reset()         { *m = AssetBuild{} }
@@ -146,35 +152,37 @@ func init() {
 }
 
 var fileDescriptor_d39ff00b5fd89710 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4c, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0x4e, 0xcd, 0x2b, 0x2e, 0x85, 0x90, 0xba, 0xe9,
-	0xf9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0xc9, 0xf9, 0x45, 0xa9, 0xfa, 0x65, 0x46, 0xfa, 0x89, 0xc5,
-	0xc5, 0xa9, 0x25, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xbc, 0x60, 0x15, 0x7a, 0x20, 0x29,
-	0xbd, 0x32, 0x23, 0x29, 0x13, 0x24, 0x13, 0xd2, 0xf3, 0xd3, 0xf3, 0xf5, 0xc1, 0xaa, 0x92, 0x4a,
-	0xd3, 0x1c, 0xca, 0x0c, 0xf5, 0x8c, 0xf5, 0x0c, 0xc1, 0x82, 0x60, 0x31, 0x30, 0x0b, 0x62, 0x88,
-	0x94, 0x01, 0x71, 0xf6, 0xe6, 0xa6, 0x96, 0x24, 0x42, 0x74, 0x28, 0xb5, 0x33, 0x73, 0xb1, 0x3a,
-	0x82, 0x9c, 0x21, 0x24, 0xc9, 0xc5, 0x5c, 0x5a, 0x94, 0x23, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0xe9,
-	0xc4, 0xfe, 0xe8, 0x9e, 0x3c, 0x73, 0x68, 0x90, 0x4f, 0x10, 0x48, 0x4c, 0x48, 0x8c, 0x8b, 0xad,
-	0x38, 0x23, 0xd1, 0xd4, 0xd0, 0x48, 0x82, 0x19, 0x24, 0x1b, 0x04, 0xe5, 0x09, 0xa9, 0x72, 0xb1,
-	0xa7, 0x65, 0xe6, 0x94, 0xa4, 0x16, 0x15, 0x4b, 0xb0, 0x2a, 0x30, 0x6b, 0x70, 0x3a, 0x71, 0xbf,
-	0xba, 0x27, 0x0f, 0x13, 0x0a, 0x82, 0x31, 0x84, 0x6c, 0xb9, 0xd8, 0x92, 0x4a, 0x33, 0x73, 0x52,
-	0x8a, 0x25, 0xd8, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x24, 0xf5, 0x50, 0xfc, 0xaa, 0x07, 0xb6, 0xdf,
-	0x09, 0xa4, 0xc2, 0x89, 0xeb, 0xd5, 0x3d, 0x79, 0xa8, 0xe2, 0x20, 0x28, 0x2d, 0x14, 0xca, 0xc5,
-	0x01, 0x72, 0x70, 0x4a, 0x62, 0x49, 0xa2, 0x04, 0x87, 0x02, 0x23, 0x16, 0x03, 0xfc, 0x93, 0xb2,
-	0x52, 0x93, 0x4b, 0x7c, 0x53, 0x4b, 0x12, 0x9d, 0xe4, 0x4e, 0xdc, 0x93, 0x67, 0xb8, 0x70, 0x4f,
-	0x9e, 0xf1, 0xd5, 0x3d, 0x79, 0x21, 0x98, 0x36, 0x9d, 0xfc, 0xdc, 0xcc, 0x92, 0xd4, 0xdc, 0x82,
-	0x92, 0xca, 0x20, 0xb8, 0x51, 0x42, 0x1e, 0x5c, 0xec, 0x19, 0xa9, 0x89, 0x29, 0x20, 0xc7, 0x73,
-	0x82, 0x9d, 0xa5, 0x88, 0xcd, 0x59, 0x7a, 0x1e, 0x10, 0x35, 0xae, 0x79, 0x25, 0x45, 0x95, 0x10,
-	0xff, 0x41, 0x75, 0x05, 0xc1, 0x18, 0x52, 0x56, 0x5c, 0x3c, 0xc8, 0xaa, 0x84, 0x04, 0xb8, 0x98,
-	0xb3, 0x53, 0x2b, 0x25, 0x18, 0xc1, 0x61, 0x05, 0x62, 0x0a, 0x89, 0x70, 0xb1, 0x96, 0x25, 0xe6,
-	0x94, 0xa6, 0x42, 0x42, 0x37, 0x08, 0xc2, 0xb1, 0x62, 0xb2, 0x60, 0xb4, 0xe2, 0xe8, 0x58, 0x20,
-	0xcf, 0xb0, 0x62, 0x81, 0x3c, 0xa3, 0xd2, 0x6f, 0x46, 0x2e, 0x2e, 0x44, 0x48, 0xd0, 0x30, 0x3a,
-	0x7c, 0xd1, 0x3d, 0xae, 0x86, 0x33, 0x3e, 0xe8, 0xe6, 0x7b, 0x27, 0x85, 0x1f, 0x0f, 0xe5, 0x18,
-	0x57, 0x3c, 0x92, 0x63, 0xdc, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf1, 0x58, 0x8e, 0x21, 0x8a, 0xa9, 0xcc, 0x28, 0x89, 0x0d,
-	0x9c, 0x60, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x79, 0xce, 0x68, 0x5c, 0x03, 0x00,
-	0x00,
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0xef, 0x34, 0xf4, 0xdf, 0x6c, 0x17, 0x64, 0x10, 0xcd, 0xf6, 0x90, 0x89, 0x0b, 0x4a, 0x41,
+	0x9d, 0xd8, 0xac, 0x82, 0x14, 0x04, 0x0d, 0x08, 0x7b, 0xd8, 0x45, 0x19, 0xe9, 0xc5, 0x8b, 0x4c,
+	0xda, 0xd9, 0x34, 0x9a, 0x36, 0x25, 0x99, 0x04, 0xfa, 0x0d, 0xfc, 0x08, 0x1e, 0xf7, 0xb8, 0x1f,
+	0xc1, 0x8f, 0xb0, 0x17, 0x61, 0xaf, 0x5e, 0x06, 0x8d, 0xb7, 0x7e, 0x02, 0x8f, 0x32, 0x93, 0x66,
+	0xed, 0x4a, 0x05, 0x2f, 0x1e, 0xf6, 0x92, 0xbc, 0x79, 0xef, 0xf7, 0x7e, 0xef, 0x37, 0xbf, 0xc7,
+	0xc0, 0x41, 0x10, 0x8a, 0x69, 0xe6, 0x93, 0x71, 0x3c, 0x73, 0x52, 0x3e, 0x4f, 0xb3, 0xf2, 0xfb,
+	0x30, 0x88, 0x1d, 0xb6, 0x08, 0x9d, 0x71, 0x9c, 0x70, 0x27, 0x77, 0x1d, 0x96, 0xa6, 0x5c, 0x90,
+	0x45, 0x12, 0x8b, 0x18, 0xed, 0x6a, 0x04, 0x51, 0x25, 0x92, 0xbb, 0xbd, 0xc7, 0x1b, 0x0c, 0x41,
+	0x1c, 0xc4, 0x8e, 0x46, 0xf9, 0xd9, 0xc9, 0xf3, 0x7c, 0x40, 0x0e, 0xc8, 0x40, 0x27, 0x75, 0x4e,
+	0x47, 0x25, 0x49, 0xef, 0xd1, 0xbf, 0xcd, 0x9d, 0x71, 0xc1, 0xca, 0x8e, 0xfd, 0xaf, 0x06, 0x6c,
+	0xbc, 0x50, 0x32, 0xd0, 0x1e, 0x34, 0xb2, 0x24, 0x32, 0xeb, 0x36, 0xe8, 0x77, 0xbc, 0x56, 0x21,
+	0xb1, 0x31, 0xa2, 0x47, 0x54, 0xe5, 0xd0, 0x2d, 0xd8, 0x4c, 0xa7, 0xec, 0xc9, 0xc0, 0x35, 0x0d,
+	0x55, 0xa5, 0xeb, 0x13, 0xba, 0x0b, 0x5b, 0x27, 0x61, 0x24, 0x78, 0x92, 0x9a, 0x0d, 0xdb, 0xe8,
+	0x77, 0xbc, 0x9d, 0x95, 0xc4, 0x55, 0x8a, 0x56, 0x01, 0x7a, 0x06, 0x9b, 0x7e, 0x16, 0x46, 0x93,
+	0xd4, 0x6c, 0xda, 0x46, 0x7f, 0xc7, 0xdd, 0x23, 0x57, 0xee, 0x4a, 0xf4, 0x7c, 0x4f, 0x21, 0x3c,
+	0xb8, 0x92, 0x78, 0x0d, 0xa6, 0xeb, 0x3f, 0x1a, 0xc1, 0xb6, 0x12, 0x3c, 0x61, 0x82, 0x99, 0x6d,
+	0x1b, 0x6c, 0x21, 0x78, 0xe5, 0xbf, 0xe7, 0x63, 0x71, 0xcc, 0x05, 0xf3, 0xac, 0x73, 0x89, 0x6b,
+	0x17, 0x12, 0x83, 0x95, 0xc4, 0xa8, 0x6a, 0x7b, 0x10, 0xcf, 0x42, 0xc1, 0x67, 0x0b, 0xb1, 0xa4,
+	0x97, 0x54, 0xe8, 0x10, 0xb6, 0xa6, 0x9c, 0x4d, 0x94, 0xf8, 0x8e, 0x96, 0x75, 0x67, 0x9b, 0x2c,
+	0x72, 0x58, 0x62, 0x5e, 0xce, 0x45, 0xb2, 0x2c, 0xef, 0xb7, 0xee, 0xa2, 0x55, 0x80, 0x5e, 0xc3,
+	0xdd, 0x34, 0x0c, 0xe6, 0x4c, 0x64, 0x09, 0x7f, 0xa7, 0x3c, 0x84, 0xda, 0xc3, 0xfb, 0x85, 0xc4,
+	0xdd, 0x37, 0x55, 0x61, 0x44, 0x8f, 0x56, 0x12, 0xdf, 0xbe, 0x02, 0xdc, 0xd0, 0xd5, 0xbd, 0x2c,
+	0x8c, 0x92, 0xa8, 0x37, 0x84, 0xdd, 0xcd, 0xb9, 0xe8, 0x06, 0x34, 0x3e, 0xf0, 0xa5, 0x09, 0xb4,
+	0xfb, 0x2a, 0x44, 0x37, 0x61, 0x23, 0x67, 0x51, 0xc6, 0xcb, 0x7d, 0xd1, 0xf2, 0x30, 0xac, 0x3f,
+	0x05, 0xc3, 0xf6, 0xc7, 0x53, 0x5c, 0x3b, 0x3b, 0xc5, 0x60, 0xff, 0x4b, 0x1d, 0xc2, 0xdf, 0xde,
+	0xfe, 0xc7, 0x05, 0x1f, 0xff, 0x69, 0xe5, 0xbd, 0xbf, 0x6e, 0xf8, 0x1a, 0xfb, 0xe9, 0xd9, 0x3f,
+	0xbf, 0x5b, 0xe0, 0xac, 0xb0, 0xc0, 0xe7, 0xc2, 0x02, 0xe7, 0x85, 0x05, 0x2e, 0x0a, 0x0b, 0x7c,
+	0x2b, 0x2c, 0xf0, 0xe9, 0x87, 0x55, 0x7b, 0x5b, 0xcf, 0x5d, 0xbf, 0xa9, 0x1f, 0xd5, 0xc1, 0xaf,
+	0x01, 0x00, 0x71, 0xeb, 0xcd, 0xd3, 0x00, 0x04, 0x00, 0x00,
 }
 
 func (this *Asset) Equal(that interface{}) bool {
@@ -229,6 +237,9 @@ func (this *Asset) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.SignatureURL != that1.SignatureURL {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -275,6 +286,9 @@ func (this *AssetBuild) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.SignatureURL != that1.SignatureURL {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -289,6 +303,7 @@ type AssetFace interface {
 	GetBuilds() []*AssetBuild
 	GetObjectMeta() ObjectMeta
 	GetHeaders() map[string]string
+	GetSignatureURL() string
 }
 
 func (this *Asset) Proto() github_com_golang_protobuf_proto.Message {
@@ -323,6 +338,10 @@ func (this *Asset) GetHeaders() map[string]string {
 	return this.Headers
 }
 
+func (this *Asset) GetSignatureURL() string {
+	return this.SignatureURL
+}
+
 func NewAssetFromFace(that AssetFace) *Asset {
 	this := &Asset{}
 	this.URL = that.GetURL()
@@ -331,6 +350,7 @@ func NewAssetFromFace(that AssetFace) *Asset {
 	this.Builds = that.GetBuilds()
 	this.ObjectMeta = that.GetObjectMeta()
 	this.Headers = that.GetHeaders()
+	this.SignatureURL = that.GetSignatureURL()
 	return this
 }
 
@@ -340,6 +360,7 @@ type AssetBuildFace interface {
 	GetSha512() string
 	GetFilters() []string
 	GetHeaders() map[string]string
+	GetSignatureURL() string
 }
 
 func (this *AssetBuild) Proto() github_com_golang_protobuf_proto.Message {
@@ -366,12 +387,17 @@ func (this *AssetBuild) GetHeaders() map[string]string {
 	return this.Headers
 }
 
+func (this *AssetBuild) GetSignatureURL() string {
+	return this.SignatureURL
+}
+
 func NewAssetBuildFromFace(that AssetBuildFace) *AssetBuild {
 	this := &AssetBuild{}
 	this.URL = that.GetURL()
 	this.Sha512 = that.GetSha512()
 	this.Filters = that.GetFilters()
 	this.Headers = that.GetHeaders()
+	this.SignatureURL = that.GetSignatureURL()
 	return this
 }
 
@@ -399,6 +425,13 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SignatureURL) > 0 {
+		i -= len(m.SignatureURL)
+		copy(dAtA[i:], m.SignatureURL)
+		i = encodeVarintAsset(dAtA, i, uint64(len(m.SignatureURL)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Headers) > 0 {
 		for k := range m.Headers {
 			v := m.Headers[k]
@@ -492,6 +525,13 @@ func (m *AssetBuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SignatureURL) > 0 {
+		i -= len(m.SignatureURL)
+		copy(dAtA[i:], m.SignatureURL)
+		i = encodeVarintAsset(dAtA, i, uint64(len(m.SignatureURL)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Headers) > 0 {
 		for k := range m.Headers {
 			v := m.Headers[k]
@@ -573,8 +613,9 @@ func NewPopulatedAsset(r randyAsset, easy bool) *Asset {
 			this.Headers[randStringAsset(r)] = randStringAsset(r)
 		}
 	}
+	this.SignatureURL = string(randStringAsset(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedAsset(r, 10)
+		this.XXX_unrecognized = randUnrecognizedAsset(r, 11)
 	}
 	return this
 }
@@ -595,8 +636,9 @@ func NewPopulatedAssetBuild(r randyAsset, easy bool) *AssetBuild {
 			this.Headers[randStringAsset(r)] = randStringAsset(r)
 		}
 	}
+	this.SignatureURL = string(randStringAsset(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedAsset(r, 10)
+		this.XXX_unrecognized = randUnrecognizedAsset(r, 11)
 	}
 	return this
 }
@@ -709,6 +751,10 @@ func (m *Asset) Size() (n int) {
 			n += mapEntrySize + 1 + sovAsset(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SignatureURL)
+	if l > 0 {
+		n += 1 + l + sovAsset(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -743,6 +789,10 @@ func (m *AssetBuild) Size() (n int) {
 			n += mapEntrySize + 1 + sovAsset(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SignatureURL)
+	if l > 0 {
+		n += 1 + l + sovAsset(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1074,6 +1124,38 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			}
 			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAsset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsset(dAtA[iNdEx:])
@@ -1348,6 +1430,38 @@ func (m *AssetBuild) Unmarshal(dAtA []byte) error {
 			}
 			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAsset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsset(dAtA[iNdEx:])
@@ -125,6 +125,7 @@ func NewCheck(c *CheckConfig) *Check {
 		DiscardOutput:        c.DiscardOutput,
 		MaxOutputSize:        c.MaxOutputSize,
 		Scheduler:            c.Scheduler,
+		Sandbox:              c.Sandbox,
 	}
 	if check.Labels == nil {
 		check.Labels = make(map[string]string)
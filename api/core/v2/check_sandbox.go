@@ -0,0 +1,31 @@
+package v2
+
+import (
+	"errors"
+	"path"
+	"strings"
+)
+
+// FixtureCheckSandbox returns a fixture for a CheckSandbox object.
+func FixtureCheckSandbox() *CheckSandbox {
+	return &CheckSandbox{
+		CPUTime:   10,
+		Memory:    256 * 1024 * 1024,
+		OpenFiles: 64,
+	}
+}
+
+// Validate returns an error if the CheckSandbox does not pass validation tests
+func (s *CheckSandbox) Validate() error {
+	if s.Group != "" && s.User == "" {
+		return errors.New("sandbox group requires a sandbox user")
+	}
+
+	if s.Cgroup != "" {
+		if path.IsAbs(s.Cgroup) || path.Clean(s.Cgroup) != s.Cgroup || strings.HasPrefix(s.Cgroup, "..") {
+			return errors.New("sandbox cgroup must be a clean path relative to the cgroup hierarchy")
+		}
+	}
+
+	return nil
+}
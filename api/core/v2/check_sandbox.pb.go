@@ -0,0 +1,690 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/check_sandbox.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// CheckSandbox describes the resource limits and the isolation applied to the
+// check command on Linux agents. Its attributes are ignored on other
+// platforms.
+type CheckSandbox struct {
+	// CPUTime is the maximum CPU time, in seconds, the check command can
+	// consume (RLIMIT_CPU)
+	CPUTime uint64 `protobuf:"varint,1,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	// Memory is the maximum size, in bytes, of the virtual memory of the check
+	// command (RLIMIT_AS)
+	Memory uint64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// OpenFiles is the maximum number of files the check command can open
+	// (RLIMIT_NOFILE)
+	OpenFiles uint64 `protobuf:"varint,3,opt,name=open_files,json=openFiles,proto3" json:"open_files,omitempty"`
+	// User is the name or the ID of the user the check command runs as
+	User string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	// Group is the name or the ID of the group the check command runs as. It
+	// defaults to the primary group of the user.
+	Group string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	// Cgroup is the path of the cgroup v2, relative to the root of the cgroup
+	// hierarchy, the check command is placed in
+	Cgroup               string   `protobuf:"bytes,6,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckSandbox) Reset()         { *m = CheckSandbox{} }
+func (m *CheckSandbox) String() string { return proto.CompactTextString(m) }
+func (*CheckSandbox) ProtoMessage()    {}
+func (*CheckSandbox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9d4ad967ba54585, []int{0}
+}
+func (m *CheckSandbox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckSandbox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckSandbox.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckSandbox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckSandbox.Merge(m, src)
+}
+func (m *CheckSandbox) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckSandbox) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckSandbox.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckSandbox proto.InternalMessageInfo
+
+func (m *CheckSandbox) GetCPUTime() uint64 {
+	if m != nil {
+		return m.CPUTime
+	}
+	return 0
+}
+
+func (m *CheckSandbox) GetMemory() uint64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *CheckSandbox) GetOpenFiles() uint64 {
+	if m != nil {
+		return m.OpenFiles
+	}
+	return 0
+}
+
+func (m *CheckSandbox) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *CheckSandbox) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *CheckSandbox) GetCgroup() string {
+	if m != nil {
+		return m.Cgroup
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CheckSandbox)(nil), "sensu.core.v2.CheckSandbox")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/check_sandbox.proto", fileDescriptor_d9d4ad967ba54585)
+}
+
+var fileDescriptor_d9d4ad967ba54585 = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xcb, 0x4a, 0xf3, 0x40,
+	0x18, 0x86, 0xff, 0xe9, 0xdf, 0x83, 0x1d, 0x3c, 0x31, 0x76, 0x11, 0x5c, 0x4c, 0xaa, 0x0b, 0xa9,
+	0x50, 0x13, 0xda, 0x0a, 0x22, 0xb8, 0x90, 0x16, 0x5c, 0x8b, 0x87, 0x8d, 0x9b, 0xd2, 0x8c, 0xd3,
+	0x34, 0xe8, 0x74, 0x86, 0x24, 0x13, 0xec, 0x9d, 0x78, 0x09, 0x5e, 0x80, 0x0b, 0x2f, 0xc1, 0xa5,
+	0x57, 0x10, 0x34, 0xee, 0x72, 0x05, 0x2e, 0x65, 0xbe, 0x54, 0x0c, 0x6e, 0x86, 0x99, 0xe7, 0x7b,
+	0xde, 0x97, 0x8f, 0xc1, 0xc7, 0x7e, 0x10, 0xcf, 0xb4, 0xe7, 0x30, 0x29, 0xdc, 0x88, 0xcf, 0x23,
+	0x5d, 0x9c, 0x07, 0xbe, 0x74, 0x27, 0x2a, 0x70, 0x99, 0x0c, 0xb9, 0x9b, 0xf4, 0x5d, 0x36, 0xe3,
+	0xec, 0x6e, 0x1c, 0x4d, 0xe6, 0xb7, 0x9e, 0x7c, 0x70, 0x54, 0x28, 0x63, 0x49, 0xd6, 0xc0, 0x74,
+	0x8c, 0xe2, 0x24, 0xfd, 0xed, 0xc3, 0x52, 0x93, 0x2f, 0x7d, 0xe9, 0x82, 0xe5, 0xe9, 0xe9, 0x69,
+	0xd2, 0x73, 0x06, 0x4e, 0x0f, 0x20, 0x30, 0xb8, 0x15, 0x25, 0xbb, 0xcf, 0x15, 0xbc, 0x3a, 0x32,
+	0xe5, 0x97, 0x45, 0x37, 0x39, 0xc1, 0x2b, 0x4c, 0xe9, 0x71, 0x1c, 0x08, 0x6e, 0xa1, 0x36, 0xea,
+	0x54, 0x87, 0x3b, 0x59, 0x6a, 0x37, 0x46, 0xe7, 0xd7, 0x57, 0x81, 0xe0, 0x79, 0x6a, 0x93, 0x9f,
+	0x71, 0x57, 0x8a, 0x20, 0xe6, 0x42, 0xc5, 0x8b, 0x8b, 0x06, 0x53, 0xda, 0x8c, 0x49, 0x17, 0xd7,
+	0x05, 0x17, 0x32, 0x5c, 0x58, 0x15, 0xc8, 0xb6, 0xf2, 0xd4, 0xde, 0x2c, 0x48, 0x49, 0x5f, 0x3a,
+	0xe4, 0x08, 0x63, 0xa9, 0xf8, 0x7c, 0x3c, 0x0d, 0xee, 0x79, 0x64, 0xfd, 0x87, 0x84, 0x95, 0xa7,
+	0x76, 0xeb, 0x97, 0x96, 0x52, 0x4d, 0x43, 0xcf, 0x0c, 0x24, 0x7b, 0xb8, 0xaa, 0x23, 0x1e, 0x5a,
+	0xd5, 0x36, 0xea, 0x34, 0x87, 0x24, 0x4f, 0xed, 0x75, 0xf3, 0x2e, 0xc9, 0x30, 0x27, 0xfb, 0xb8,
+	0xe6, 0x87, 0x52, 0x2b, 0xab, 0x06, 0xe2, 0x56, 0x9e, 0xda, 0x1b, 0x00, 0x4a, 0x66, 0x61, 0x98,
+	0xcd, 0x59, 0xe1, 0xd6, 0xc1, 0x85, 0xcd, 0xd9, 0x5f, 0x79, 0xe9, 0x0c, 0xdb, 0x5f, 0x1f, 0x14,
+	0x3d, 0x65, 0x14, 0xbd, 0x64, 0x14, 0xbd, 0x66, 0x14, 0xbd, 0x65, 0x14, 0xbd, 0x67, 0x14, 0x3d,
+	0x7e, 0xd2, 0x7f, 0x37, 0x95, 0xa4, 0xef, 0xd5, 0xe1, 0x7f, 0x07, 0xdf, 0x03, 0x00, 0xc3, 0x2c,
+	0xf3, 0x0f, 0xe1, 0x01, 0x00, 0x00,
+}
+
+func (this *CheckSandbox) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckSandbox)
+	if !ok {
+		that2, ok := that.(CheckSandbox)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CPUTime != that1.CPUTime {
+		return false
+	}
+	if this.Memory != that1.Memory {
+		return false
+	}
+	if this.OpenFiles != that1.OpenFiles {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if this.Group != that1.Group {
+		return false
+	}
+	if this.Cgroup != that1.Cgroup {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (m *CheckSandbox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckSandbox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckSandbox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cgroup) > 0 {
+		i -= len(m.Cgroup)
+		copy(dAtA[i:], m.Cgroup)
+		i = encodeVarintCheckSandbox(dAtA, i, uint64(len(m.Cgroup)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintCheckSandbox(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintCheckSandbox(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OpenFiles != 0 {
+		i = encodeVarintCheckSandbox(dAtA, i, uint64(m.OpenFiles))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Memory != 0 {
+		i = encodeVarintCheckSandbox(dAtA, i, uint64(m.Memory))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CPUTime != 0 {
+		i = encodeVarintCheckSandbox(dAtA, i, uint64(m.CPUTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCheckSandbox(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCheckSandbox(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedCheckSandbox(r randyCheckSandbox, easy bool) *CheckSandbox {
+	this := &CheckSandbox{}
+	this.CPUTime = uint64(uint64(r.Uint32()))
+	this.Memory = uint64(uint64(r.Uint32()))
+	this.OpenFiles = uint64(uint64(r.Uint32()))
+	this.User = string(randStringCheckSandbox(r))
+	this.Group = string(randStringCheckSandbox(r))
+	this.Cgroup = string(randStringCheckSandbox(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCheckSandbox(r, 7)
+	}
+	return this
+}
+
+type randyCheckSandbox interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneCheckSandbox(r randyCheckSandbox) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringCheckSandbox(r randyCheckSandbox) string {
+	v1 := r.Intn(100)
+	tmps := make([]rune, v1)
+	for i := 0; i < v1; i++ {
+		tmps[i] = randUTF8RuneCheckSandbox(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedCheckSandbox(r randyCheckSandbox, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldCheckSandbox(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldCheckSandbox(dAtA []byte, r randyCheckSandbox, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateCheckSandbox(dAtA, uint64(key))
+		v2 := r.Int63()
+		if r.Intn(2) == 0 {
+			v2 *= -1
+		}
+		dAtA = encodeVarintPopulateCheckSandbox(dAtA, uint64(v2))
+	case 1:
+		dAtA = encodeVarintPopulateCheckSandbox(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateCheckSandbox(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateCheckSandbox(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateCheckSandbox(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateCheckSandbox(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *CheckSandbox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CPUTime != 0 {
+		n += 1 + sovCheckSandbox(uint64(m.CPUTime))
+	}
+	if m.Memory != 0 {
+		n += 1 + sovCheckSandbox(uint64(m.Memory))
+	}
+	if m.OpenFiles != 0 {
+		n += 1 + sovCheckSandbox(uint64(m.OpenFiles))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovCheckSandbox(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovCheckSandbox(uint64(l))
+	}
+	l = len(m.Cgroup)
+	if l > 0 {
+		n += 1 + l + sovCheckSandbox(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCheckSandbox(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCheckSandbox(x uint64) (n int) {
+	return sovCheckSandbox(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckSandbox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckSandbox
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckSandbox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckSandbox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUTime", wireType)
+			}
+			m.CPUTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			m.Memory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Memory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFiles", wireType)
+			}
+			m.OpenFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenFiles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckSandbox
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckSandbox
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckSandbox
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckSandbox
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cgroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckSandbox
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckSandbox
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cgroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckSandbox(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckSandbox
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCheckSandbox(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCheckSandbox
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckSandbox
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckSandbox
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCheckSandbox
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCheckSandbox
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCheckSandbox
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCheckSandbox        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCheckSandbox          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCheckSandbox = fmt.Errorf("proto: unexpected end of group")
+)
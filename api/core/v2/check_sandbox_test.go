@@ -0,0 +1,53 @@
+package v2
+
+import (
+	"testing"
+)
+
+func TestCheckSandboxValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sandbox *CheckSandbox
+		wantErr bool
+	}{
+		{
+			name:    "valid limits",
+			sandbox: FixtureCheckSandbox(),
+		},
+		{
+			name:    "valid user and group",
+			sandbox: &CheckSandbox{User: "nobody", Group: "nogroup"},
+		},
+		{
+			name:    "group without user",
+			sandbox: &CheckSandbox{Group: "nogroup"},
+			wantErr: true,
+		},
+		{
+			name:    "valid cgroup",
+			sandbox: &CheckSandbox{Cgroup: "sensu/checks"},
+		},
+		{
+			name:    "absolute cgroup",
+			sandbox: &CheckSandbox{Cgroup: "/sensu/checks"},
+			wantErr: true,
+		},
+		{
+			name:    "cgroup escaping the hierarchy",
+			sandbox: &CheckSandbox{Cgroup: "../checks"},
+			wantErr: true,
+		},
+		{
+			name:    "unclean cgroup",
+			sandbox: &CheckSandbox{Cgroup: "sensu//checks/"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.sandbox.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("CheckSandbox.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
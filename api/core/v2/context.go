@@ -44,6 +44,14 @@ const (
 
 	// PipelineWorkflowKey contains the key name to retrieve the pipeline workflow from context
 	PipelineWorkflowKey
+
+	// APIKeyKey contains the key name to retrieve the API key used to
+	// authenticate a request from context
+	APIKeyKey
+
+	// HandlerExecutionKey contains the key name to retrieve the handler
+	// execution being recorded from context
+	HandlerExecutionKey
 )
 
 // ContextNamespace returns the namespace injected in the context
@@ -70,6 +78,24 @@ func ContextPipelineWorkflow(ctx context.Context) string {
 	return ""
 }
 
+// ContextAPIKey returns the API key injected in the context, if the request
+// was authenticated with one
+func ContextAPIKey(ctx context.Context) *APIKey {
+	if value := ctx.Value(APIKeyKey); value != nil {
+		return value.(*APIKey)
+	}
+	return nil
+}
+
+// ContextHandlerExecution returns the handler execution injected in the
+// context, if the execution of the handler is being recorded
+func ContextHandlerExecution(ctx context.Context) *HandlerExecution {
+	if value := ctx.Value(HandlerExecutionKey); value != nil {
+		return value.(*HandlerExecution)
+	}
+	return nil
+}
+
 // PageSizeFromContext returns the page size stored in the given context, if
 // any. Returns 0 if none is found, typically meaning "unlimited" page size.
 func PageSizeFromContext(ctx context.Context) int {
@@ -68,6 +68,10 @@ func (h *Handler) Validate() error {
 		return errors.New("namespace must be set")
 	}
 
+	if (h.Retries > 0 || h.RetryBackoff > 0) && h.Type == "set" {
+		return errors.New("retries are not supported by handler sets")
+	}
+
 	return nil
 }
 
@@ -51,10 +51,16 @@ type Handler struct {
 	RuntimeAssets []string `protobuf:"bytes,13,rep,name=runtime_assets,json=runtimeAssets,proto3" json:"runtime_assets"`
 	// Secrets is the list of Sensu secrets to set for the handler's
 	// execution environment.
-	Secrets              []*Secret `protobuf:"bytes,14,rep,name=secrets,proto3" json:"secrets"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Secrets []*Secret `protobuf:"bytes,14,rep,name=secrets,proto3" json:"secrets"`
+	// Retries is the number of times the execution of a pipe, tcp or udp
+	// handler is retried after a failure.
+	Retries uint32 `protobuf:"varint,15,opt,name=retries,proto3" json:"retries,omitempty"`
+	// RetryBackoff is the number of seconds to wait before retrying a failed
+	// handler execution. It doubles after each retry.
+	RetryBackoff         uint32   `protobuf:"varint,16,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Handler) Reset()         { *m = Handler{} }
@@ -158,39 +164,41 @@ func init() {
 }
 
 var fileDescriptor_a415b3439792b693 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0x36, 0x21, 0x76, 0x36, 0xb8, 0x87, 0x95, 0x90, 0x96, 0xaa, 0xb2, 0xad, 0x4a, 0x08,
-	0x1f, 0xc0, 0xa6, 0x0e, 0x17, 0x2a, 0x0e, 0xd4, 0x27, 0x2e, 0x08, 0x69, 0x2b, 0x38, 0x70, 0xa9,
-	0x36, 0xce, 0x36, 0x09, 0xd4, 0xde, 0xc8, 0xbb, 0xb6, 0xd4, 0x3f, 0xe8, 0x27, 0x70, 0xec, 0xb1,
-	0x9f, 0xc0, 0x27, 0xe4, 0xd8, 0x2f, 0xb0, 0x20, 0xdc, 0xfc, 0x05, 0x1c, 0x91, 0xc7, 0x76, 0xa0,
-	0x39, 0xf5, 0xb2, 0x7a, 0xf3, 0xe6, 0xcd, 0x78, 0x9e, 0x67, 0xf0, 0x64, 0xbe, 0xd4, 0x8b, 0x7c,
-	0xea, 0xc7, 0x32, 0x09, 0x94, 0x48, 0x55, 0xde, 0xbc, 0x2f, 0xe7, 0x32, 0xe0, 0xab, 0x65, 0x10,
-	0xcb, 0x4c, 0x04, 0x45, 0x18, 0x2c, 0x78, 0x3a, 0xbb, 0x14, 0x99, 0xbf, 0xca, 0xa4, 0x96, 0xc4,
-	0x02, 0x8d, 0x5f, 0x27, 0xfd, 0x22, 0x3c, 0x78, 0xfd, 0x5f, 0x8f, 0xb9, 0x9c, 0xcb, 0x00, 0x54,
-	0xd3, 0xfc, 0xe2, 0x5d, 0x71, 0xec, 0x4f, 0xfc, 0x63, 0x20, 0x81, 0x03, 0xd4, 0x34, 0x39, 0x78,
-	0xf5, 0xb0, 0x2f, 0x27, 0x42, 0xf3, 0xb6, 0x22, 0x7c, 0x58, 0x85, 0x12, 0x71, 0x26, 0x74, 0x53,
-	0x73, 0x74, 0x3d, 0xc0, 0xc6, 0xfb, 0x66, 0x78, 0xf2, 0x09, 0x9b, 0x75, 0xb7, 0x19, 0xd7, 0x9c,
-	0x22, 0x17, 0x79, 0xe3, 0xf0, 0xa9, 0x7f, 0xcf, 0x89, 0xff, 0x71, 0xfa, 0x55, 0xc4, 0xfa, 0x83,
-	0xd0, 0x3c, 0xb2, 0xd7, 0xa5, 0xd3, 0xbb, 0x2b, 0x1d, 0x54, 0x95, 0x0e, 0xe9, 0xca, 0x5e, 0xc8,
-	0x64, 0xa9, 0x45, 0xb2, 0xd2, 0x57, 0x6c, 0xdb, 0x8a, 0x10, 0x3c, 0xd0, 0x57, 0x2b, 0x41, 0xf7,
-	0x5c, 0xe4, 0x8d, 0x18, 0x60, 0x42, 0xb1, 0x91, 0xe4, 0x9a, 0x6b, 0x99, 0xd1, 0x3e, 0xd0, 0x5d,
-	0x58, 0x67, 0x62, 0x99, 0x24, 0x3c, 0x9d, 0xd1, 0x41, 0x93, 0x69, 0x43, 0xf2, 0x0c, 0x1b, 0x7a,
-	0x99, 0x08, 0x99, 0x6b, 0xfa, 0xc8, 0x45, 0x9e, 0x15, 0x8d, 0xab, 0xd2, 0xe9, 0x28, 0xd6, 0x01,
-	0x72, 0x82, 0x87, 0x4a, 0xc6, 0xdf, 0x84, 0xa6, 0x43, 0xf0, 0x70, 0xb8, 0xe3, 0xa1, 0x75, 0x7b,
-	0x06, 0x9a, 0x68, 0xb0, 0x2e, 0x1d, 0xc4, 0xda, 0x0a, 0xe2, 0x61, 0xb3, 0xdd, 0xa4, 0xa2, 0x86,
-	0xdb, 0xf7, 0x46, 0xd1, 0xe3, 0xaa, 0x74, 0xb6, 0x1c, 0xdb, 0xa2, 0x7a, 0x98, 0x8b, 0xe5, 0xa5,
-	0xae, 0x85, 0x26, 0x08, 0x61, 0x98, 0x96, 0x62, 0x1d, 0x20, 0xcf, 0xb1, 0x29, 0xd2, 0xe2, 0xbc,
-	0xe0, 0x99, 0xa2, 0xa3, 0x7f, 0x0d, 0x3b, 0x8e, 0x19, 0x22, 0x2d, 0x3e, 0xf3, 0x4c, 0x91, 0x37,
-	0x78, 0x3f, 0xcb, 0xd3, 0xda, 0xc3, 0x39, 0x57, 0x4a, 0x68, 0x45, 0x2d, 0x90, 0x93, 0xaa, 0x74,
-	0x76, 0x32, 0xcc, 0x6a, 0xe3, 0x53, 0x08, 0xc9, 0x5b, 0x6c, 0x34, 0x2b, 0x55, 0x74, 0xdf, 0xed,
-	0x7b, 0xe3, 0xf0, 0xc9, 0x8e, 0xe3, 0x33, 0xc8, 0x36, 0x13, 0xb6, 0x4a, 0xd6, 0x81, 0x13, 0xf3,
-	0xfa, 0xc6, 0xe9, 0xdd, 0xde, 0x38, 0xe8, 0xe8, 0x14, 0x5b, 0xf7, 0xfe, 0x4d, 0xbd, 0xb8, 0x85,
-	0x54, 0x1a, 0x6e, 0x61, 0xc4, 0x00, 0x93, 0x43, 0x3c, 0x58, 0xc9, 0x4c, 0xc3, 0x32, 0xad, 0xc8,
-	0xac, 0x4a, 0x07, 0x62, 0x06, 0x6f, 0xe4, 0xfe, 0xf9, 0x65, 0xa3, 0xdb, 0x8d, 0x8d, 0x7e, 0x6c,
-	0x6c, 0xb4, 0xde, 0xd8, 0xe8, 0x6e, 0x63, 0xa3, 0x9f, 0x1b, 0x1b, 0x7d, 0xff, 0x6d, 0xf7, 0xbe,
-	0xec, 0x15, 0xe1, 0x74, 0x08, 0x67, 0x37, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x72, 0x2a, 0x50,
-	0xb5, 0x58, 0x03, 0x00, 0x00,
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6e, 0xd3, 0x4c,
+	0x18, 0xed, 0x34, 0xf9, 0x63, 0x67, 0x52, 0xf7, 0x47, 0x23, 0x21, 0x0d, 0x55, 0x65, 0x5b, 0x45,
+	0x08, 0x2f, 0xc0, 0xa6, 0x0e, 0x1b, 0x2a, 0x16, 0xd4, 0x2b, 0x36, 0x08, 0x69, 0x2a, 0x58, 0xb0,
+	0x89, 0x26, 0xce, 0x24, 0x31, 0xad, 0x3d, 0xd1, 0xcc, 0xd8, 0x52, 0x6e, 0xc0, 0x11, 0x58, 0x76,
+	0xd9, 0x23, 0x70, 0x03, 0xb2, 0xec, 0x09, 0x2c, 0x08, 0xbb, 0x9c, 0x80, 0x25, 0xf2, 0xd8, 0x0e,
+	0x34, 0xab, 0x6e, 0xac, 0xf7, 0xbd, 0xf7, 0xbe, 0xcf, 0xdf, 0x9b, 0x19, 0x38, 0x9c, 0x25, 0x6a,
+	0x9e, 0x8f, 0xfd, 0x98, 0xa7, 0x81, 0x64, 0x99, 0xcc, 0xeb, 0xef, 0xf3, 0x19, 0x0f, 0xe8, 0x22,
+	0x09, 0x62, 0x2e, 0x58, 0x50, 0x84, 0xc1, 0x9c, 0x66, 0x93, 0x2b, 0x26, 0xfc, 0x85, 0xe0, 0x8a,
+	0x23, 0x4b, 0x7b, 0xfc, 0x4a, 0xf4, 0x8b, 0xf0, 0xe8, 0xe5, 0x3f, 0x33, 0x66, 0x7c, 0xc6, 0x03,
+	0xed, 0x1a, 0xe7, 0xd3, 0x37, 0xc5, 0xa9, 0x3f, 0xf4, 0x4f, 0x35, 0xa9, 0x39, 0x8d, 0xea, 0x21,
+	0x47, 0x2f, 0xee, 0xf7, 0xe7, 0x94, 0x29, 0xda, 0x74, 0x84, 0xf7, 0xeb, 0x90, 0x2c, 0x16, 0x4c,
+	0xd5, 0x3d, 0x27, 0xdf, 0xbb, 0xd0, 0x78, 0x5b, 0x2f, 0x8f, 0x3e, 0x40, 0xb3, 0x9a, 0x36, 0xa1,
+	0x8a, 0x62, 0xe0, 0x02, 0x6f, 0x10, 0x3e, 0xf2, 0xef, 0x24, 0xf1, 0xdf, 0x8f, 0x3f, 0xb3, 0x58,
+	0xbd, 0x63, 0x8a, 0x46, 0xf6, 0xaa, 0x74, 0xf6, 0x6e, 0x4b, 0x07, 0x6c, 0x4a, 0x07, 0xb5, 0x6d,
+	0xcf, 0x78, 0x9a, 0x28, 0x96, 0x2e, 0xd4, 0x92, 0x6c, 0x47, 0x21, 0x04, 0xbb, 0x6a, 0xb9, 0x60,
+	0x78, 0xdf, 0x05, 0x5e, 0x9f, 0x68, 0x8c, 0x30, 0x34, 0xd2, 0x5c, 0x51, 0xc5, 0x05, 0xee, 0x68,
+	0xba, 0x2d, 0x2b, 0x25, 0xe6, 0x69, 0x4a, 0xb3, 0x09, 0xee, 0xd6, 0x4a, 0x53, 0xa2, 0x27, 0xd0,
+	0x50, 0x49, 0xca, 0x78, 0xae, 0xf0, 0x7f, 0x2e, 0xf0, 0xac, 0x68, 0xb0, 0x29, 0x9d, 0x96, 0x22,
+	0x2d, 0x40, 0x67, 0xb0, 0x27, 0x79, 0x7c, 0xc9, 0x14, 0xee, 0xe9, 0x0c, 0xc7, 0x3b, 0x19, 0x9a,
+	0xb4, 0x17, 0xda, 0x13, 0x75, 0x57, 0xa5, 0x03, 0x48, 0xd3, 0x81, 0x3c, 0x68, 0x36, 0x37, 0x29,
+	0xb1, 0xe1, 0x76, 0xbc, 0x7e, 0x74, 0xb0, 0x29, 0x9d, 0x2d, 0x47, 0xb6, 0xa8, 0x5a, 0x66, 0x9a,
+	0x5c, 0xa9, 0xca, 0x68, 0x6a, 0xa3, 0x5e, 0xa6, 0xa1, 0x48, 0x0b, 0xd0, 0x53, 0x68, 0xb2, 0xac,
+	0x18, 0x15, 0x54, 0x48, 0xdc, 0xff, 0x3b, 0xb0, 0xe5, 0x88, 0xc1, 0xb2, 0xe2, 0x23, 0x15, 0x12,
+	0xbd, 0x82, 0x87, 0x22, 0xcf, 0xaa, 0x0c, 0x23, 0x2a, 0x25, 0x53, 0x12, 0x5b, 0xda, 0x8e, 0x36,
+	0xa5, 0xb3, 0xa3, 0x10, 0xab, 0xa9, 0xcf, 0x75, 0x89, 0x5e, 0x43, 0xa3, 0xbe, 0x52, 0x89, 0x0f,
+	0xdd, 0x8e, 0x37, 0x08, 0x1f, 0xee, 0x24, 0xbe, 0xd0, 0x6a, 0xbd, 0x61, 0xe3, 0x24, 0x2d, 0xa8,
+	0xce, 0x5b, 0x30, 0x25, 0x12, 0x26, 0xf1, 0xff, 0xd5, 0xa9, 0x92, 0xb6, 0x44, 0x8f, 0xa1, 0x55,
+	0xc1, 0xe5, 0x68, 0x4c, 0xe3, 0x4b, 0x3e, 0x9d, 0xe2, 0x07, 0x5a, 0x3f, 0xd0, 0x64, 0x54, 0x73,
+	0x67, 0xe6, 0x97, 0x6b, 0x67, 0xef, 0xe6, 0xda, 0x01, 0x27, 0xe7, 0xd0, 0xba, 0x73, 0xb4, 0xd5,
+	0xbd, 0xcf, 0xb9, 0x54, 0xfa, 0x29, 0xf5, 0x89, 0xc6, 0xe8, 0x18, 0x76, 0x17, 0x5c, 0x28, 0xfd,
+	0x16, 0xac, 0xc8, 0xdc, 0x94, 0x8e, 0xae, 0x89, 0xfe, 0x46, 0xee, 0xef, 0x9f, 0x36, 0xb8, 0x59,
+	0xdb, 0xe0, 0xdb, 0xda, 0x06, 0xab, 0xb5, 0x0d, 0x6e, 0xd7, 0x36, 0xf8, 0xb1, 0xb6, 0xc1, 0xd7,
+	0x5f, 0xf6, 0xde, 0xa7, 0xfd, 0x22, 0x1c, 0xf7, 0xf4, 0xab, 0x1d, 0xfe, 0x19, 0x00, 0xd2, 0xa9,
+	0x18, 0x11, 0x97, 0x03, 0x00, 0x00,
 }
 
 func (this *Handler) Equal(that interface{}) bool {
@@ -270,6 +278,12 @@ func (this *Handler) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Retries != that1.Retries {
+		return false
+	}
+	if this.RetryBackoff != that1.RetryBackoff {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -319,6 +333,8 @@ type HandlerFace interface {
 	GetEnvVars() []string
 	GetRuntimeAssets() []string
 	GetSecrets() []*Secret
+	GetRetries() uint32
+	GetRetryBackoff() uint32
 }
 
 func (this *Handler) Proto() github_com_golang_protobuf_proto.Message {
@@ -373,6 +389,14 @@ func (this *Handler) GetSecrets() []*Secret {
 	return this.Secrets
 }
 
+func (this *Handler) GetRetries() uint32 {
+	return this.Retries
+}
+
+func (this *Handler) GetRetryBackoff() uint32 {
+	return this.RetryBackoff
+}
+
 func NewHandlerFromFace(that HandlerFace) *Handler {
 	this := &Handler{}
 	this.ObjectMeta = that.GetObjectMeta()
@@ -386,6 +410,8 @@ func NewHandlerFromFace(that HandlerFace) *Handler {
 	this.EnvVars = that.GetEnvVars()
 	this.RuntimeAssets = that.GetRuntimeAssets()
 	this.Secrets = that.GetSecrets()
+	this.Retries = that.GetRetries()
+	this.RetryBackoff = that.GetRetryBackoff()
 	return this
 }
 
@@ -413,6 +439,18 @@ func (m *Handler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetryBackoff != 0 {
+		i = encodeVarintHandler(dAtA, i, uint64(m.RetryBackoff))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Retries != 0 {
+		i = encodeVarintHandler(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.Secrets) > 0 {
 		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -602,8 +640,10 @@ func NewPopulatedHandler(r randyHandler, easy bool) *Handler {
 			this.Secrets[i] = NewPopulatedSecret(r, easy)
 		}
 	}
+	this.Retries = uint32(r.Uint32())
+	this.RetryBackoff = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedHandler(r, 15)
+		this.XXX_unrecognized = randUnrecognizedHandler(r, 17)
 	}
 	return this
 }
@@ -747,6 +787,12 @@ func (m *Handler) Size() (n int) {
 			n += 1 + l + sovHandler(uint64(l))
 		}
 	}
+	if m.Retries != 0 {
+		n += 1 + sovHandler(uint64(m.Retries))
+	}
+	if m.RetryBackoff != 0 {
+		n += 2 + sovHandler(uint64(m.RetryBackoff))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1153,6 +1199,44 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoff", wireType)
+			}
+			m.RetryBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoff |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
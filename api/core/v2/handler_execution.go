@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"strings"
+)
+
+// NewHandlerExecution initializes and returns a HandlerExecution for the given
+// event and handler name.
+func NewHandlerExecution(event *Event, handler string) *HandlerExecution {
+	h := &HandlerExecution{
+		ObjectMeta: NewObjectMeta(handler, event.GetNamespace()),
+	}
+	if len(event.ID) > 0 {
+		h.EventID = event.GetUUID().String()
+	}
+	if event.HasCheck() {
+		h.Check = event.Check.Name
+	}
+	if event.Entity != nil {
+		h.Entity = event.Entity.Name
+	}
+	return h
+}
+
+// SetOutput sets the output of the handler execution, truncated to the given
+// number of bytes if it is larger.
+func (h *HandlerExecution) SetOutput(output string, size int) {
+	h.OutputTruncated = false
+	if size > 0 && len(output) > size {
+		// Don't leave a partial rune at the end of the truncated output
+		output = strings.ToValidUTF8(output[:size], "")
+		h.OutputTruncated = true
+	}
+	h.Output = output
+}
+
+// Succeeded returns true if the mutator and the handler ran without errors
+// and the handler exited with a zero status.
+func (h *HandlerExecution) Succeeded() bool {
+	return h.Error == "" && h.Status == 0
+}
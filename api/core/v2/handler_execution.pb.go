@@ -0,0 +1,1166 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/handler_execution.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// HandlerExecution records the execution of a handler, and of the mutator
+// that preceded it, for an event.
+type HandlerExecution struct {
+	// Metadata contains the name (of the handler), and namespace, labels and
+	// annotations of the handler execution
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// EventID is the unique identifier of the handled event
+	EventID string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Entity is the name of the entity of the handled event
+	Entity string `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// Check is the name of the check of the handled event
+	Check string `protobuf:"bytes,4,opt,name=check,proto3" json:"check,omitempty"`
+	// Pipeline is the name of the pipeline that ran the handler
+	Pipeline string `protobuf:"bytes,5,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// Workflow is the name of the pipeline workflow that ran the handler
+	Workflow string `protobuf:"bytes,6,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// Type is the type of the handler
+	Type string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	// Mutator is the name of the mutator of the workflow
+	Mutator string `protobuf:"bytes,8,opt,name=mutator,proto3" json:"mutator,omitempty"`
+	// MutatorDuration is the time, in seconds, it took to mutate the event
+	MutatorDuration float64 `protobuf:"fixed64,9,opt,name=mutator_duration,json=mutatorDuration,proto3" json:"mutator_duration,omitempty"`
+	// Executed describes the time at which the handler was executed, in
+	// seconds since the Unix epoch
+	Executed int64 `protobuf:"varint,10,opt,name=executed,proto3" json:"executed,omitempty"`
+	// Duration is the time, in seconds, it took to execute the handler
+	Duration float64 `protobuf:"fixed64,11,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Status is the exit status of a pipe handler
+	Status int32 `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
+	// Output is the output of a pipe handler, truncated to a maximum size
+	Output string `protobuf:"bytes,13,opt,name=output,proto3" json:"output,omitempty"`
+	// OutputTruncated indicates whether the output has been truncated
+	OutputTruncated bool `protobuf:"varint,14,opt,name=output_truncated,json=outputTruncated,proto3" json:"output_truncated,omitempty"`
+	// Error is the error returned by the mutator or the handler, if any
+	Error                string   `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandlerExecution) Reset()         { *m = HandlerExecution{} }
+func (m *HandlerExecution) String() string { return proto.CompactTextString(m) }
+func (*HandlerExecution) ProtoMessage()    {}
+func (*HandlerExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5fbde5194d90cfd, []int{0}
+}
+func (m *HandlerExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandlerExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandlerExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandlerExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandlerExecution.Merge(m, src)
+}
+func (m *HandlerExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandlerExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandlerExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandlerExecution proto.InternalMessageInfo
+
+func (m *HandlerExecution) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+func (m *HandlerExecution) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *HandlerExecution) GetCheck() string {
+	if m != nil {
+		return m.Check
+	}
+	return ""
+}
+
+func (m *HandlerExecution) GetPipeline() string {
+	if m != nil {
+		return m.Pipeline
+	}
+	return ""
+}
+
+func (m *HandlerExecution) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *HandlerExecution) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *HandlerExecution) GetMutator() string {
+	if m != nil {
+		return m.Mutator
+	}
+	return ""
+}
+
+func (m *HandlerExecution) GetMutatorDuration() float64 {
+	if m != nil {
+		return m.MutatorDuration
+	}
+	return 0
+}
+
+func (m *HandlerExecution) GetExecuted() int64 {
+	if m != nil {
+		return m.Executed
+	}
+	return 0
+}
+
+func (m *HandlerExecution) GetDuration() float64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *HandlerExecution) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *HandlerExecution) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func (m *HandlerExecution) GetOutputTruncated() bool {
+	if m != nil {
+		return m.OutputTruncated
+	}
+	return false
+}
+
+func (m *HandlerExecution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*HandlerExecution)(nil), "sensu.core.v2.HandlerExecution")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/handler_execution.proto", fileDescriptor_b5fbde5194d90cfd)
+}
+
+var fileDescriptor_b5fbde5194d90cfd = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x40, 0xbb, 0x4d, 0x93, 0xb8, 0x1b, 0x4a, 0xaa, 0x15, 0x42, 0x4b, 0x0e, 0x8e, 0xc5, 0x01,
+	0x19, 0x09, 0x6c, 0x9a, 0x72, 0x45, 0x42, 0x51, 0x2b, 0xd1, 0x03, 0x42, 0xb2, 0xe0, 0xc2, 0x25,
+	0xda, 0xd8, 0xdb, 0xc4, 0x34, 0xf6, 0x5a, 0x9b, 0x59, 0x97, 0xfc, 0x09, 0x9f, 0xc0, 0x27, 0xf0,
+	0x09, 0x3d, 0xf6, 0x0b, 0x2c, 0x30, 0x37, 0xbe, 0x00, 0x6e, 0x68, 0x77, 0x6d, 0x0b, 0x6e, 0xbd,
+	0x58, 0xf3, 0xde, 0xcc, 0xec, 0x8e, 0xc7, 0xc6, 0xaf, 0x56, 0x29, 0xac, 0xd5, 0x32, 0x88, 0x45,
+	0x16, 0x6e, 0x79, 0xbe, 0x55, 0xf6, 0xf9, 0x7c, 0x25, 0x42, 0x56, 0xa4, 0x61, 0x2c, 0x24, 0x0f,
+	0xcb, 0x59, 0xb8, 0x66, 0x79, 0xb2, 0xe1, 0x72, 0xc1, 0x3f, 0xf3, 0x58, 0x41, 0x2a, 0xf2, 0xa0,
+	0x90, 0x02, 0x04, 0x39, 0x32, 0xd5, 0x81, 0x2e, 0x0b, 0xca, 0xd9, 0xe4, 0xe5, 0x3f, 0xa7, 0xad,
+	0xc4, 0x4a, 0x84, 0xa6, 0x6a, 0xa9, 0x2e, 0x5f, 0x97, 0x27, 0xc1, 0x69, 0x70, 0x62, 0xa4, 0x71,
+	0x26, 0xb2, 0x87, 0x4c, 0x5e, 0xdc, 0x6d, 0x86, 0x8c, 0x03, 0xb3, 0x1d, 0x8f, 0xff, 0xf4, 0xf0,
+	0xf1, 0x1b, 0x3b, 0xd2, 0x79, 0x3b, 0x11, 0xf9, 0x80, 0x1d, 0x5d, 0x92, 0x30, 0x60, 0x14, 0x79,
+	0xc8, 0x1f, 0xcd, 0x1e, 0x05, 0xff, 0x8d, 0x17, 0xbc, 0x5b, 0x7e, 0xe2, 0x31, 0xbc, 0xe5, 0xc0,
+	0xe6, 0xee, 0x4d, 0x35, 0xdd, 0xbb, 0xad, 0xa6, 0xe8, 0x57, 0x35, 0x25, 0x6d, 0xdb, 0x33, 0x91,
+	0xa5, 0xc0, 0xb3, 0x02, 0x76, 0x51, 0x77, 0x14, 0x79, 0x82, 0x1d, 0x5e, 0xf2, 0x1c, 0x16, 0x69,
+	0x42, 0xf7, 0x3d, 0xe4, 0x1f, 0xce, 0x47, 0x75, 0x35, 0x1d, 0x9e, 0x6b, 0x77, 0x71, 0x16, 0x0d,
+	0x4d, 0xf2, 0x22, 0x21, 0x0f, 0xf1, 0x80, 0xe7, 0x90, 0xc2, 0x8e, 0xf6, 0x74, 0x55, 0xd4, 0x10,
+	0x79, 0x80, 0xfb, 0xf1, 0x9a, 0xc7, 0x57, 0xf4, 0xc0, 0x68, 0x0b, 0x64, 0x82, 0x9d, 0x22, 0x2d,
+	0xf8, 0x26, 0xcd, 0x39, 0xed, 0x9b, 0x44, 0xc7, 0x3a, 0x77, 0x2d, 0xe4, 0xd5, 0xe5, 0x46, 0x5c,
+	0xd3, 0x81, 0xcd, 0xb5, 0x4c, 0x08, 0x3e, 0x80, 0x5d, 0xc1, 0xe9, 0xd0, 0x78, 0x13, 0x13, 0x8a,
+	0x87, 0x99, 0x02, 0x06, 0x42, 0x52, 0xc7, 0xe8, 0x16, 0xc9, 0x53, 0x7c, 0xdc, 0x84, 0x8b, 0x44,
+	0x49, 0xa6, 0xd7, 0x44, 0x0f, 0x3d, 0xe4, 0xa3, 0x68, 0xdc, 0xf8, 0xb3, 0x46, 0xeb, 0x4b, 0xed,
+	0xc7, 0xe5, 0x09, 0xc5, 0x1e, 0xf2, 0x7b, 0x51, 0xc7, 0x3a, 0xd7, 0xb5, 0x8f, 0x4c, 0x7b, 0xc7,
+	0xfa, 0xb5, 0xb7, 0xc0, 0x40, 0x6d, 0xe9, 0x3d, 0x0f, 0xf9, 0xfd, 0xa8, 0x21, 0xed, 0x85, 0x82,
+	0x42, 0x01, 0x3d, 0xb2, 0xeb, 0xb0, 0xa4, 0x47, 0xb2, 0xd1, 0x02, 0xa4, 0xca, 0x63, 0xa6, 0xef,
+	0xbb, 0xef, 0x21, 0xdf, 0x89, 0xc6, 0xd6, 0xbf, 0x6f, 0xb5, 0xde, 0x1c, 0x97, 0x52, 0x48, 0x3a,
+	0xb6, 0x9b, 0x33, 0x30, 0xf7, 0x7e, 0xff, 0x70, 0xd1, 0xd7, 0xda, 0x45, 0xdf, 0x6a, 0x17, 0xdd,
+	0xd4, 0x2e, 0xba, 0xad, 0x5d, 0xf4, 0xbd, 0x76, 0xd1, 0x97, 0x9f, 0xee, 0xde, 0xc7, 0xfd, 0x72,
+	0xb6, 0x1c, 0x98, 0x9f, 0xe4, 0xf4, 0xef, 0x00, 0x04, 0x52, 0xbc, 0xa5, 0xdc, 0x02, 0x00, 0x00,
+}
+
+func (this *HandlerExecution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandlerExecution)
+	if !ok {
+		that2, ok := that.(HandlerExecution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.EventID != that1.EventID {
+		return false
+	}
+	if this.Entity != that1.Entity {
+		return false
+	}
+	if this.Check != that1.Check {
+		return false
+	}
+	if this.Pipeline != that1.Pipeline {
+		return false
+	}
+	if this.Workflow != that1.Workflow {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Mutator != that1.Mutator {
+		return false
+	}
+	if this.MutatorDuration != that1.MutatorDuration {
+		return false
+	}
+	if this.Executed != that1.Executed {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Output != that1.Output {
+		return false
+	}
+	if this.OutputTruncated != that1.OutputTruncated {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (m *HandlerExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandlerExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.OutputTruncated {
+		i--
+		if m.OutputTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Status != 0 {
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Duration != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Duration))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.Executed != 0 {
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(m.Executed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MutatorDuration != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MutatorDuration))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if len(m.Mutator) > 0 {
+		i -= len(m.Mutator)
+		copy(dAtA[i:], m.Mutator)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.Mutator)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Workflow) > 0 {
+		i -= len(m.Workflow)
+		copy(dAtA[i:], m.Workflow)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.Workflow)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Pipeline) > 0 {
+		i -= len(m.Pipeline)
+		copy(dAtA[i:], m.Pipeline)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.Pipeline)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Check) > 0 {
+		i -= len(m.Check)
+		copy(dAtA[i:], m.Check)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.Check)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Entity) > 0 {
+		i -= len(m.Entity)
+		copy(dAtA[i:], m.Entity)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.Entity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EventID) > 0 {
+		i -= len(m.EventID)
+		copy(dAtA[i:], m.EventID)
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(len(m.EventID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHandlerExecution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHandlerExecution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHandlerExecution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedHandlerExecution(r randyHandlerExecution, easy bool) *HandlerExecution {
+	this := &HandlerExecution{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.EventID = string(randStringHandlerExecution(r))
+	this.Entity = string(randStringHandlerExecution(r))
+	this.Check = string(randStringHandlerExecution(r))
+	this.Pipeline = string(randStringHandlerExecution(r))
+	this.Workflow = string(randStringHandlerExecution(r))
+	this.Type = string(randStringHandlerExecution(r))
+	this.Mutator = string(randStringHandlerExecution(r))
+	this.MutatorDuration = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MutatorDuration *= -1
+	}
+	this.Executed = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Executed *= -1
+	}
+	this.Duration = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Duration *= -1
+	}
+	this.Status = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Status *= -1
+	}
+	this.Output = string(randStringHandlerExecution(r))
+	this.OutputTruncated = bool(bool(r.Intn(2) == 0))
+	this.Error = string(randStringHandlerExecution(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedHandlerExecution(r, 16)
+	}
+	return this
+}
+
+type randyHandlerExecution interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneHandlerExecution(r randyHandlerExecution) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringHandlerExecution(r randyHandlerExecution) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RuneHandlerExecution(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedHandlerExecution(r randyHandlerExecution, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldHandlerExecution(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldHandlerExecution(dAtA []byte, r randyHandlerExecution, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateHandlerExecution(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulateHandlerExecution(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulateHandlerExecution(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateHandlerExecution(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateHandlerExecution(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateHandlerExecution(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateHandlerExecution(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *HandlerExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovHandlerExecution(uint64(l))
+	l = len(m.EventID)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	l = len(m.Entity)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	l = len(m.Check)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	l = len(m.Pipeline)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	l = len(m.Workflow)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	l = len(m.Mutator)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	if m.MutatorDuration != 0 {
+		n += 9
+	}
+	if m.Executed != 0 {
+		n += 1 + sovHandlerExecution(uint64(m.Executed))
+	}
+	if m.Duration != 0 {
+		n += 9
+	}
+	if m.Status != 0 {
+		n += 1 + sovHandlerExecution(uint64(m.Status))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	if m.OutputTruncated {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHandlerExecution(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovHandlerExecution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHandlerExecution(x uint64) (n int) {
+	return sovHandlerExecution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HandlerExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlerExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Check = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipeline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutatorDuration", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MutatorDuration = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			m.Executed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Duration = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutputTruncated = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlerExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlerExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHandlerExecution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHandlerExecution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHandlerExecution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHandlerExecution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHandlerExecution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHandlerExecution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHandlerExecution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHandlerExecution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHandlerExecution = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,44 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandlerExecution(t *testing.T) {
+	event := FixtureEvent("entity1", "check1")
+	h := NewHandlerExecution(event, "pagerduty")
+	assert.Equal(t, "pagerduty", h.Name)
+	assert.Equal(t, "default", h.Namespace)
+	assert.Equal(t, "entity1", h.Entity)
+	assert.Equal(t, "check1", h.Check)
+	assert.Equal(t, event.GetUUID().String(), h.EventID)
+}
+
+func TestHandlerExecutionSetOutput(t *testing.T) {
+	h := &HandlerExecution{}
+
+	h.SetOutput("hello", 10)
+	assert.Equal(t, "hello", h.Output)
+	assert.False(t, h.OutputTruncated)
+
+	h.SetOutput("hello world", 5)
+	assert.Equal(t, "hello", h.Output)
+	assert.True(t, h.OutputTruncated)
+
+	// The partial rune is removed
+	h.SetOutput("héllo", 2)
+	assert.Equal(t, "h", h.Output)
+	assert.True(t, h.OutputTruncated)
+
+	h.SetOutput("hello world", 0)
+	assert.Equal(t, "hello world", h.Output)
+	assert.False(t, h.OutputTruncated)
+}
+
+func TestHandlerExecutionSucceeded(t *testing.T) {
+	assert.True(t, (&HandlerExecution{}).Succeeded())
+	assert.False(t, (&HandlerExecution{Status: 2}).Succeeded())
+	assert.False(t, (&HandlerExecution{Error: "boom"}).Succeeded())
+}
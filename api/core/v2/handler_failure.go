@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxHandlerRetryBackoff is the maximum amount of time to wait before retrying
+// a failed handler execution.
+const MaxHandlerRetryBackoff = time.Hour
+
+// NewHandlerFailure initializes and returns a HandlerFailure for the given
+// handler, event and mutated data, failed with the given error.
+func NewHandlerFailure(handler *Handler, event *Event, mutatedData []byte, err error) *HandlerFailure {
+	now := time.Now().Unix()
+	f := &HandlerFailure{
+		ObjectMeta:   NewObjectMeta(uuid.New().String(), handler.Namespace),
+		Handler:      handler.Name,
+		Event:        event,
+		MutatedData:  mutatedData,
+		Attempts:     1,
+		Retries:      handler.Retries,
+		RetryBackoff: handler.RetryBackoff,
+		Created:      now,
+		LastAttempt:  now,
+	}
+	if err != nil {
+		f.Error = err.Error()
+	}
+	f.NextAttempt = now + int64(f.RetryDelay()/time.Second)
+	return f
+}
+
+// Exhausted returns true if the handler execution has been retried the
+// maximum number of times.
+func (f *HandlerFailure) Exhausted() bool {
+	return f.Attempts > f.Retries
+}
+
+// RetryDelay returns the amount of time to wait before the next retry. It
+// doubles after each retry, up to MaxHandlerRetryBackoff.
+func (f *HandlerFailure) RetryDelay() time.Duration {
+	delay := time.Duration(f.RetryBackoff) * time.Second
+	for i := uint32(1); i < f.Attempts && delay < MaxHandlerRetryBackoff; i++ {
+		delay *= 2
+	}
+	if delay > MaxHandlerRetryBackoff {
+		delay = MaxHandlerRetryBackoff
+	}
+	return delay
+}
@@ -0,0 +1,1061 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/handler_failure.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// HandlerFailure is a failed handler execution, which is either waiting to be
+// retried or, once its retries are exhausted, kept in the dead-letter list.
+type HandlerFailure struct {
+	// Metadata contains the name (a unique identifier), and namespace, labels
+	// and annotations of the handler failure
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Handler is the name of the failed handler
+	Handler string `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"`
+	// Pipeline is the name of the pipeline that ran the handler
+	Pipeline string `protobuf:"bytes,3,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// Workflow is the name of the pipeline workflow that ran the handler
+	Workflow string `protobuf:"bytes,4,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// Event is the handled event
+	Event *Event `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`
+	// MutatedData is the data the handler was given
+	MutatedData []byte `protobuf:"bytes,6,opt,name=mutated_data,json=mutatedData,proto3" json:"mutated_data,omitempty"`
+	// Attempts is the number of times the handler has been executed
+	Attempts uint32 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Retries is the maximum number of retries of the handler execution
+	Retries uint32 `protobuf:"varint,8,opt,name=retries,proto3" json:"retries,omitempty"`
+	// RetryBackoff is the number of seconds to wait before the next retry,
+	// which doubles after each retry
+	RetryBackoff uint32 `protobuf:"varint,9,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`
+	// Created is the time of the first failure, in seconds since the Unix epoch
+	Created int64 `protobuf:"varint,10,opt,name=created,proto3" json:"created,omitempty"`
+	// LastAttempt is the time of the last execution, in seconds since the Unix
+	// epoch
+	LastAttempt int64 `protobuf:"varint,11,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	// NextAttempt is the time of the next retry, in seconds since the Unix
+	// epoch
+	NextAttempt int64 `protobuf:"varint,12,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"`
+	// Error is the error returned by the last execution of the handler
+	Error                string   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandlerFailure) Reset()         { *m = HandlerFailure{} }
+func (m *HandlerFailure) String() string { return proto.CompactTextString(m) }
+func (*HandlerFailure) ProtoMessage()    {}
+func (*HandlerFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d716ce20105572d, []int{0}
+}
+func (m *HandlerFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandlerFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandlerFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandlerFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandlerFailure.Merge(m, src)
+}
+func (m *HandlerFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandlerFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandlerFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandlerFailure proto.InternalMessageInfo
+
+func (m *HandlerFailure) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *HandlerFailure) GetPipeline() string {
+	if m != nil {
+		return m.Pipeline
+	}
+	return ""
+}
+
+func (m *HandlerFailure) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *HandlerFailure) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *HandlerFailure) GetMutatedData() []byte {
+	if m != nil {
+		return m.MutatedData
+	}
+	return nil
+}
+
+func (m *HandlerFailure) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *HandlerFailure) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *HandlerFailure) GetRetryBackoff() uint32 {
+	if m != nil {
+		return m.RetryBackoff
+	}
+	return 0
+}
+
+func (m *HandlerFailure) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *HandlerFailure) GetLastAttempt() int64 {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return 0
+}
+
+func (m *HandlerFailure) GetNextAttempt() int64 {
+	if m != nil {
+		return m.NextAttempt
+	}
+	return 0
+}
+
+func (m *HandlerFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*HandlerFailure)(nil), "sensu.core.v2.HandlerFailure")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/handler_failure.proto", fileDescriptor_6d716ce20105572d)
+}
+
+var fileDescriptor_6d716ce20105572d = []byte{
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3d, 0x72, 0xd3, 0x40,
+	0x14, 0xce, 0xc6, 0x71, 0xe2, 0xac, 0x6d, 0x0a, 0x4d, 0x8a, 0xc5, 0x85, 0x2c, 0xa0, 0x51, 0x01,
+	0x52, 0xec, 0xd0, 0xd1, 0x80, 0x07, 0x18, 0x1a, 0x86, 0x19, 0xcd, 0xd0, 0xd0, 0x78, 0x56, 0xf2,
+	0x93, 0x23, 0x22, 0x69, 0x35, 0xab, 0x95, 0x42, 0x6e, 0xc2, 0x11, 0x38, 0x02, 0x47, 0x70, 0x99,
+	0x13, 0x18, 0x10, 0x1d, 0x27, 0xa0, 0x64, 0xf6, 0xad, 0x64, 0x20, 0x95, 0x1b, 0xcd, 0x7e, 0x3f,
+	0xef, 0xed, 0xf7, 0xed, 0x88, 0x3e, 0x5b, 0x27, 0xea, 0xb2, 0x0a, 0xbd, 0x48, 0x64, 0x7e, 0x09,
+	0x79, 0x59, 0x99, 0xef, 0x93, 0xb5, 0xf0, 0x79, 0x91, 0xf8, 0x91, 0x90, 0xe0, 0xd7, 0x73, 0xff,
+	0x92, 0xe7, 0xab, 0x14, 0xe4, 0x32, 0xe6, 0x49, 0x5a, 0x49, 0xf0, 0x0a, 0x29, 0x94, 0xb0, 0xc6,
+	0xe8, 0xf5, 0xb4, 0xc9, 0xab, 0xe7, 0x93, 0xa7, 0xff, 0xec, 0x5a, 0x8b, 0xb5, 0xf0, 0xd1, 0x15,
+	0x56, 0xf1, 0xf3, 0x7a, 0xe6, 0x5d, 0x78, 0x33, 0x24, 0x91, 0xc3, 0x93, 0x59, 0x32, 0x39, 0xdf,
+	0x2f, 0x41, 0x06, 0x8a, 0xb7, 0x13, 0xb3, 0xfd, 0x26, 0xa0, 0x86, 0x5c, 0x99, 0x91, 0x87, 0xdf,
+	0x7a, 0xf4, 0xde, 0x1b, 0xd3, 0xe1, 0xb5, 0xa9, 0x60, 0xbd, 0xa7, 0x03, 0xbd, 0x73, 0xc5, 0x15,
+	0x67, 0xc4, 0x21, 0xee, 0x70, 0x7e, 0xdf, 0xfb, 0xaf, 0x8f, 0xf7, 0x2e, 0xfc, 0x08, 0x91, 0x7a,
+	0x0b, 0x8a, 0x2f, 0xec, 0xcd, 0x76, 0x7a, 0x70, 0xbb, 0x9d, 0x92, 0x5f, 0xdb, 0xa9, 0xd5, 0x8d,
+	0x3d, 0x16, 0x59, 0xa2, 0x20, 0x2b, 0xd4, 0x4d, 0xb0, 0x5b, 0x65, 0x31, 0x7a, 0xd2, 0x3e, 0x16,
+	0x3b, 0x74, 0x88, 0x7b, 0x1a, 0x74, 0xd0, 0x9a, 0xd0, 0x41, 0x91, 0x14, 0x90, 0x26, 0x39, 0xb0,
+	0x1e, 0x4a, 0x3b, 0xac, 0xb5, 0x6b, 0x21, 0xaf, 0xe2, 0x54, 0x5c, 0xb3, 0x23, 0xa3, 0x75, 0xd8,
+	0x3a, 0xa7, 0x7d, 0xac, 0xc2, 0xfa, 0x98, 0xf2, 0xec, 0x4e, 0xca, 0x57, 0x5a, 0x5b, 0x1c, 0x6d,
+	0xb6, 0x53, 0x12, 0x18, 0xa3, 0xf5, 0x80, 0x8e, 0xb2, 0x4a, 0x71, 0x05, 0xab, 0x25, 0xd6, 0x3b,
+	0x76, 0x88, 0x3b, 0x0a, 0x86, 0x2d, 0xf7, 0x52, 0xc7, 0x9c, 0xd0, 0x01, 0x57, 0x18, 0xbe, 0x64,
+	0x27, 0x0e, 0x71, 0xc7, 0xc1, 0x0e, 0xeb, 0x0a, 0x12, 0x94, 0x4c, 0xa0, 0x64, 0x03, 0x94, 0x3a,
+	0x68, 0x3d, 0xa2, 0x63, 0x7d, 0xbc, 0x59, 0x86, 0x3c, 0xba, 0x12, 0x71, 0xcc, 0x4e, 0x51, 0x1f,
+	0x21, 0xb9, 0x30, 0x9c, 0x1e, 0x8f, 0x24, 0xe8, 0x9b, 0x18, 0x75, 0x88, 0xdb, 0x0b, 0x3a, 0xa8,
+	0x73, 0xa5, 0xbc, 0x54, 0xcb, 0xf6, 0x26, 0x36, 0x44, 0x79, 0xa8, 0xb9, 0x17, 0x86, 0xd2, 0x96,
+	0x1c, 0x3e, 0xfd, 0xb5, 0x8c, 0x8c, 0x45, 0x73, 0x9d, 0xe5, 0x8c, 0xf6, 0x41, 0x4a, 0x21, 0xd9,
+	0x18, 0x1f, 0xca, 0x80, 0x85, 0xf3, 0xfb, 0x87, 0x4d, 0xbe, 0x34, 0x36, 0xf9, 0xda, 0xd8, 0x64,
+	0xd3, 0xd8, 0xe4, 0xb6, 0xb1, 0xc9, 0xf7, 0xc6, 0x26, 0x9f, 0x7f, 0xda, 0x07, 0x1f, 0x0e, 0xeb,
+	0x79, 0x78, 0x8c, 0xbf, 0xc2, 0xc5, 0x9f, 0x01, 0x00, 0xcb, 0xfb, 0xd6, 0x61, 0xf3, 0x02, 0x00,
+	0x00,
+}
+
+func (this *HandlerFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandlerFailure)
+	if !ok {
+		that2, ok := that.(HandlerFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Handler != that1.Handler {
+		return false
+	}
+	if this.Pipeline != that1.Pipeline {
+		return false
+	}
+	if this.Workflow != that1.Workflow {
+		return false
+	}
+	if !this.Event.Equal(that1.Event) {
+		return false
+	}
+	if !bytes.Equal(this.MutatedData, that1.MutatedData) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.Retries != that1.Retries {
+		return false
+	}
+	if this.RetryBackoff != that1.RetryBackoff {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if this.LastAttempt != that1.LastAttempt {
+		return false
+	}
+	if this.NextAttempt != that1.NextAttempt {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (m *HandlerFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandlerFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.NextAttempt != 0 {
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(m.NextAttempt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastAttempt != 0 {
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(m.LastAttempt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Created != 0 {
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RetryBackoff != 0 {
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(m.RetryBackoff))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Retries != 0 {
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MutatedData) > 0 {
+		i -= len(m.MutatedData)
+		copy(dAtA[i:], m.MutatedData)
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(len(m.MutatedData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandlerFailure(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Workflow) > 0 {
+		i -= len(m.Workflow)
+		copy(dAtA[i:], m.Workflow)
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(len(m.Workflow)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Pipeline) > 0 {
+		i -= len(m.Pipeline)
+		copy(dAtA[i:], m.Pipeline)
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(len(m.Pipeline)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Handler) > 0 {
+		i -= len(m.Handler)
+		copy(dAtA[i:], m.Handler)
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(len(m.Handler)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHandlerFailure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHandlerFailure(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHandlerFailure(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedHandlerFailure(r randyHandlerFailure, easy bool) *HandlerFailure {
+	this := &HandlerFailure{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Handler = string(randStringHandlerFailure(r))
+	this.Pipeline = string(randStringHandlerFailure(r))
+	this.Workflow = string(randStringHandlerFailure(r))
+	if r.Intn(5) != 0 {
+		this.Event = NewPopulatedEvent(r, easy)
+	}
+	v2 := r.Intn(100)
+	this.MutatedData = make([]byte, v2)
+	for i := 0; i < v2; i++ {
+		this.MutatedData[i] = byte(r.Intn(256))
+	}
+	this.Attempts = uint32(r.Uint32())
+	this.Retries = uint32(r.Uint32())
+	this.RetryBackoff = uint32(r.Uint32())
+	this.Created = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Created *= -1
+	}
+	this.LastAttempt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastAttempt *= -1
+	}
+	this.NextAttempt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.NextAttempt *= -1
+	}
+	this.Error = string(randStringHandlerFailure(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedHandlerFailure(r, 14)
+	}
+	return this
+}
+
+type randyHandlerFailure interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneHandlerFailure(r randyHandlerFailure) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringHandlerFailure(r randyHandlerFailure) string {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
+		tmps[i] = randUTF8RuneHandlerFailure(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedHandlerFailure(r randyHandlerFailure, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldHandlerFailure(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldHandlerFailure(dAtA []byte, r randyHandlerFailure, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateHandlerFailure(dAtA, uint64(key))
+		v4 := r.Int63()
+		if r.Intn(2) == 0 {
+			v4 *= -1
+		}
+		dAtA = encodeVarintPopulateHandlerFailure(dAtA, uint64(v4))
+	case 1:
+		dAtA = encodeVarintPopulateHandlerFailure(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateHandlerFailure(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateHandlerFailure(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateHandlerFailure(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateHandlerFailure(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *HandlerFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovHandlerFailure(uint64(l))
+	l = len(m.Handler)
+	if l > 0 {
+		n += 1 + l + sovHandlerFailure(uint64(l))
+	}
+	l = len(m.Pipeline)
+	if l > 0 {
+		n += 1 + l + sovHandlerFailure(uint64(l))
+	}
+	l = len(m.Workflow)
+	if l > 0 {
+		n += 1 + l + sovHandlerFailure(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovHandlerFailure(uint64(l))
+	}
+	l = len(m.MutatedData)
+	if l > 0 {
+		n += 1 + l + sovHandlerFailure(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovHandlerFailure(uint64(m.Attempts))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovHandlerFailure(uint64(m.Retries))
+	}
+	if m.RetryBackoff != 0 {
+		n += 1 + sovHandlerFailure(uint64(m.RetryBackoff))
+	}
+	if m.Created != 0 {
+		n += 1 + sovHandlerFailure(uint64(m.Created))
+	}
+	if m.LastAttempt != 0 {
+		n += 1 + sovHandlerFailure(uint64(m.LastAttempt))
+	}
+	if m.NextAttempt != 0 {
+		n += 1 + sovHandlerFailure(uint64(m.NextAttempt))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHandlerFailure(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovHandlerFailure(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHandlerFailure(x uint64) (n int) {
+	return sovHandlerFailure(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HandlerFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlerFailure
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipeline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutatedData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MutatedData = append(m.MutatedData[:0], dAtA[iNdEx:postIndex]...)
+			if m.MutatedData == nil {
+				m.MutatedData = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoff", wireType)
+			}
+			m.RetryBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoff |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttempt", wireType)
+			}
+			m.LastAttempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAttempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttempt", wireType)
+			}
+			m.NextAttempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAttempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlerFailure(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlerFailure
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHandlerFailure(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHandlerFailure
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHandlerFailure
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHandlerFailure
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHandlerFailure
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHandlerFailure
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHandlerFailure        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHandlerFailure          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHandlerFailure = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandlerFailure(t *testing.T) {
+	handler := FixtureHandler("pagerduty")
+	handler.Retries = 2
+	handler.RetryBackoff = 10
+	event := FixtureEvent("entity1", "check1")
+
+	f := NewHandlerFailure(handler, event, []byte("data"), errors.New("boom"))
+	assert.NotEmpty(t, f.Name)
+	assert.Equal(t, "default", f.Namespace)
+	assert.Equal(t, "pagerduty", f.Handler)
+	assert.Equal(t, event, f.Event)
+	assert.Equal(t, []byte("data"), f.MutatedData)
+	assert.Equal(t, uint32(1), f.Attempts)
+	assert.Equal(t, "boom", f.Error)
+	assert.Equal(t, f.LastAttempt+10, f.NextAttempt)
+	assert.False(t, f.Exhausted())
+}
+
+func TestHandlerFailureExhausted(t *testing.T) {
+	f := &HandlerFailure{Retries: 2, Attempts: 2}
+	assert.False(t, f.Exhausted())
+	f.Attempts = 3
+	assert.True(t, f.Exhausted())
+}
+
+func TestHandlerFailureRetryDelay(t *testing.T) {
+	f := &HandlerFailure{RetryBackoff: 10, Attempts: 1}
+	assert.Equal(t, 10*time.Second, f.RetryDelay())
+	f.Attempts = 3
+	assert.Equal(t, 40*time.Second, f.RetryDelay())
+	f.Attempts = 100
+	assert.Equal(t, MaxHandlerRetryBackoff, f.RetryDelay())
+	f.RetryBackoff = 0
+	assert.Equal(t, time.Duration(0), f.RetryDelay())
+}
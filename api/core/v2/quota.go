@@ -0,0 +1,248 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	cron "github.com/robfig/cron/v3"
+	stringsutil "github.com/sensu/sensu-go/api/core/v2/internal/stringutil"
+)
+
+const (
+	// QuotasResource is the name of this resource type
+	QuotasResource = "quotas"
+
+	// QuotaUsagePath is the path, relative to the quotas of a namespace, of the
+	// usage of the quotas. It can't be used as the name of a quota.
+	QuotaUsagePath = "usage"
+)
+
+// QuotaResources are the resources limited by quotas, by RBAC name.
+var QuotaResources = []string{
+	"entities",
+	"checks",
+	"handlers",
+	"silenced",
+	"events",
+}
+
+// GetObjectMeta returns the object metadata for the resource.
+func (q *Quota) GetObjectMeta() ObjectMeta {
+	return q.ObjectMeta
+}
+
+// SetObjectMeta sets the object metadata for the resource.
+func (q *Quota) SetObjectMeta(meta ObjectMeta) {
+	q.ObjectMeta = meta
+}
+
+// SetNamespace sets the namespace of the resource.
+func (q *Quota) SetNamespace(namespace string) {
+	q.Namespace = namespace
+}
+
+// StorePrefix returns the path prefix to this resource in the store.
+func (q *Quota) StorePrefix() string {
+	return QuotasResource
+}
+
+// RBACName describes the name of the resource for RBAC purposes.
+func (q *Quota) RBACName() string {
+	return QuotasResource
+}
+
+// URIPath gives the path component of a quota URI.
+func (q *Quota) URIPath() string {
+	if q.Namespace == "" {
+		return path.Join(URLPrefix, QuotasResource, url.PathEscape(q.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(q.Namespace), QuotasResource, url.PathEscape(q.Name))
+}
+
+// Validate checks if a quota passes validation rules.
+func (q *Quota) Validate() error {
+	if err := ValidateName(q.Name); err != nil {
+		return errors.New("name " + err.Error())
+	}
+	if q.Name == QuotaUsagePath {
+		return fmt.Errorf("name %q is reserved", QuotaUsagePath)
+	}
+	if q.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+	return nil
+}
+
+// Limit returns the limit of the quota on the number of resources of the
+// given RBAC name, 0 if there is none.
+func (q *Quota) Limit(resource string) uint32 {
+	switch resource {
+	case "entities":
+		return q.Entities
+	case "checks":
+		return q.Checks
+	case "handlers":
+		return q.Handlers
+	case "silenced":
+		return q.Silenced
+	case "events":
+		return q.Events
+	}
+	return 0
+}
+
+// QuotaFields returns a set of fields that represent that resource.
+func QuotaFields(r Resource) map[string]string {
+	resource := r.(*Quota)
+	fields := map[string]string{
+		"quota.name":      resource.ObjectMeta.Name,
+		"quota.namespace": resource.ObjectMeta.Namespace,
+	}
+	stringsutil.MergeMapWithPrefix(fields, resource.ObjectMeta.Labels, "quota.labels.")
+	return fields
+}
+
+// FixtureQuota returns a testing fixture for a Quota object.
+func FixtureQuota(name string) *Quota {
+	return &Quota{
+		ObjectMeta:       NewObjectMeta(name, "default"),
+		Entities:         100,
+		Checks:           50,
+		Handlers:         10,
+		Silenced:         20,
+		Events:           1000,
+		MinCheckInterval: 10,
+	}
+}
+
+// ResourceUsage is the number of resources of a kind in a namespace, and the
+// limit of its quotas.
+type ResourceUsage struct {
+	// Resource is the RBAC name of the resources
+	Resource string `json:"resource" yaml:"resource"`
+
+	// Used is the number of resources
+	Used int64 `json:"used" yaml:"used"`
+
+	// Limit is the lowest limit of the quotas, 0 if there is none
+	Limit uint32 `json:"limit,omitempty" yaml:"limit,omitempty"`
+}
+
+// QuotaUsage is the usage of the quotas of a namespace.
+type QuotaUsage struct {
+	// Namespace is the name of the namespace
+	Namespace string `json:"namespace" yaml:"namespace"`
+
+	// Quotas are the names of the quotas of the namespace
+	Quotas []string `json:"quotas" yaml:"quotas"`
+
+	// Resources are the usage of the limited resources, in the order of
+	// QuotaResources
+	Resources []ResourceUsage `json:"resources" yaml:"resources"`
+
+	// MinCheckInterval is the highest minimum check interval of the quotas, in
+	// seconds
+	MinCheckInterval uint32 `json:"min_check_interval,omitempty" yaml:"min_check_interval,omitempty"`
+}
+
+// NewQuotaUsage returns the limits of the given quotas of a namespace, with no
+// resources used.
+func NewQuotaUsage(namespace string, quotas []*Quota) *QuotaUsage {
+	usage := &QuotaUsage{
+		Namespace: namespace,
+		Quotas:    []string{},
+		Resources: make([]ResourceUsage, 0, len(QuotaResources)),
+	}
+	for _, resource := range QuotaResources {
+		usage.Resources = append(usage.Resources, ResourceUsage{Resource: resource})
+	}
+	for _, quota := range quotas {
+		usage.Quotas = append(usage.Quotas, quota.Name)
+		for i := range usage.Resources {
+			limit := quota.Limit(usage.Resources[i].Resource)
+			if limit > 0 && (usage.Resources[i].Limit == 0 || limit < usage.Resources[i].Limit) {
+				usage.Resources[i].Limit = limit
+			}
+		}
+		if quota.MinCheckInterval > usage.MinCheckInterval {
+			usage.MinCheckInterval = quota.MinCheckInterval
+		}
+	}
+	return usage
+}
+
+// Limit returns the limit on the number of resources of the given RBAC name,
+// 0 if there is none.
+func (u *QuotaUsage) Limit(resource string) uint32 {
+	for _, r := range u.Resources {
+		if r.Resource == resource {
+			return r.Limit
+		}
+	}
+	return 0
+}
+
+// CheckInterval returns an error if the check is scheduled more frequently
+// than allowed by the minimum check interval.
+func (u *QuotaUsage) CheckInterval(check *CheckConfig) error {
+	if u.MinCheckInterval == 0 {
+		return nil
+	}
+	interval := time.Duration(check.Interval) * time.Second
+	if check.Cron != "" {
+		schedule, err := cron.ParseStandard(check.Cron)
+		if err != nil {
+			return errors.New("check cron string is invalid")
+		}
+		interval = cronMinInterval(schedule)
+	}
+	if min := time.Duration(u.MinCheckInterval) * time.Second; interval < min {
+		return fmt.Errorf("checks must be scheduled at intervals of at least %s", min)
+	}
+	return nil
+}
+
+// cronMinInterval returns the shortest interval between two runs of a cron
+// schedule. The runs of a day are all considered, along with the interval
+// between the last run of a day and the first run of the next day, so the
+// interval does not depend on when the schedule is checked.
+func cronMinInterval(schedule cron.Schedule) time.Duration {
+	switch schedule := schedule.(type) {
+	case cron.ConstantDelaySchedule:
+		return schedule.Delay
+	case *cron.SpecSchedule:
+		const day = 24 * 60 * 60
+		var first, last, min = -1, -1, day
+		for hour := 0; hour < 24; hour++ {
+			if schedule.Hour&(1<<uint(hour)) == 0 {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if schedule.Minute&(1<<uint(minute)) == 0 {
+					continue
+				}
+				for second := 0; second < 60; second++ {
+					if schedule.Second&(1<<uint(second)) == 0 {
+						continue
+					}
+					run := hour*60*60 + minute*60 + second
+					if first < 0 {
+						first = run
+					} else if run-last < min {
+						min = run - last
+					}
+					last = run
+				}
+			}
+		}
+		if first >= 0 && first+day-last < min {
+			min = first + day - last
+		}
+		return time.Duration(min) * time.Second
+	}
+	next := schedule.Next(time.Now())
+	return schedule.Next(next).Sub(next)
+}
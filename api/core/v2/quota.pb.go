@@ -0,0 +1,695 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/sensu/sensu-go/api/core/v2/quota.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Quota limits the resources of a namespace. When a namespace has several
+// quotas, all of them are enforced. The limits are unset, meaning unlimited,
+// when zero.
+type Quota struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// quota
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Entities is the maximum number of entities of the namespace
+	Entities uint32 `protobuf:"varint,2,opt,name=entities,proto3" json:"entities,omitempty"`
+	// Checks is the maximum number of checks of the namespace
+	Checks uint32 `protobuf:"varint,3,opt,name=checks,proto3" json:"checks,omitempty"`
+	// Handlers is the maximum number of handlers of the namespace
+	Handlers uint32 `protobuf:"varint,4,opt,name=handlers,proto3" json:"handlers,omitempty"`
+	// Silenced is the maximum number of silenced entries of the namespace
+	Silenced uint32 `protobuf:"varint,5,opt,name=silenced,proto3" json:"silenced,omitempty"`
+	// Events is the maximum number of events of the namespace, one per entity
+	// and check
+	Events uint32 `protobuf:"varint,6,opt,name=events,proto3" json:"events,omitempty"`
+	// MinCheckInterval is the minimum interval of the checks of the namespace,
+	// in seconds. Cron schedules are limited by the interval between their next
+	// two executions.
+	MinCheckInterval     uint32   `protobuf:"varint,7,opt,name=min_check_interval,json=minCheckInterval,proto3" json:"min_check_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Quota) Reset()         { *m = Quota{} }
+func (m *Quota) String() string { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()    {}
+func (*Quota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7461fca39cc0daeb, []int{0}
+}
+func (m *Quota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Quota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Quota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Quota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quota.Merge(m, src)
+}
+func (m *Quota) XXX_Size() int {
+	return m.Size()
+}
+func (m *Quota) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quota proto.InternalMessageInfo
+
+func (m *Quota) GetEntities() uint32 {
+	if m != nil {
+		return m.Entities
+	}
+	return 0
+}
+
+func (m *Quota) GetChecks() uint32 {
+	if m != nil {
+		return m.Checks
+	}
+	return 0
+}
+
+func (m *Quota) GetHandlers() uint32 {
+	if m != nil {
+		return m.Handlers
+	}
+	return 0
+}
+
+func (m *Quota) GetSilenced() uint32 {
+	if m != nil {
+		return m.Silenced
+	}
+	return 0
+}
+
+func (m *Quota) GetEvents() uint32 {
+	if m != nil {
+		return m.Events
+	}
+	return 0
+}
+
+func (m *Quota) GetMinCheckInterval() uint32 {
+	if m != nil {
+		return m.MinCheckInterval
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Quota)(nil), "sensu.core.v2.Quota")
+}
+
+func init() {
+	proto.RegisterFile("github.com/sensu/sensu-go/api/core/v2/quota.proto", fileDescriptor_7461fca39cc0daeb)
+}
+
+var fileDescriptor_7461fca39cc0daeb = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x6a, 0xdb, 0x40,
+	0x14, 0x86, 0x3d, 0x76, 0xed, 0x1a, 0x15, 0x83, 0x11, 0xa5, 0xa8, 0xa6, 0x8c, 0x44, 0x57, 0x5e,
+	0xb8, 0xa3, 0x5a, 0xee, 0x01, 0x8a, 0xbb, 0xea, 0x22, 0x09, 0x09, 0x64, 0x93, 0x8d, 0x91, 0xe4,
+	0x17, 0x79, 0x12, 0x6b, 0xc6, 0x91, 0x46, 0x82, 0xdc, 0x24, 0x27, 0x08, 0x39, 0x42, 0x8e, 0xe0,
+	0xa5, 0x4f, 0x20, 0x12, 0x65, 0xe7, 0x13, 0x64, 0x19, 0x66, 0xe4, 0x89, 0x15, 0xb2, 0xc9, 0x46,
+	0x88, 0xef, 0xbd, 0xef, 0xfd, 0x3f, 0x8c, 0x31, 0x8e, 0xa8, 0x58, 0x64, 0x01, 0x09, 0x79, 0xec,
+	0xa6, 0xc0, 0xd2, 0xac, 0xfa, 0xfe, 0x8a, 0xb8, 0xeb, 0xaf, 0xa8, 0x1b, 0xf2, 0x04, 0xdc, 0xdc,
+	0x73, 0xaf, 0x32, 0x2e, 0x7c, 0xb2, 0x4a, 0xb8, 0xe0, 0x66, 0x4f, 0x6d, 0x10, 0x39, 0x22, 0xb9,
+	0x37, 0xf8, 0x53, 0xbb, 0x10, 0xf1, 0x88, 0xbb, 0x6a, 0x2b, 0xc8, 0xce, 0xff, 0xe6, 0x63, 0x32,
+	0x21, 0x63, 0x05, 0x15, 0x53, 0x7f, 0xd5, 0x91, 0xc1, 0xef, 0x8f, 0xe5, 0xc6, 0xa0, 0x63, 0x7f,
+	0xde, 0xb6, 0x8c, 0xf6, 0xb1, 0xac, 0x61, 0x9e, 0x1a, 0x5d, 0xc9, 0xe7, 0xbe, 0xf0, 0x2d, 0xe4,
+	0xa0, 0xe1, 0x17, 0xef, 0x3b, 0x79, 0xd3, 0x89, 0x1c, 0x05, 0x17, 0x10, 0x8a, 0x03, 0x10, 0xfe,
+	0x14, 0xaf, 0x0b, 0xbb, 0xb1, 0x29, 0x6c, 0xb4, 0x2d, 0x6c, 0x53, 0x6b, 0x23, 0x1e, 0x53, 0x01,
+	0xf1, 0x4a, 0x5c, 0x9f, 0xbc, 0x9e, 0x32, 0x3d, 0xa3, 0x0b, 0x4c, 0x50, 0x41, 0x21, 0xb5, 0x9a,
+	0x0e, 0x1a, 0xf6, 0xa6, 0xdf, 0xa4, 0xa3, 0x59, 0xdd, 0xd1, 0xcc, 0x1c, 0x19, 0x9d, 0x70, 0x01,
+	0xe1, 0x65, 0x6a, 0xb5, 0x94, 0xf1, 0x75, 0x5b, 0xd8, 0xfd, 0x8a, 0xd4, 0xf6, 0x77, 0x3b, 0x32,
+	0x61, 0xe1, 0xb3, 0xf9, 0x12, 0x92, 0xd4, 0xfa, 0xb4, 0x4f, 0xd0, 0xac, 0x9e, 0xa0, 0x99, 0x74,
+	0x52, 0xba, 0x04, 0x16, 0xc2, 0xdc, 0x6a, 0xef, 0x1d, 0xcd, 0xea, 0x8e, 0x66, 0xb2, 0x15, 0xe4,
+	0xc0, 0x44, 0x6a, 0x75, 0xf6, 0xad, 0x2a, 0x52, 0x6f, 0x55, 0x11, 0xf3, 0xd0, 0x30, 0x63, 0xca,
+	0x66, 0xaa, 0xe3, 0x8c, 0x32, 0x01, 0x49, 0xee, 0x2f, 0xad, 0xcf, 0xca, 0x74, 0xb6, 0x85, 0xfd,
+	0xe3, 0xfd, 0xb4, 0x76, 0xa5, 0x1f, 0x53, 0xf6, 0x4f, 0x0e, 0xff, 0xef, 0x66, 0x53, 0xe7, 0xf9,
+	0x11, 0xa3, 0xbb, 0x12, 0xa3, 0xfb, 0x12, 0xa3, 0x75, 0x89, 0xd1, 0xa6, 0xc4, 0xe8, 0xa1, 0xc4,
+	0xe8, 0xe6, 0x09, 0x37, 0xce, 0x9a, 0xb9, 0x17, 0x74, 0xd4, 0x8b, 0x4e, 0x5e, 0x06, 0x00, 0x2c,
+	0x48, 0x7e, 0xf3, 0x7d, 0x02, 0x00, 0x00,
+}
+
+func (m *Quota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MinCheckInterval != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.MinCheckInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Events != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Events))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Silenced != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Silenced))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Handlers != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Handlers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Checks != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Checks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Entities != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Entities))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedQuota(r randyQuota, easy bool) *Quota {
+	this := &Quota{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Entities = uint32(r.Uint32())
+	this.Checks = uint32(r.Uint32())
+	this.Handlers = uint32(r.Uint32())
+	this.Silenced = uint32(r.Uint32())
+	this.Events = uint32(r.Uint32())
+	this.MinCheckInterval = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedQuota(r, 8)
+	}
+	return this
+}
+
+type randyQuota interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneQuota(r randyQuota) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringQuota(r randyQuota) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RuneQuota(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedQuota(r randyQuota, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldQuota(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldQuota(dAtA []byte, r randyQuota, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateQuota(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulateQuota(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulateQuota(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateQuota(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateQuota(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateQuota(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateQuota(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (this *Quota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Quota)
+	if !ok {
+		that2, ok := that.(Quota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Entities != that1.Entities {
+		return false
+	}
+	if this.Checks != that1.Checks {
+		return false
+	}
+	if this.Handlers != that1.Handlers {
+		return false
+	}
+	if this.Silenced != that1.Silenced {
+		return false
+	}
+	if this.Events != that1.Events {
+		return false
+	}
+	if this.MinCheckInterval != that1.MinCheckInterval {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (m *Quota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	if m.Entities != 0 {
+		n += 1 + sovQuota(uint64(m.Entities))
+	}
+	if m.Checks != 0 {
+		n += 1 + sovQuota(uint64(m.Checks))
+	}
+	if m.Handlers != 0 {
+		n += 1 + sovQuota(uint64(m.Handlers))
+	}
+	if m.Silenced != 0 {
+		n += 1 + sovQuota(uint64(m.Silenced))
+	}
+	if m.Events != 0 {
+		n += 1 + sovQuota(uint64(m.Events))
+	}
+	if m.MinCheckInterval != 0 {
+		n += 1 + sovQuota(uint64(m.MinCheckInterval))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovQuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuota(x uint64) (n int) {
+	return sovQuota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+			}
+			m.Entities = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entities |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			m.Checks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			m.Handlers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Handlers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Silenced", wireType)
+			}
+			m.Silenced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Silenced |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			m.Events = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Events |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCheckInterval", wireType)
+			}
+			m.MinCheckInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCheckInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuota = fmt.Errorf("proto: unexpected end of group")
+)
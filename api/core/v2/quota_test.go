@@ -0,0 +1,114 @@
+package v2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		quota   *Quota
+		wantErr bool
+	}{
+		{
+			name:  "valid quota",
+			quota: FixtureQuota("quota"),
+		},
+		{
+			name:    "missing namespace",
+			quota:   &Quota{ObjectMeta: ObjectMeta{Name: "quota"}},
+			wantErr: true,
+		},
+		{
+			name:    "reserved name",
+			quota:   FixtureQuota(QuotaUsagePath),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.quota.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Quota.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewQuotaUsage(t *testing.T) {
+	a := &Quota{ObjectMeta: NewObjectMeta("a", "default"), Entities: 10, Checks: 5, MinCheckInterval: 30}
+	b := &Quota{ObjectMeta: NewObjectMeta("b", "default"), Entities: 20, Handlers: 3, MinCheckInterval: 60}
+
+	usage := NewQuotaUsage("default", []*Quota{a, b})
+	want := &QuotaUsage{
+		Namespace: "default",
+		Quotas:    []string{"a", "b"},
+		Resources: []ResourceUsage{
+			{Resource: "entities", Limit: 10},
+			{Resource: "checks", Limit: 5},
+			{Resource: "handlers", Limit: 3},
+			{Resource: "silenced"},
+			{Resource: "events"},
+		},
+		MinCheckInterval: 60,
+	}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("NewQuotaUsage() = %#v, want %#v", usage, want)
+	}
+	if got := usage.Limit("handlers"); got != 3 {
+		t.Errorf("QuotaUsage.Limit() = %d, want 3", got)
+	}
+}
+
+func TestQuotaUsageCheckInterval(t *testing.T) {
+	usage := &QuotaUsage{MinCheckInterval: 60}
+	tests := []struct {
+		name     string
+		interval uint32
+		cron     string
+		wantErr  bool
+	}{
+		{name: "allowed interval", interval: 60},
+		{name: "interval too short", interval: 10, wantErr: true},
+		{name: "allowed cron", cron: "*/5 * * * *"},
+		{name: "cron too frequent", cron: "@every 30s", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := FixtureCheckConfig("check")
+			check.Interval = tt.interval
+			check.Cron = tt.cron
+			if err := usage.CheckInterval(check); (err != nil) != tt.wantErr {
+				t.Errorf("QuotaUsage.CheckInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuotaUsageCheckIntervalCron(t *testing.T) {
+	usage := &QuotaUsage{MinCheckInterval: 3600}
+	tests := []struct {
+		cron    string
+		wantErr bool
+	}{
+		{cron: "0 * * * *"},
+		{cron: "@daily"},
+		{cron: "0 0,23 * * *"},
+		{cron: "30 0,23 * * *"},
+		{cron: "0,5 3 * * *", wantErr: true},
+		{cron: "0 12,13 * * *"},
+		{cron: "0 12,13 * * 1-5"},
+		{cron: "*/5 * * * 1", wantErr: true},
+		{cron: "0 23 * * *"},
+		{cron: "@every 30m", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cron, func(t *testing.T) {
+			check := FixtureCheckConfig("check")
+			check.Cron = tt.cron
+			if err := usage.CheckInterval(check); (err != nil) != tt.wantErr {
+				t.Errorf("QuotaUsage.CheckInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -29,6 +29,8 @@ var typeMap = map[string]interface{}{
 	"check_config":           &CheckConfig{},
 	"CheckHistory":           &CheckHistory{},
 	"check_history":          &CheckHistory{},
+	"CheckOverride":          &CheckOverride{},
+	"check_override":         &CheckOverride{},
 	"CheckRequest":           &CheckRequest{},
 	"check_request":          &CheckRequest{},
 	"Claims":                 &Claims{},
@@ -49,6 +51,10 @@ var typeMap = map[string]interface{}{
 	"event_filter":           &EventFilter{},
 	"Handler":                &Handler{},
 	"handler":                &Handler{},
+	"HandlerExecution":       &HandlerExecution{},
+	"handler_execution":      &HandlerExecution{},
+	"HandlerFailure":         &HandlerFailure{},
+	"handler_failure":        &HandlerFailure{},
 	"HandlerSocket":          &HandlerSocket{},
 	"handler_socket":         &HandlerSocket{},
 	"HealthResponse":         &HealthResponse{},
@@ -87,6 +93,8 @@ var typeMap = map[string]interface{}{
 	"process":                &Process{},
 	"ProxyRequests":          &ProxyRequests{},
 	"proxy_requests":         &ProxyRequests{},
+	"Quota":                  &Quota{},
+	"quota":                  &Quota{},
 	"ResourceReference":      &ResourceReference{},
 	"resource_reference":     &ResourceReference{},
 	"Role":                   &Role{},
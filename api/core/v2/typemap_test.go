@@ -176,6 +176,23 @@ func TestResolveCheckHistory(t *testing.T) {
 	}
 }
 
+func TestResolveCheckOverride(t *testing.T) {
+	var value interface{} = new(CheckOverride)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("CheckOverride"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("CheckOverride")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"CheckOverride" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveCheckRequest(t *testing.T) {
 	var value interface{} = new(CheckRequest)
 	if _, ok := value.(Resource); ok {
@@ -346,6 +363,40 @@ func TestResolveHandler(t *testing.T) {
 	}
 }
 
+func TestResolveHandlerExecution(t *testing.T) {
+	var value interface{} = new(HandlerExecution)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("HandlerExecution"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("HandlerExecution")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"HandlerExecution" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestResolveHandlerFailure(t *testing.T) {
+	var value interface{} = new(HandlerFailure)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("HandlerFailure"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("HandlerFailure")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"HandlerFailure" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveHandlerSocket(t *testing.T) {
 	var value interface{} = new(HandlerSocket)
 	if _, ok := value.(Resource); ok {
@@ -669,6 +720,23 @@ func TestResolveProxyRequests(t *testing.T) {
 	}
 }
 
+func TestResolveQuota(t *testing.T) {
+	var value interface{} = new(Quota)
+	if _, ok := value.(Resource); ok {
+		if _, err := ResolveResource("Quota"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	_, err := ResolveResource("Quota")
+	if err == nil {
+		t.Fatal("expected non-nil error")
+	}
+	if got, want := err.Error(), `"Quota" is not a Resource`; got != want {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestResolveResourceReference(t *testing.T) {
 	var value interface{} = new(ResourceReference)
 	if _, ok := value.(Resource); ok {
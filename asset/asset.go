@@ -48,6 +48,9 @@ type RuntimeAsset struct {
 	Path string
 	// SHA512 is the hash of the asset tarball.
 	SHA512 string
+	// SignatureVerified is whether the signature of the asset tarball was
+	// verified when it was installed.
+	SignatureVerified bool
 }
 
 // BinDir returns the full path to the asset's bin directory.
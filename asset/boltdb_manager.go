@@ -3,9 +3,13 @@ package asset
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -18,20 +22,31 @@ var (
 )
 
 // NewBoltDBGetter returns a new default asset Getter. If fetcher, verifier, or
-// expander are nil, the getter will use the built-in components.
+// expander are nil, the getter will use the built-in components. If
+// signatureVerifier is not nil, assets must be signed and their signatures
+// are verified.
 func NewBoltDBGetter(db *bolt.DB,
 	localStorage string,
 	trustedCAFile string,
 	fetcher Fetcher,
 	verifier Verifier,
+	signatureVerifier SignatureVerifier,
 	expander Expander,
 	limiter *rate.Limiter) Getter {
+	return newBoltDBAssetManager(db, localStorage, trustedCAFile, fetcher, verifier, signatureVerifier, expander, limiter)
+}
+
+func newBoltDBAssetManager(db *bolt.DB,
+	localStorage string,
+	trustedCAFile string,
+	fetcher Fetcher,
+	verifier Verifier,
+	signatureVerifier SignatureVerifier,
+	expander Expander,
+	limiter *rate.Limiter) *boltDBAssetManager {
 
 	if fetcher == nil {
-		fetcher = &httpFetcher{
-			Limiter:       limiter,
-			trustedCAFile: trustedCAFile,
-		}
+		fetcher = newSchemeFetcher(trustedCAFile, limiter)
 	}
 
 	if expander == nil {
@@ -43,11 +58,12 @@ func NewBoltDBGetter(db *bolt.DB,
 	}
 
 	return &boltDBAssetManager{
-		localStorage: localStorage,
-		db:           db,
-		fetcher:      fetcher,
-		expander:     expander,
-		verifier:     verifier,
+		localStorage:      localStorage,
+		db:                db,
+		fetcher:           fetcher,
+		expander:          expander,
+		verifier:          verifier,
+		signatureVerifier: signatureVerifier,
 	}
 }
 
@@ -57,11 +73,13 @@ func NewBoltDBGetter(db *bolt.DB,
 // We rely on long-lived BoltDB transactions during Get to provide this
 // mechanism for blocking.
 type boltDBAssetManager struct {
-	localStorage string
-	db           *bolt.DB
-	fetcher      Fetcher
-	expander     Expander
-	verifier     Verifier
+	localStorage      string
+	db                *bolt.DB
+	fetcher           Fetcher
+	expander          Expander
+	verifier          Verifier
+	signatureVerifier SignatureVerifier
+	uses              assetUses
 }
 
 // Get opens a transaction to BoltDB, causing subsequent calls to
@@ -70,7 +88,8 @@ type boltDBAssetManager struct {
 //
 // If a value is returned, we return the deserialized asset stored in BoltDB.
 // If deserialization fails, we assume there is some level of corruption and
-// attempt to re-install the asset.
+// attempt to re-install the asset. Assets installed before signatures were
+// required are re-installed too, so that their signature is verified.
 //
 // If a value is not returned, the asset is not installed or not installed
 // correctly. We then proceed to attempt asset installation.
@@ -87,14 +106,7 @@ func (b *boltDBAssetManager) Get(ctx context.Context, asset *corev2.Asset) (*Run
 			return nil
 		}
 
-		value := bucket.Get(key)
-		if value != nil {
-			// deserialize asset
-			if err := json.Unmarshal(value, &localAsset); err == nil {
-				return nil
-			}
-		}
-
+		localAsset = b.installed(bucket.Get(key))
 		return nil
 	}); err != nil {
 		return nil, err
@@ -102,6 +114,7 @@ func (b *boltDBAssetManager) Get(ctx context.Context, asset *corev2.Asset) (*Run
 
 	// Check to see if the view was successful.
 	if localAsset != nil {
+		b.uses.touch(asset.Sha512, asset.Name, time.Now())
 		localAsset.Name = asset.Name
 		localAsset.SHA512 = asset.Sha512
 		return localAsset, nil
@@ -117,12 +130,8 @@ func (b *boltDBAssetManager) Get(ctx context.Context, asset *corev2.Asset) (*Run
 		// call completed installation of the asset while this transaction
 		// was blocked on serialization. Re-attempt to get the key in case that is
 		// what happened.
-		value := bucket.Get(key)
-		if value != nil {
-			// deserialize asset
-			if err := json.Unmarshal(value, &localAsset); err == nil {
-				return nil
-			}
+		if localAsset = b.installed(bucket.Get(key)); localAsset != nil {
+			return nil
 		}
 
 		// install the asset
@@ -147,14 +156,27 @@ func (b *boltDBAssetManager) Get(ctx context.Context, asset *corev2.Asset) (*Run
 			)
 		}
 
-		// expand
+		// verify the signature
+		if b.signatureVerifier != nil {
+			if err := b.verifySignature(ctx, tmpFile, asset); err != nil {
+				return fmt.Errorf("could not verify the signature of asset %q: %s", asset.Name, err)
+			}
+		}
+
+		// expand, replacing any previous installation of the asset
 		assetPath := filepath.Join(b.localStorage, asset.Sha512)
+		if err := os.RemoveAll(assetPath); err != nil {
+			return err
+		}
 		if err := b.expander.Expand(tmpFile, assetPath); err != nil {
+			// Remove the partially expanded asset
+			_ = os.RemoveAll(assetPath)
 			return err
 		}
 
 		localAsset = &RuntimeAsset{
-			Path: assetPath,
+			Path:              assetPath,
+			SignatureVerified: b.signatureVerifier != nil,
 		}
 
 		assetJSON, err := json.Marshal(localAsset)
@@ -168,9 +190,49 @@ func (b *boltDBAssetManager) Get(ctx context.Context, asset *corev2.Asset) (*Run
 	}
 
 	if localAsset != nil {
+		b.uses.touch(asset.Sha512, asset.Name, time.Now())
 		localAsset.Name = asset.Name
 		localAsset.SHA512 = asset.Sha512
 	}
 
 	return localAsset, nil
 }
+
+// installed deserializes the stored asset, and returns nil if it is
+// corrupted or if its signature must be verified but was not.
+func (b *boltDBAssetManager) installed(value []byte) *RuntimeAsset {
+	if value == nil {
+		return nil
+	}
+	var localAsset RuntimeAsset
+	if err := json.Unmarshal(value, &localAsset); err != nil {
+		return nil
+	}
+	if b.signatureVerifier != nil && !localAsset.SignatureVerified {
+		return nil
+	}
+	return &localAsset
+}
+
+// maxSignatureSize is the maximum size of the asset signatures
+const maxSignatureSize = 4096
+
+// verifySignature fetches the detached signature of the asset and verifies the
+// asset file against it.
+func (b *boltDBAssetManager) verifySignature(ctx context.Context, file io.ReadSeeker, asset *corev2.Asset) error {
+	if asset.SignatureURL == "" {
+		return errors.New("the asset has no signature url")
+	}
+	sigFile, err := b.fetcher.Fetch(ctx, asset.SignatureURL, asset.Headers)
+	if err != nil {
+		return fmt.Errorf("could not fetch the signature: %s", err)
+	}
+	defer os.Remove(sigFile.Name())
+	defer sigFile.Close()
+
+	signature, err := ioutil.ReadAll(io.LimitReader(sigFile, maxSignatureSize))
+	if err != nil {
+		return fmt.Errorf("could not read the signature: %s", err)
+	}
+	return b.signatureVerifier.VerifySignature(file, signature)
+}
@@ -37,6 +37,17 @@ func (m *mockVerifier) Verify(f io.ReadSeeker, sha512 string) error {
 	return errors.New("")
 }
 
+type mockSignatureVerifier struct {
+	pass bool
+}
+
+func (m *mockSignatureVerifier) VerifySignature(f io.ReadSeeker, signature []byte) error {
+	if m.pass {
+		return nil
+	}
+	return errors.New("")
+}
+
 type mockExpander struct {
 	pass bool
 }
@@ -288,3 +299,118 @@ func TestSuccessfulGetAsset(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestGetAssetSignature(t *testing.T) {
+	t.Parallel()
+
+	tmpFile, err := ioutil.TempFile(os.TempDir(), "asset_test_get_asset_signature.db")
+	if err != nil {
+		t.Fatalf("unable to create test boltdb file: %v", err)
+	}
+	defer tmpFile.Close()
+	defer os.Remove(tmpFile.Name())
+
+	db, err := bolt.Open(tmpFile.Name(), 0666, &bolt.Options{})
+	if err != nil {
+		t.Fatalf("unable to open boltdb in test: %v", err)
+	}
+	defer db.Close()
+
+	manager := &boltDBAssetManager{
+		db:                db,
+		fetcher:           &mockFetcher{true},
+		verifier:          &mockVerifier{true},
+		signatureVerifier: &mockSignatureVerifier{false},
+		expander:          &mockExpander{true},
+	}
+
+	a := &types.Asset{
+		ObjectMeta: types.ObjectMeta{
+			Name:      "asset",
+			Namespace: "default",
+		},
+		Sha512: "sha",
+		URL:    "path",
+	}
+
+	// Unsigned assets are refused
+	if _, err := manager.Get(context.TODO(), a); err == nil {
+		t.Fatal("expected error for an unsigned asset, got nil")
+	}
+
+	// Assets with an invalid signature are refused
+	a.SignatureURL = "path.minisig"
+	if _, err := manager.Get(context.TODO(), a); err == nil {
+		t.Fatal("expected error for an invalid signature, got nil")
+	}
+
+	manager.signatureVerifier = &mockSignatureVerifier{true}
+	runtimeAsset, err := manager.Get(context.TODO(), a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if runtimeAsset == nil {
+		t.Fatal("expected runtime asset, got nil")
+	}
+	if !runtimeAsset.SignatureVerified {
+		t.Fatal("expected the signature of the runtime asset to be verified")
+	}
+}
+
+func TestGetAssetSignatureCached(t *testing.T) {
+	t.Parallel()
+
+	tmpFile, err := ioutil.TempFile(os.TempDir(), "asset_test_get_asset_signature_cached.db")
+	if err != nil {
+		t.Fatalf("unable to create test boltdb file: %v", err)
+	}
+	defer tmpFile.Close()
+	defer os.Remove(tmpFile.Name())
+
+	db, err := bolt.Open(tmpFile.Name(), 0666, &bolt.Options{})
+	if err != nil {
+		t.Fatalf("unable to open boltdb in test: %v", err)
+	}
+	defer db.Close()
+
+	manager := &boltDBAssetManager{
+		db:       db,
+		fetcher:  &mockFetcher{true},
+		verifier: &mockVerifier{true},
+		expander: &mockExpander{true},
+	}
+
+	a := &types.Asset{
+		ObjectMeta: types.ObjectMeta{
+			Name:      "asset",
+			Namespace: "default",
+		},
+		Sha512:       "sha",
+		URL:          "path",
+		SignatureURL: "path.minisig",
+	}
+
+	// The asset is installed before signatures are required
+	runtimeAsset, err := manager.Get(context.TODO(), a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if runtimeAsset.SignatureVerified {
+		t.Fatal("expected the signature of the runtime asset not to be verified")
+	}
+
+	// The cached asset is installed again, verifying its signature
+	manager.signatureVerifier = &mockSignatureVerifier{false}
+	if _, err := manager.Get(context.TODO(), a); err == nil {
+		t.Fatal("expected error for an invalid signature of a cached asset, got nil")
+	}
+
+	manager.signatureVerifier = &mockSignatureVerifier{true}
+	runtimeAsset, err = manager.Get(context.TODO(), a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !runtimeAsset.SignatureVerified {
+		t.Fatal("expected the signature of the runtime asset to be verified")
+	}
+}
@@ -0,0 +1,252 @@
+package asset
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	usageBucketName = []byte("assets_usage")
+
+	// cacheGCInterval is the interval at which the asset cache is garbage
+	// collected
+	cacheGCInterval = 10 * time.Minute
+
+	// cacheGCGracePeriod is the period during which the assets recently used
+	// are never removed from the cache, since checks may still use them
+	cacheGCGracePeriod = time.Hour
+)
+
+// CachedAsset is an asset installed in the asset cache.
+type CachedAsset struct {
+	// Name is the name of the asset which installed it
+	Name string `json:"name"`
+	// SHA512 is the hash of the asset archive
+	SHA512 string `json:"sha512"`
+	// Path is the path to the expanded asset
+	Path string `json:"path"`
+	// Size is the size of the expanded asset, in bytes
+	Size int64 `json:"size"`
+	// LastUsed is the last time the asset was used by a check, hook, handler
+	// or mutator, or when it was found in the cache if it was not since
+	LastUsed time.Time `json:"last_used"`
+}
+
+// assetUsage records the usage of a cached asset
+type assetUsage struct {
+	Name     string    `json:"name"`
+	Size     int64     `json:"size"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+// assetUses tracks in memory the last uses of the cached assets, which are
+// persisted when the cache is garbage collected.
+type assetUses struct {
+	mu   sync.Mutex
+	uses map[string]assetUsage
+}
+
+func (u *assetUses) touch(sha512, name string, now time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.uses == nil {
+		u.uses = make(map[string]assetUsage)
+	}
+	u.uses[sha512] = assetUsage{Name: name, LastUsed: now}
+}
+
+// drain returns the uses tracked since the last drain.
+func (u *assetUses) drain() map[string]assetUsage {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	uses := u.uses
+	u.uses = nil
+	return uses
+}
+
+func (u *assetUses) get(sha512 string) (assetUsage, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	use, ok := u.uses[sha512]
+	return use, ok
+}
+
+// List returns the assets installed in the cache.
+func (b *boltDBAssetManager) List() ([]CachedAsset, error) {
+	var cached []CachedAsset
+	err := b.db.View(func(tx *bolt.Tx) error {
+		assets := tx.Bucket(assetBucketName)
+		if assets == nil {
+			return nil
+		}
+		usages := tx.Bucket(usageBucketName)
+		return assets.ForEach(func(k, v []byte) error {
+			var runtimeAsset RuntimeAsset
+			if err := json.Unmarshal(v, &runtimeAsset); err != nil {
+				return nil
+			}
+			var usage assetUsage
+			if usages != nil {
+				if value := usages.Get(k); value != nil {
+					_ = json.Unmarshal(value, &usage)
+				}
+			}
+			if usage.Size == 0 {
+				usage.Size = dirSize(runtimeAsset.Path)
+			}
+			if use, ok := b.uses.get(string(k)); ok {
+				usage.Name = use.Name
+				usage.LastUsed = use.LastUsed
+			}
+			cached = append(cached, CachedAsset{
+				Name:     usage.Name,
+				SHA512:   string(k),
+				Path:     runtimeAsset.Path,
+				Size:     usage.Size,
+				LastUsed: usage.LastUsed,
+			})
+			return nil
+		})
+	})
+	return cached, err
+}
+
+// saveUsage persists the uses of the assets tracked in memory, and records the
+// assets found in the cache without usage as used now.
+func (b *boltDBAssetManager) saveUsage(now time.Time) error {
+	uses := b.uses.drain()
+	return b.db.Update(func(tx *bolt.Tx) error {
+		assets := tx.Bucket(assetBucketName)
+		if assets == nil {
+			return nil
+		}
+		usages, err := tx.CreateBucketIfNotExists(usageBucketName)
+		if err != nil {
+			return err
+		}
+		return assets.ForEach(func(k, v []byte) error {
+			var usage assetUsage
+			if value := usages.Get(k); value != nil {
+				_ = json.Unmarshal(value, &usage)
+			}
+			use, used := uses[string(k)]
+			if used {
+				usage.Name = use.Name
+				usage.LastUsed = use.LastUsed
+			} else if !usage.LastUsed.IsZero() && usage.Size != 0 {
+				return nil
+			}
+			if usage.LastUsed.IsZero() {
+				usage.LastUsed = now
+			}
+			if usage.Size == 0 {
+				var runtimeAsset RuntimeAsset
+				if err := json.Unmarshal(v, &runtimeAsset); err == nil {
+					usage.Size = dirSize(runtimeAsset.Path)
+				}
+			}
+			value, err := json.Marshal(usage)
+			if err != nil {
+				return err
+			}
+			return usages.Put(k, value)
+		})
+	})
+}
+
+// Collect removes from the cache the assets unused for longer than the unused
+// TTL, and then the least recently used assets until the size of the cache is
+// below its maximum size. Assets used during the grace period are never
+// removed. A zero TTL or maximum size disables the corresponding collection.
+func (b *boltDBAssetManager) Collect(now time.Time, maxSize int64, unusedTTL time.Duration) error {
+	if err := b.saveUsage(now); err != nil {
+		return err
+	}
+	cached, err := b.List()
+	if err != nil {
+		return err
+	}
+
+	// Least recently used first
+	sort.Slice(cached, func(i, j int) bool {
+		return cached[i].LastUsed.Before(cached[j].LastUsed)
+	})
+
+	var size int64
+	for _, asset := range cached {
+		size += asset.Size
+	}
+	for _, asset := range cached {
+		idle := now.Sub(asset.LastUsed)
+		if idle < cacheGCGracePeriod {
+			break
+		}
+		unused := unusedTTL > 0 && idle > unusedTTL
+		oversized := maxSize > 0 && size > maxSize
+		if !unused && !oversized {
+			continue
+		}
+		if err := b.remove(asset); err != nil {
+			return err
+		}
+		size -= asset.Size
+		logger.WithField("asset", asset.Name).WithField("sha512", asset.SHA512).Info("removed asset from the cache")
+	}
+	return nil
+}
+
+// remove removes the asset from the cache.
+func (b *boltDBAssetManager) remove(asset CachedAsset) error {
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		if bucket := tx.Bucket(usageBucketName); bucket != nil {
+			if err := bucket.Delete([]byte(asset.SHA512)); err != nil {
+				return err
+			}
+		}
+		if bucket := tx.Bucket(assetBucketName); bucket != nil {
+			if err := bucket.Delete([]byte(asset.SHA512)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(asset.Path)
+}
+
+// collectPeriodically garbage collects the cache until the context is done.
+func (b *boltDBAssetManager) collectPeriodically(ctx context.Context, maxSize int64, unusedTTL time.Duration) {
+	ticker := time.NewTicker(cacheGCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.Collect(time.Now(), maxSize, unusedTTL); err != nil {
+				logger.WithError(err).Error("could not garbage collect the asset cache")
+			}
+		}
+	}
+}
+
+// dirSize returns the size of the files under the directory.
+func dirSize(path string) int64 {
+	var size int64
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,143 @@
+package asset
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// newTestCache returns a boltdb asset manager with the given cached assets,
+// each one containing a file of the given size.
+func newTestCache(t *testing.T, sizes map[string]int) (*boltDBAssetManager, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "asset_cache_test")
+	require.NoError(t, err)
+
+	db, err := bolt.Open(filepath.Join(dir, dbName), 0600, &bolt.Options{})
+	require.NoError(t, err)
+	cleanup := func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(assetBucketName)
+		if err != nil {
+			return err
+		}
+		for sha, size := range sizes {
+			path := filepath.Join(dir, sha)
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(filepath.Join(path, "bin"), make([]byte, size), 0644); err != nil {
+				return err
+			}
+			value, err := json.Marshal(&RuntimeAsset{Path: path})
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(sha), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	return &boltDBAssetManager{db: db, localStorage: dir}, cleanup
+}
+
+func useAsset(t *testing.T, manager *boltDBAssetManager, sha string, now time.Time) {
+	t.Helper()
+	_, err := manager.Get(context.Background(), &types.Asset{
+		ObjectMeta: types.ObjectMeta{Name: sha},
+		Sha512:     sha,
+	})
+	require.NoError(t, err)
+	// Override the time of use recorded by Get
+	manager.uses.touch(sha, sha, now)
+}
+
+func cachedSHAs(t *testing.T, manager *boltDBAssetManager) []string {
+	t.Helper()
+	cached, err := manager.List()
+	require.NoError(t, err)
+	var shas []string
+	for _, asset := range cached {
+		shas = append(shas, asset.SHA512)
+		_, err := os.Stat(asset.Path)
+		assert.NoError(t, err)
+	}
+	return shas
+}
+
+func TestCacheList(t *testing.T) {
+	manager, cleanup := newTestCache(t, map[string]int{"a": 10, "b": 20})
+	defer cleanup()
+
+	now := time.Now()
+	useAsset(t, manager, "a", now)
+
+	cached, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, cached, 2)
+	for _, asset := range cached {
+		switch asset.SHA512 {
+		case "a":
+			assert.Equal(t, "a", asset.Name)
+			assert.Equal(t, int64(10), asset.Size)
+			assert.True(t, asset.LastUsed.Equal(now))
+		case "b":
+			assert.Equal(t, int64(20), asset.Size)
+			assert.True(t, asset.LastUsed.IsZero())
+		}
+	}
+}
+
+func TestCacheCollectUnused(t *testing.T) {
+	manager, cleanup := newTestCache(t, map[string]int{"a": 10, "b": 10, "c": 10})
+	defer cleanup()
+
+	now := time.Now()
+	useAsset(t, manager, "a", now.Add(-72*time.Hour))
+	useAsset(t, manager, "b", now.Add(-2*time.Hour))
+
+	// c was never used, it is only recorded as used now
+	require.NoError(t, manager.Collect(now, 0, 24*time.Hour))
+	assert.ElementsMatch(t, []string{"b", "c"}, cachedSHAs(t, manager))
+	_, err := os.Stat(filepath.Join(manager.localStorage, "a"))
+	assert.True(t, os.IsNotExist(err))
+
+	// The usage persists across collections
+	require.NoError(t, manager.Collect(now.Add(23*time.Hour), 0, 24*time.Hour))
+	assert.ElementsMatch(t, []string{"c"}, cachedSHAs(t, manager))
+}
+
+func TestCacheCollectMaxSize(t *testing.T) {
+	manager, cleanup := newTestCache(t, map[string]int{"a": 10, "b": 10, "c": 10, "d": 10})
+	defer cleanup()
+
+	now := time.Now()
+	useAsset(t, manager, "a", now.Add(-4*time.Hour))
+	useAsset(t, manager, "b", now.Add(-2*time.Hour))
+	useAsset(t, manager, "c", now.Add(-3*time.Hour))
+	useAsset(t, manager, "d", now.Add(-time.Minute))
+
+	// The least recently used are removed first
+	require.NoError(t, manager.Collect(now, 25, 0))
+	assert.ElementsMatch(t, []string{"b", "d"}, cachedSHAs(t, manager))
+
+	// d is never removed during the grace period
+	require.NoError(t, manager.Collect(now, 5, 0))
+	assert.ElementsMatch(t, []string{"d"}, cachedSHAs(t, manager))
+}
@@ -0,0 +1,28 @@
+package asset
+
+import (
+	"context"
+	"strings"
+)
+
+type secretsKey struct{}
+
+// ContextWithSecrets returns a context carrying secrets, formatted as
+// environment variables, in which the fetchers look up their credentials.
+func ContextWithSecrets(ctx context.Context, secrets []string) context.Context {
+	return context.WithValue(ctx, secretsKey{}, secrets)
+}
+
+// lookupCredential returns the value of the named credential, from the secrets
+// of the context. The environment of the process is never used, since the
+// asset URLs, which choose where the credentials are sent, are not written by
+// the operator of the agent.
+func lookupCredential(ctx context.Context, name string) string {
+	secrets, _ := ctx.Value(secretsKey{}).([]string)
+	for _, secret := range secrets {
+		if kv := strings.SplitN(secret, "=", 2); len(kv) == 2 && kv[0] == name {
+			return kv[1]
+		}
+	}
+	return ""
+}
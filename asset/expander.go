@@ -1,9 +1,15 @@
 package asset
 
 import (
-	"errors"
+	"archive/tar"
+	"archive/zip"
+	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 
 	archiver "github.com/mholt/archiver/v3"
 
@@ -18,6 +24,13 @@ const (
 
 var (
 	defaultExpander = &archiveExpander{}
+
+	// zstdMagic is the magic number of zstandard frames, which filetype does
+	// not detect
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+	// typeZstd is the file type of zstandard compressed files
+	typeZstd = filetype_types.NewType("zst", "application/zstd")
 )
 
 // An Expander expands the provided *os.File to the target direcrtory.
@@ -26,17 +39,17 @@ type Expander interface {
 }
 
 // A archiveExpander detects the archive type and expands it to the local
-// filesystem.
+// filesystem. The archive entries must be within the target directory, and so
+// must the targets of the symbolic and hard links.
 //
 // Supported archive types:
 // - tar
 // - tar-gzip
+// - tar-zstd
+// - tar-xz
+// - zip
 type archiveExpander struct{}
 
-type namer interface {
-	Name() string
-}
-
 // Expand an archive to a target directory.
 func (a *archiveExpander) Expand(archive io.ReadSeeker, targetDirectory string) error {
 	// detect the type of archive the asset is
@@ -45,7 +58,7 @@ func (a *archiveExpander) Expand(archive io.ReadSeeker, targetDirectory string)
 		return err
 	}
 
-	var ar archiver.Unarchiver
+	var ar archiver.Reader
 
 	// If the file is not an archive, exit with an error.
 	switch ft.MIME.Value {
@@ -53,6 +66,12 @@ func (a *archiveExpander) Expand(archive io.ReadSeeker, targetDirectory string)
 		ar = archiver.NewTar()
 	case "application/gzip":
 		ar = archiver.NewTarGz()
+	case "application/zstd":
+		ar = archiver.NewTarZstd()
+	case "application/x-xz":
+		ar = archiver.NewTarXz()
+	case "application/zip":
+		ar = archiver.NewZip()
 
 	default:
 		return fmt.Errorf(
@@ -61,19 +80,238 @@ func (a *archiveExpander) Expand(archive io.ReadSeeker, targetDirectory string)
 		)
 	}
 
-	namer, ok := archive.(namer)
-	if !ok {
-		return errors.New("couldn't get path to archive")
+	// The size of the archive is required by zip archives
+	size, err := archive.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return err
 	}
 
 	// Extract the archive to the desired path
-	if err := ar.Unarchive(namer.Name(), targetDirectory); err != nil {
+	if err := ar.Open(archive, size); err != nil {
+		return fmt.Errorf("error extracting asset: %s", err)
+	}
+	defer ar.Close()
+
+	root, err := filepath.Abs(targetDirectory)
+	if err != nil {
+		return err
+	}
+	for {
+		f, err := ar.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error extracting asset: %s", err)
+		}
+		err = extractFile(root, f)
+		_ = f.Close()
+		if err != nil {
+			return fmt.Errorf("error extracting asset: %s", err)
+		}
+	}
+
+	// The symbolic links are checked once all of them are extracted, since
+	// they can be chained
+	if err := checkSymlinks(root); err != nil {
 		return fmt.Errorf("error extracting asset: %s", err)
 	}
 
 	return nil
 }
 
+// extractFile extracts the archive entry to the root directory.
+func extractFile(root string, f archiver.File) error {
+	var name, linkname string
+	var hardlink bool
+	switch header := f.Header.(type) {
+	case *tar.Header:
+		name = header.Name
+		switch header.Typeflag {
+		case tar.TypeXGlobalHeader:
+			// ignore the pax global header from git-generated tarballs
+			return nil
+		case tar.TypeSymlink:
+			linkname = header.Linkname
+		case tar.TypeLink:
+			linkname = header.Linkname
+			hardlink = true
+		case tar.TypeDir, tar.TypeReg, tar.TypeRegA:
+		default:
+			return fmt.Errorf("%s: unsupported type flag: %c", name, header.Typeflag)
+		}
+	case zip.FileHeader:
+		name = header.Name
+		if f.Mode()&os.ModeSymlink != 0 {
+			target, err := ioutil.ReadAll(io.LimitReader(f, 4096))
+			if err != nil {
+				return err
+			}
+			linkname = string(target)
+		}
+	default:
+		return fmt.Errorf("unexpected archive header %T", f.Header)
+	}
+
+	path, err := securePath(root, name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	switch {
+	case f.IsDir():
+		return os.MkdirAll(path, f.Mode().Perm()|0700)
+	case hardlink:
+		target, err := securePath(root, linkname)
+		if err != nil {
+			return err
+		}
+		return os.Link(target, path)
+	case f.Mode()&os.ModeSymlink != 0:
+		if filepath.IsAbs(linkname) || !within(root, filepath.Join(filepath.Dir(path), linkname)) {
+			return fmt.Errorf("%s: illegal link target %q", name, linkname)
+		}
+		return os.Symlink(linkname, path)
+	}
+
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, f.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, f); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// securePath returns the path of the archive entry within the root directory.
+// It fails if the entry is outside of the root directory, or if its path
+// traverses a symbolic link, which could point outside of the root directory.
+func securePath(root, name string) (string, error) {
+	path := filepath.Join(root, filepath.FromSlash(name))
+	if filepath.IsAbs(filepath.FromSlash(name)) || !within(root, path) {
+		return "", fmt.Errorf("%s: illegal file path", name)
+	}
+	if path == root {
+		return path, nil
+	}
+	rel, err := filepath.Rel(root, filepath.Dir(path))
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		return path, nil
+	}
+	parent := root
+	for _, component := range strings.Split(rel, string(filepath.Separator)) {
+		parent = filepath.Join(parent, component)
+		info, err := os.Lstat(parent)
+		if os.IsNotExist(err) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("%s: illegal file path through a symbolic link", name)
+		}
+	}
+	return path, nil
+}
+
+// checkSymlinks returns an error if a symbolic link in the root directory
+// resolves outside of it, including dangling links.
+func checkSymlinks(root string) error {
+	realRoot, err := followPath(root)
+	if err != nil {
+		return err
+	}
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink == 0 {
+			return nil
+		}
+		target, err := followPath(path)
+		if err != nil {
+			return err
+		}
+		if !within(realRoot, target) {
+			rel, _ := filepath.Rel(root, path)
+			return fmt.Errorf("%s: illegal link target outside of the archive", filepath.ToSlash(rel))
+		}
+		return nil
+	})
+}
+
+// followPath resolves the symbolic links of the absolute path like the system
+// does, even if the path, or the target of a link, does not exist.
+func followPath(path string) (string, error) {
+	const maxLinks = 255
+	links := 0
+
+	volume := filepath.VolumeName(path)
+	rootDir := volume + string(filepath.Separator)
+	resolved := rootDir
+	pending := strings.Split(path[len(volume):], string(filepath.Separator))
+	for len(pending) > 0 {
+		component := pending[0]
+		pending = pending[1:]
+		switch component {
+		case "", ".":
+			continue
+		case "..":
+			resolved = filepath.Dir(resolved)
+			continue
+		}
+
+		next := filepath.Join(resolved, component)
+		info, err := os.Lstat(next)
+		if os.IsNotExist(err) {
+			resolved = next
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+
+		links++
+		if links > maxLinks {
+			return "", fmt.Errorf("%s: too many levels of symbolic links", path)
+		}
+		target, err := os.Readlink(next)
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(target) {
+			resolved = rootDir
+		}
+		pending = append(strings.Split(target, string(filepath.Separator)), pending...)
+	}
+	return resolved, nil
+}
+
+// within returns whether the path is within the parent directory.
+func within(parent, path string) bool {
+	rel, err := filepath.Rel(parent, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 func sniffType(f io.ReadSeeker) (filetype_types.Type, error) {
 	header := make([]byte, headerSize)
 	if _, err := f.Read(header); err != nil {
@@ -83,6 +321,9 @@ func sniffType(f io.ReadSeeker) (filetype_types.Type, error) {
 	if err != nil {
 		return ft, err
 	}
+	if bytes.HasPrefix(header, zstdMagic) {
+		ft = typeZstd
+	}
 
 	if _, err := f.Seek(0, 0); err != nil {
 		return filetype_types.Type{}, err
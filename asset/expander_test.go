@@ -1,11 +1,18 @@
 package asset
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
 
+	archiver "github.com/mholt/archiver/v3"
 	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExpandValidTar(t *testing.T) {
@@ -69,7 +76,7 @@ func TestExpandValidTGZ(t *testing.T) {
 func TestExpandUnsupportedArchive(t *testing.T) {
 	t.Parallel()
 
-	assetPath := getFixturePath("unsupported.zip")
+	assetPath := getFixturePath("unsupported.tar.bz2")
 	f, err := os.Open(assetPath)
 	if err != nil {
 		t.Fatalf("unable to open asset fixture, err: %v", err)
@@ -78,7 +85,7 @@ func TestExpandUnsupportedArchive(t *testing.T) {
 
 	tmpDir, remove := testutil.TempDir(t)
 	defer remove()
-	targetDirectory := filepath.Join(tmpDir, "unsupported-zip")
+	targetDirectory := filepath.Join(tmpDir, "unsupported-tar-bz2")
 	if err := os.Mkdir(targetDirectory, 0755); err != nil {
 		t.Fatalf("unable to create target directory, err: %v", err)
 	}
@@ -113,3 +120,199 @@ func TestExpandInvalidArchive(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestExpandValidZip(t *testing.T) {
+	t.Parallel()
+
+	assetPath := getFixturePath("foo.zip")
+	f, err := os.Open(assetPath)
+	if err != nil {
+		t.Fatalf("unable to open asset fixture, err: %v", err)
+	}
+	defer f.Close()
+
+	tmpDir, remove := testutil.TempDir(t)
+	defer remove()
+
+	expander := &archiveExpander{}
+	if err := expander.Expand(f, tmpDir); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "foo", "file")); err != nil {
+		t.Fatalf("could not stat asset contents, err: %v", err)
+	}
+}
+
+// tarEntry is an entry of an archive built by the tests
+type tarEntry struct {
+	name     string
+	typeflag byte
+	linkname string
+	body     string
+}
+
+func buildTar(t *testing.T, entries []tarEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, entry := range entries {
+		mode := int64(0644)
+		if entry.typeflag == tar.TypeDir {
+			mode = 0755
+		}
+		require.NoError(t, w.WriteHeader(&tar.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Linkname: entry.linkname,
+			Mode:     mode,
+			Size:     int64(len(entry.body)),
+		}))
+		_, err := w.Write([]byte(entry.body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func buildZip(t *testing.T, entries []tarEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name}
+		body := entry.body
+		header.SetMode(0644)
+		if entry.typeflag == tar.TypeSymlink {
+			header.SetMode(os.ModeSymlink | 0777)
+			body = entry.linkname
+		}
+		fw, err := w.CreateHeader(header)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// expandArchive expands the archive in a new directory and returns it
+func expandArchive(t *testing.T, archive []byte) (string, error, func()) {
+	t.Helper()
+	tmpDir, remove := testutil.TempDir(t)
+	f, err := ioutil.TempFile(tmpDir, "archive")
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.Write(archive)
+	require.NoError(t, err)
+	_, err = f.Seek(0, 0)
+	require.NoError(t, err)
+
+	targetDirectory := filepath.Join(tmpDir, "asset")
+	require.NoError(t, os.Mkdir(targetDirectory, 0755))
+	return targetDirectory, (&archiveExpander{}).Expand(f, targetDirectory), remove
+}
+
+func TestExpandCompressedTar(t *testing.T) {
+	t.Parallel()
+
+	archive := buildTar(t, []tarEntry{
+		{name: "bin/", typeflag: tar.TypeDir},
+		{name: "bin/foo", typeflag: tar.TypeReg, body: "#!/bin/sh"},
+		{name: "bin/bar", typeflag: tar.TypeSymlink, linkname: "foo"},
+		{name: "bin/baz", typeflag: tar.TypeLink, linkname: "bin/foo"},
+	})
+
+	compressors := map[string]archiver.Compressor{
+		"zstd": archiver.NewZstd(),
+		"xz":   archiver.NewXz(),
+		"gzip": archiver.NewGz(),
+	}
+	for name, compressor := range compressors {
+		compressor := compressor
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var compressed bytes.Buffer
+			require.NoError(t, compressor.Compress(bytes.NewReader(archive), &compressed))
+
+			dir, err, remove := expandArchive(t, compressed.Bytes())
+			defer remove()
+			require.NoError(t, err)
+
+			for _, file := range []string{"foo", "bar", "baz"} {
+				content, err := ioutil.ReadFile(filepath.Join(dir, "bin", file))
+				require.NoError(t, err)
+				assert.Equal(t, "#!/bin/sh", string(content))
+			}
+		})
+	}
+}
+
+func TestExpandIllegalArchive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		entries []tarEntry
+	}{
+		{
+			name:    "path traversal",
+			entries: []tarEntry{{name: "../evil", typeflag: tar.TypeReg, body: "evil"}},
+		},
+		{
+			name:    "nested path traversal",
+			entries: []tarEntry{{name: "bin/../../evil", typeflag: tar.TypeReg, body: "evil"}},
+		},
+		{
+			name:    "absolute path",
+			entries: []tarEntry{{name: "/evil", typeflag: tar.TypeReg, body: "evil"}},
+		},
+		{
+			name:    "absolute symlink",
+			entries: []tarEntry{{name: "evil", typeflag: tar.TypeSymlink, linkname: "/etc/passwd"}},
+		},
+		{
+			name:    "symlink escape",
+			entries: []tarEntry{{name: "bin/evil", typeflag: tar.TypeSymlink, linkname: "../../evil"}},
+		},
+		{
+			name:    "hard link escape",
+			entries: []tarEntry{{name: "evil", typeflag: tar.TypeLink, linkname: "../evil"}},
+		},
+		{
+			name: "write through symlink",
+			entries: []tarEntry{
+				{name: "lib/", typeflag: tar.TypeDir},
+				{name: "link", typeflag: tar.TypeSymlink, linkname: "lib"},
+				{name: "link/evil", typeflag: tar.TypeReg, body: "evil"},
+			},
+		},
+		{
+			name: "chained symlinks escape",
+			entries: []tarEntry{
+				{name: "bin/", typeflag: tar.TypeDir},
+				{name: "bin/parent", typeflag: tar.TypeSymlink, linkname: ".."},
+				{name: "bin/evil", typeflag: tar.TypeSymlink, linkname: "parent/../evil"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for format, archive := range map[string][]byte{
+				"tar": buildTar(t, tt.entries),
+				"zip": buildZip(t, tt.entries),
+			} {
+				if format == "zip" && tt.entries[len(tt.entries)-1].typeflag == tar.TypeLink {
+					// zip archives have no hard links
+					continue
+				}
+				dir, err, remove := expandArchive(t, archive)
+				assert.Error(t, err, format)
+				_, statErr := os.Lstat(filepath.Join(filepath.Dir(dir), "evil"))
+				assert.True(t, os.IsNotExist(statErr), format)
+				remove()
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
@@ -34,8 +35,9 @@ type Fetcher interface {
 // URLGetter gets all content at the specified URL.
 type urlGetter func(context.Context, string, string, map[string]string) (io.ReadCloser, error)
 
-// Get the target URL and return an io.ReadCloser
-func httpGet(ctx context.Context, path, trustedCAFile string, headers map[string]string) (io.ReadCloser, error) {
+// newHTTPClient returns an HTTP client trusting the CA certificates of the
+// trusted CA file, in addition to the system ones.
+func newHTTPClient(trustedCAFile string) *http.Client {
 	client := &http.Client{}
 
 	if trustedCAFile != "" {
@@ -68,6 +70,13 @@ func httpGet(ctx context.Context, path, trustedCAFile string, headers map[string
 		}
 	}
 
+	return client
+}
+
+// Get the target URL and return an io.ReadCloser
+func httpGet(ctx context.Context, path, trustedCAFile string, headers map[string]string) (io.ReadCloser, error) {
+	client := newHTTPClient(trustedCAFile)
+
 	req, err := http.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching asset: %s", err)
@@ -106,10 +115,8 @@ func (h *httpFetcher) Fetch(ctx context.Context, url string, headers map[string]
 		h.URLGetter = httpGet
 	}
 
-	if h.Limiter != nil {
-		if !h.Limiter.Allow() {
-			return nil, fmt.Errorf("can't download asset due to rate limit")
-		}
+	if err := allowFetch(h.Limiter); err != nil {
+		return nil, err
 	}
 
 	resp, err := h.URLGetter(ctx, url, h.trustedCAFile, headers)
@@ -118,7 +125,12 @@ func (h *httpFetcher) Fetch(ctx context.Context, url string, headers map[string]
 	}
 	defer resp.Close()
 
-	// Write response to tmp
+	return writeTempFile(resp)
+}
+
+// writeTempFile writes the content of the reader to a new temporary file,
+// and returns the file rewound.
+func writeTempFile(r io.Reader) (*os.File, error) {
 	tmpFile, err := ioutil.TempFile(os.TempDir(), "sensu-asset")
 	if err != nil {
 		return nil, fmt.Errorf("can't open tmp file for asset: %s", err)
@@ -130,7 +142,7 @@ func (h *httpFetcher) Fetch(ctx context.Context, url string, headers map[string]
 	}
 
 	buffered := bufio.NewWriter(tmpFile)
-	if _, err = io.Copy(buffered, resp); err != nil {
+	if _, err = io.Copy(buffered, r); err != nil {
 		cleanup()
 		return nil, fmt.Errorf("error downloading asset: %s", err)
 	}
@@ -151,3 +163,46 @@ func (h *httpFetcher) Fetch(ctx context.Context, url string, headers map[string]
 
 	return tmpFile, nil
 }
+
+// allowFetch returns an error if the limiter does not allow another fetch.
+func allowFetch(limiter *rate.Limiter) error {
+	if limiter != nil && !limiter.Allow() {
+		return fmt.Errorf("can't download asset due to rate limit")
+	}
+	return nil
+}
+
+// A schemeFetcher fetches files with the fetcher of the scheme of their URL,
+// and with the HTTP fetcher for unknown schemes.
+type schemeFetcher struct {
+	fetchers map[string]Fetcher
+	http     Fetcher
+}
+
+// newSchemeFetcher returns a fetcher supporting the http, https, file, s3 and
+// oci URL schemes.
+func newSchemeFetcher(trustedCAFile string, limiter *rate.Limiter) *schemeFetcher {
+	client := newHTTPClient(trustedCAFile)
+	return &schemeFetcher{
+		fetchers: map[string]Fetcher{
+			"file": &fileFetcher{},
+			"s3":   &s3Fetcher{Client: client, Limiter: limiter},
+			"oci":  &ociFetcher{Client: client, Limiter: limiter},
+		},
+		http: &httpFetcher{
+			Limiter:       limiter,
+			trustedCAFile: trustedCAFile,
+		},
+	}
+}
+
+// Fetch the file found at the specified source with the fetcher of its URL
+// scheme.
+func (s *schemeFetcher) Fetch(ctx context.Context, source string, headers map[string]string) (*os.File, error) {
+	if u, err := url.Parse(source); err == nil {
+		if fetcher, ok := s.fetchers[strings.ToLower(u.Scheme)]; ok {
+			return fetcher.Fetch(ctx, source, headers)
+		}
+	}
+	return s.http.Fetch(ctx, source, headers)
+}
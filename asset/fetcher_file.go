@@ -0,0 +1,32 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+// A fileFetcher fetches the files found on the local filesystem, such as
+// assets staged beforehand on hosts without access to the network.
+type fileFetcher struct{}
+
+// Fetch copies the file found at the file URL, and returns the copy.
+func (f *fileFetcher) Fetch(ctx context.Context, source string, headers map[string]string) (*os.File, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err)
+	}
+	if u.Host != "" && u.Host != "localhost" {
+		return nil, fmt.Errorf("error fetching asset: file URL must not have a remote host: %s", u.Host)
+	}
+
+	file, err := os.Open(fileURLPath(u))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err)
+	}
+	defer file.Close()
+
+	// Copy the file, since fetched files are removed once expanded
+	return writeTempFile(file)
+}
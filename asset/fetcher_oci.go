@@ -0,0 +1,305 @@
+package asset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// ociTitleAnnotation is the annotation naming the files of OCI artifacts
+	ociTitleAnnotation = "org.opencontainers.image.title"
+)
+
+// An ociFetcher fetches assets stored as OCI artifacts in container
+// registries, with URLs such as oci://registry/repository:tag or
+// oci://registry/repository@digest. The artifact must have a single layer,
+// unless the file URL query parameter selects the layer by its title. The
+// insecure URL query parameter makes requests over plain HTTP.
+//
+// Registries are authenticated with the OCI_USERNAME and OCI_PASSWORD
+// credentials, looked up in the secrets of the context, and anonymously
+// without them. The credentials are only sent over HTTPS, to the registry or
+// to a token realm on the same host.
+type ociFetcher struct {
+	Client  *http.Client
+	Limiter *rate.Limiter
+}
+
+// ociReference is a reference to an artifact in a registry.
+type ociReference struct {
+	baseURL    string
+	repository string
+	reference  string
+	file       string
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// Fetch the layer of the artifact found at the OCI URL.
+func (o *ociFetcher) Fetch(ctx context.Context, source string, headers map[string]string) (*os.File, error) {
+	if err := allowFetch(o.Limiter); err != nil {
+		return nil, err
+	}
+
+	ref, err := parseOCIReference(source)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err)
+	}
+
+	manifestURL := fmt.Sprintf("%s/v2/%s/manifests/%s", ref.baseURL, ref.repository, ref.reference)
+	resp, err := o.get(ctx, manifestURL, ociManifestMediaType+", "+dockerManifestMediaType)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset manifest: %s", err)
+	}
+	var manifest ociManifest
+	err = json.NewDecoder(resp.Body).Decode(&manifest)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error decoding asset manifest: %s", err)
+	}
+
+	layer, err := ref.layer(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err)
+	}
+	if !strings.HasPrefix(layer.Digest, "sha256:") {
+		return nil, fmt.Errorf("error fetching asset: unsupported layer digest %q", layer.Digest)
+	}
+
+	blobURL := fmt.Sprintf("%s/v2/%s/blobs/%s", ref.baseURL, ref.repository, layer.Digest)
+	resp, err = o.get(ctx, blobURL, "")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err)
+	}
+	defer resp.Body.Close()
+
+	// Verify the content of the layer against its digest
+	h := sha256.New()
+	file, err := writeTempFile(io.TeeReader(resp.Body, h))
+	if err != nil {
+		return nil, err
+	}
+	if digest := "sha256:" + hex.EncodeToString(h.Sum(nil)); digest != layer.Digest {
+		file.Close()
+		_ = os.Remove(file.Name())
+		return nil, fmt.Errorf("error fetching asset: layer digest %s does not match %s", digest, layer.Digest)
+	}
+	return file, nil
+}
+
+// get requests the URL, authenticating with the registry if it requires it.
+func (o *ociFetcher) get(ctx context.Context, target, accept string) (*http.Response, error) {
+	client := o.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		return req.WithContext(ctx), nil
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		authorization, err := o.authorize(ctx, client, req.URL, challenge)
+		if err != nil {
+			return nil, err
+		}
+		if req, err = newRequest(); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", authorization)
+		if resp, err = client.Do(req); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("Response Code %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// authorize returns the Authorization header answering the authentication
+// challenge of the registry.
+func (o *ociFetcher) authorize(ctx context.Context, client *http.Client, registry *url.URL, challenge string) (string, error) {
+	username := lookupCredential(ctx, "OCI_USERNAME")
+	password := lookupCredential(ctx, "OCI_PASSWORD")
+
+	scheme, params := parseAuthChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if username == "" {
+			return "", errors.New("the registry requires credentials")
+		}
+		if registry.Scheme != "https" {
+			return "", errors.New("registry credentials are only sent over https")
+		}
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(username, password)
+		return req.Header.Get("Authorization"), nil
+	case "bearer":
+		realm, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return "", fmt.Errorf("invalid registry authentication realm %q", params["realm"])
+		}
+		query := realm.Query()
+		for _, param := range []string{"service", "scope"} {
+			if value := params[param]; value != "" {
+				query.Set(param, value)
+			}
+		}
+		realm.RawQuery = query.Encode()
+
+		req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		// Tokens of realms on other hosts, or over plain HTTP, are requested
+		// anonymously
+		if username != "" && realm.Scheme == "https" && realm.Host == registry.Host {
+			req.SetBasicAuth(username, password)
+		}
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return "", fmt.Errorf("error requesting registry token: %s", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("error requesting registry token: Response Code %d", resp.StatusCode)
+		}
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return "", fmt.Errorf("error decoding registry token: %s", err)
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		return "Bearer " + token.Token, nil
+	default:
+		return "", fmt.Errorf("unsupported registry authentication %q", scheme)
+	}
+}
+
+// parseAuthChallenge parses a WWW-Authenticate header such as
+// Bearer realm="https://auth.example.com/token",service="registry".
+func parseAuthChallenge(challenge string) (string, map[string]string) {
+	params := map[string]string{}
+	parts := strings.SplitN(strings.TrimSpace(challenge), " ", 2)
+	if len(parts) < 2 {
+		return parts[0], params
+	}
+	rest := parts[1]
+	for rest != "" {
+		kv := strings.SplitN(rest, "=", 2)
+		if len(kv) != 2 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(kv[0]))
+		value := strings.TrimSpace(kv[1])
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				break
+			}
+			params[key] = value[1 : end+1]
+			rest = value[end+2:]
+		} else {
+			end := strings.Index(value, ",")
+			if end < 0 {
+				end = len(value)
+			}
+			params[key] = value[:end]
+			rest = value[end:]
+		}
+		rest = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+	}
+	return parts[0], params
+}
+
+// parseOCIReference parses an OCI URL.
+func parseOCIReference(source string) (ociReference, error) {
+	var ref ociReference
+	u, err := url.Parse(source)
+	if err != nil {
+		return ref, err
+	}
+	if u.Host == "" {
+		return ref, fmt.Errorf("oci URL must have a registry: %s", source)
+	}
+	scheme := "https"
+	if u.Query().Get("insecure") == "true" {
+		scheme = "http"
+	}
+	ref.baseURL = fmt.Sprintf("%s://%s", scheme, u.Host)
+	ref.file = u.Query().Get("file")
+
+	path := strings.TrimPrefix(u.Path, "/")
+	if i := strings.Index(path, "@"); i >= 0 {
+		ref.repository, ref.reference = path[:i], path[i+1:]
+	} else if i := strings.LastIndex(path, ":"); i > strings.LastIndex(path, "/") {
+		ref.repository, ref.reference = path[:i], path[i+1:]
+	} else {
+		ref.repository, ref.reference = path, "latest"
+	}
+	if ref.repository == "" || ref.reference == "" {
+		return ref, fmt.Errorf("oci URL must have a repository and a tag or a digest: %s", source)
+	}
+	return ref, nil
+}
+
+// layer returns the layer of the manifest holding the asset.
+func (r ociReference) layer(manifest ociManifest) (ociDescriptor, error) {
+	if r.file == "" {
+		if len(manifest.Layers) != 1 {
+			return ociDescriptor{}, fmt.Errorf("artifact has %d layers, select one with the file query parameter", len(manifest.Layers))
+		}
+		return manifest.Layers[0], nil
+	}
+	for _, layer := range manifest.Layers {
+		if layer.Annotations[ociTitleAnnotation] == r.file {
+			return layer, nil
+		}
+	}
+	return ociDescriptor{}, fmt.Errorf("artifact has no layer titled %q", r.file)
+}
@@ -0,0 +1,212 @@
+package asset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRegistry returns a registry serving an artifact with the given
+// layers, which requires a bearer token.
+func newTestRegistry(t *testing.T, layers map[string]string) *httptest.Server {
+	t.Helper()
+	manifest := ociManifest{}
+	blobs := map[string]string{}
+	for title, content := range layers {
+		sum := sha256.Sum256([]byte(content))
+		digest := "sha256:" + hex.EncodeToString(sum[:])
+		blobs[digest] = content
+		manifest.Layers = append(manifest.Layers, ociDescriptor{
+			MediaType:   "application/vnd.sensu.asset.layer.v1.tar+gzip",
+			Digest:      digest,
+			Size:        int64(len(content)),
+			Annotations: map[string]string{ociTitleAnnotation: title},
+		})
+	}
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			assert.Equal(t, "repository:sensu/asset:pull", r.URL.Query().Get("scope"))
+			// Credentials are never sent over plain HTTP
+			_, _, ok := r.BasicAuth()
+			assert.False(t, ok)
+			_, _ = w.Write([]byte(`{"token": "secret-token"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:sensu/asset:pull"`, ts.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.URL.Path == "/v2/sensu/asset/manifests/1.0.0":
+			w.Header().Set("Content-Type", ociManifestMediaType)
+			_ = json.NewEncoder(w).Encode(manifest)
+		case strings.HasPrefix(r.URL.Path, "/v2/sensu/asset/blobs/"):
+			blob, ok := blobs[strings.TrimPrefix(r.URL.Path, "/v2/sensu/asset/blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(blob))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return ts
+}
+
+func TestOCIFetcherFetch(t *testing.T) {
+	ts := newTestRegistry(t, map[string]string{"asset.tar.gz": "asset"})
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	fetcher := &ociFetcher{}
+	f, err := fetcher.Fetch(context.Background(), fmt.Sprintf("oci://%s/sensu/asset:1.0.0?insecure=true", host), nil)
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	b, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "asset", string(b))
+
+	_, err = fetcher.Fetch(context.Background(), fmt.Sprintf("oci://%s/sensu/asset:2.0.0?insecure=true", host), nil)
+	assert.Error(t, err)
+}
+
+func TestOCIFetcherFetchLayer(t *testing.T) {
+	ts := newTestRegistry(t, map[string]string{
+		"asset-linux.tar.gz":   "linux",
+		"asset-darwin.tar.gz":  "darwin",
+		"asset-windows.tar.gz": "windows",
+	})
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	fetcher := &ociFetcher{}
+	_, err := fetcher.Fetch(context.Background(), fmt.Sprintf("oci://%s/sensu/asset:1.0.0?insecure=true", host), nil)
+	assert.Error(t, err)
+
+	f, err := fetcher.Fetch(context.Background(), fmt.Sprintf("oci://%s/sensu/asset:1.0.0?insecure=true&file=asset-darwin.tar.gz", host), nil)
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	b, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "darwin", string(b))
+}
+
+func TestOCIFetcherCredentials(t *testing.T) {
+	ts := newTestRegistry(t, map[string]string{"asset.tar.gz": "asset"})
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+	ctx := ContextWithSecrets(context.Background(), []string{"OCI_USERNAME=user", "OCI_PASSWORD=password"})
+
+	// The token is requested anonymously over plain HTTP
+	fetcher := &ociFetcher{}
+	f, err := fetcher.Fetch(ctx, fmt.Sprintf("oci://%s/sensu/asset:1.0.0?insecure=true", host), nil)
+	require.NoError(t, err)
+	f.Close()
+	_ = os.Remove(f.Name())
+
+	// Basic credentials are refused over plain HTTP
+	basic := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		assert.False(t, ok)
+		w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer basic.Close()
+	_, err = fetcher.Fetch(ctx, fmt.Sprintf("oci://%s/sensu/asset:1.0.0?insecure=true", strings.TrimPrefix(basic.URL, "http://")), nil)
+	assert.Error(t, err)
+}
+
+func TestOCIAuthorizeRealmHost(t *testing.T) {
+	var authorized bool
+	realm := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, authorized = r.BasicAuth()
+		_, _ = w.Write([]byte(`{"token": "token"}`))
+	}))
+	defer realm.Close()
+	ctx := ContextWithSecrets(context.Background(), []string{"OCI_USERNAME=user", "OCI_PASSWORD=password"})
+	challenge := fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, realm.URL)
+	fetcher := &ociFetcher{}
+
+	// The realm is on the registry host
+	registry, err := url.Parse(realm.URL + "/v2/sensu/asset/manifests/1.0.0")
+	require.NoError(t, err)
+	authorization, err := fetcher.authorize(ctx, realm.Client(), registry, challenge)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token", authorization)
+	assert.True(t, authorized)
+
+	// The realm is on another host
+	registry, err = url.Parse("https://registry.example.com/v2/sensu/asset/manifests/1.0.0")
+	require.NoError(t, err)
+	_, err = fetcher.authorize(ctx, realm.Client(), registry, challenge)
+	require.NoError(t, err)
+	assert.False(t, authorized)
+}
+
+func TestParseOCIReference(t *testing.T) {
+	tests := []struct {
+		source string
+		want   ociReference
+		err    bool
+	}{
+		{
+			source: "oci://registry.example.com/sensu/asset:1.0.0",
+			want:   ociReference{baseURL: "https://registry.example.com", repository: "sensu/asset", reference: "1.0.0"},
+		},
+		{
+			source: "oci://localhost:5000/asset?insecure=true",
+			want:   ociReference{baseURL: "http://localhost:5000", repository: "asset", reference: "latest"},
+		},
+		{
+			source: "oci://registry.example.com/sensu/asset@sha256:abcd?file=asset.tar.gz",
+			want:   ociReference{baseURL: "https://registry.example.com", repository: "sensu/asset", reference: "sha256:abcd", file: "asset.tar.gz"},
+		},
+		{
+			source: "oci:///sensu/asset:1.0.0",
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			got, err := parseOCIReference(tt.source)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseAuthChallenge(t *testing.T) {
+	scheme, params := parseAuthChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:sensu/asset:pull"`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:sensu/asset:pull",
+	}, params)
+
+	scheme, params = parseAuthChallenge(`Basic realm=registry`)
+	assert.Equal(t, "Basic", scheme)
+	assert.Equal(t, map[string]string{"realm": "registry"}, params)
+}
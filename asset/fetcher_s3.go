@@ -0,0 +1,222 @@
+package asset
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// s3DefaultRegion is the region of the buckets when none is configured
+	s3DefaultRegion = "us-east-1"
+
+	// emptyPayloadHash is the SHA-256 hash of an empty request body
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// An s3Fetcher fetches objects from S3 compatible object stores, with URLs
+// such as s3://bucket/path/to/object. The region and the endpoint of the
+// store can be set with the region and endpoint URL query parameters, which
+// default to the AWS_REGION credential and to AWS S3.
+//
+// Requests are signed with the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// optional AWS_SESSION_TOKEN credentials, looked up in the secrets of the
+// context. Requests are anonymous without them. Signed requests are only sent
+// over HTTPS, to AWS S3 or to the host of the AWS_S3_ENDPOINT credential, so
+// that the endpoint of an asset URL can't collect the credentials.
+type s3Fetcher struct {
+	Client  *http.Client
+	Limiter *rate.Limiter
+}
+
+// Fetch the object found at the S3 URL.
+func (s *s3Fetcher) Fetch(ctx context.Context, source string, headers map[string]string) (*os.File, error) {
+	if err := allowFetch(s.Limiter); err != nil {
+		return nil, err
+	}
+
+	objectURL, region, err := s3ObjectURL(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, objectURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err)
+	}
+	req = req.WithContext(ctx)
+
+	if accessKey := lookupCredential(ctx, "AWS_ACCESS_KEY_ID"); accessKey != "" {
+		if err := checkS3CredentialsHost(ctx, req.URL); err != nil {
+			return nil, fmt.Errorf("error fetching asset: %s", err)
+		}
+		credentials := s3Credentials{
+			AccessKeyID:     accessKey,
+			SecretAccessKey: lookupCredential(ctx, "AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    lookupCredential(ctx, "AWS_SESSION_TOKEN"),
+		}
+		signS3Request(req, credentials, region, time.Now())
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching asset: Response Code %d", resp.StatusCode)
+	}
+
+	return writeTempFile(resp.Body)
+}
+
+// s3ObjectURL returns the HTTP URL of the object of the S3 URL, and the
+// region of its bucket.
+func s3ObjectURL(ctx context.Context, source string) (string, string, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return "", "", err
+	}
+	bucket := u.Host
+	key := strings.TrimPrefix(u.Path, "/")
+	if bucket == "" || key == "" {
+		return "", "", fmt.Errorf("s3 URL must have a bucket and an object key: %s", source)
+	}
+
+	query := u.Query()
+	region := query.Get("region")
+	if region == "" {
+		region = lookupCredential(ctx, "AWS_REGION")
+	}
+	if region == "" {
+		region = s3DefaultRegion
+	}
+
+	// Use virtual-hosted style requests with AWS S3, and path style requests
+	// with the other object stores
+	if endpoint := query.Get("endpoint"); endpoint != "" {
+		return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(endpoint, "/"), awsURIEncode(bucket, true), awsURIEncode(key, false)), region, nil
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", bucket, region, awsURIEncode(key, false)), region, nil
+}
+
+// checkS3CredentialsHost returns an error if the credentials can't be sent to
+// the object URL: they are only sent over HTTPS, to AWS S3 or to the host of
+// the AWS_S3_ENDPOINT credential.
+func checkS3CredentialsHost(ctx context.Context, objectURL *url.URL) error {
+	if objectURL.Scheme != "https" {
+		return errors.New("s3 credentials are only sent over https")
+	}
+	if strings.HasSuffix(objectURL.Hostname(), ".amazonaws.com") {
+		return nil
+	}
+	if endpoint, err := url.Parse(lookupCredential(ctx, "AWS_S3_ENDPOINT")); err == nil && endpoint.Host == objectURL.Host {
+		return nil
+	}
+	return fmt.Errorf("s3 credentials are only sent to AWS S3 or to the AWS_S3_ENDPOINT host, not to %s", objectURL.Host)
+}
+
+// s3Credentials are the credentials signing S3 requests.
+type s3Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// signS3Request signs the request without body with AWS Signature Version 4,
+// including all its headers in the signature.
+func signS3Request(req *http.Request, credentials s3Credentials, region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	if credentials.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", credentials.SessionToken)
+	}
+
+	// Canonical headers, sorted by their lowercase names
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, headers[name])
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		emptyPayloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, "s3", "aws4_request"}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+credentials.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		credentials.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// awsURIEncode encodes all the characters of the string but the unreserved
+// ones, and the slashes unless encodeSlash is set.
+func awsURIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
@@ -85,3 +86,61 @@ func TestHTTPGetNon200(t *testing.T) {
 	assert.Nil(t, closer)
 	assert.EqualError(t, err, "error fetching asset: Response Code 404")
 }
+
+func TestFileFetcherFetch(t *testing.T) {
+	assetName := "rubby-on-rails.tar"
+	localAssetPath := getFixturePath(assetName)
+
+	fetcher := &fileFetcher{}
+	f, err := fetcher.Fetch(context.TODO(), (&url.URL{Scheme: "file", Path: filepath.ToSlash(localAssetPath)}).String(), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	defer f.Close()
+	defer os.Remove(f.Name())
+
+	// The fetched file is a copy of the staged asset
+	assert.NotEqual(t, localAssetPath, f.Name())
+	desiredSHA, _ := ioutil.ReadFile(getFixturePath(fmt.Sprintf("%s.sha512", assetName)))
+	verifier := &Sha512Verifier{}
+	assert.NoError(t, verifier.Verify(f, string(desiredSHA)))
+
+	_, err = fetcher.Fetch(context.TODO(), "file://remote/asset.tar", nil)
+	assert.Error(t, err)
+
+	_, err = fetcher.Fetch(context.TODO(), "file:///nonexistent/asset.tar", nil)
+	assert.Error(t, err)
+}
+
+type schemeTestFetcher struct {
+	scheme string
+	called *string
+}
+
+func (s *schemeTestFetcher) Fetch(context.Context, string, map[string]string) (*os.File, error) {
+	*s.called = s.scheme
+	return nil, nil
+}
+
+func TestSchemeFetcherFetch(t *testing.T) {
+	var called string
+	fetcher := &schemeFetcher{
+		fetchers: map[string]Fetcher{
+			"file": &schemeTestFetcher{scheme: "file", called: &called},
+			"s3":   &schemeTestFetcher{scheme: "s3", called: &called},
+		},
+		http: &schemeTestFetcher{scheme: "http", called: &called},
+	}
+
+	tests := map[string]string{
+		"file:///tmp/asset.tar.gz":           "file",
+		"S3://bucket/asset.tar.gz":           "s3",
+		"https://example.com/asset.tar.gz":   "http",
+		"http://example.com/asset.tar.gz":    "http",
+		"unknown://example.com/asset.tar.gz": "http",
+	}
+	for source, scheme := range tests {
+		_, _ = fetcher.Fetch(context.TODO(), source, nil)
+		assert.Equal(t, scheme, called, source)
+	}
+}
@@ -2,6 +2,14 @@
 
 package asset
 
-import "crypto/x509"
+import (
+	"crypto/x509"
+	"net/url"
+)
 
 func appendCerts(rootCAs *x509.CertPool) {}
+
+// fileURLPath returns the path of the file URL on the local filesystem.
+func fileURLPath(u *url.URL) string {
+	return u.Path
+}
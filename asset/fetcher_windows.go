@@ -4,6 +4,8 @@ package asset
 
 import (
 	"crypto/x509"
+	"net/url"
+	"path/filepath"
 	"syscall"
 	"unsafe"
 )
@@ -43,3 +45,13 @@ func appendCerts(rootCAs *x509.CertPool) {
 		}
 	}
 }
+
+// fileURLPath returns the path of the file URL on the local filesystem, where
+// file:///C:/path/to/file is C:\path\to\file.
+func fileURLPath(u *url.URL) string {
+	path := u.Path
+	if len(path) > 2 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+	return filepath.FromSlash(path)
+}
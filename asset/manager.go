@@ -18,6 +18,19 @@ const (
 
 // Manager ...
 type Manager struct {
+	// SignatureVerifier, if set, requires the assets to be signed and verifies
+	// their signatures.
+	SignatureVerifier SignatureVerifier
+
+	// CacheMaxSize is the maximum size of the asset cache, in bytes. The least
+	// recently used assets are removed when it is exceeded. Zero means no limit.
+	CacheMaxSize int64
+
+	// CacheUnusedTTL is the duration after which the assets which were not
+	// used are removed from the cache. Zero means the assets are kept.
+	CacheUnusedTTL time.Duration
+
+	cache         *boltDBAssetManager
 	cacheDir      string
 	entity        *types.Entity
 	wg            *sync.WaitGroup
@@ -56,8 +69,25 @@ func (m *Manager) StartAssetManager(ctx context.Context, limiter *rate.Limiter)
 			logger.Debug(err)
 		}
 	}()
-	boltDBGetter := NewBoltDBGetter(
-		db, m.cacheDir, m.trustedCAFile, nil, nil, nil, limiter)
+	m.cache = newBoltDBAssetManager(
+		db, m.cacheDir, m.trustedCAFile, nil, nil, m.SignatureVerifier, nil, limiter)
+
+	if m.CacheMaxSize > 0 || m.CacheUnusedTTL > 0 {
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			m.cache.collectPeriodically(ctx, m.CacheMaxSize, m.CacheUnusedTTL)
+		}()
+	}
+
+	return NewFilteredManager(m.cache, m.entity), nil
+}
 
-	return NewFilteredManager(boltDBGetter, m.entity), nil
+// CachedAssets returns the assets installed in the cache, or none if the
+// asset manager was not started.
+func (m *Manager) CachedAssets() ([]CachedAsset, error) {
+	if m.cache == nil {
+		return nil, nil
+	}
+	return m.cache.List()
 }
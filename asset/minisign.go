@@ -0,0 +1,165 @@
+package asset
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// minisignAlgorithm is the algorithm of minisign keys and of legacy
+	// signatures, which sign the file itself. Legacy signatures are refused,
+	// since verifying them requires the whole file in memory.
+	minisignAlgorithm = "Ed"
+
+	// minisignHashedAlgorithm is the algorithm of minisign signatures which
+	// sign the BLAKE2b-512 hash of the file
+	minisignHashedAlgorithm = "ED"
+
+	minisignKeyIDSize        = 8
+	minisignUntrustedComment = "untrusted comment:"
+	minisignTrustedComment   = "trusted comment: "
+)
+
+// minisignPublicKey is a public key generated by minisign.
+type minisignPublicKey struct {
+	id  [minisignKeyIDSize]byte
+	key ed25519.PublicKey
+}
+
+// MinisignVerifier verifies that files match their detached minisign
+// signatures, made with one of its trusted keys.
+type MinisignVerifier struct {
+	keys []minisignPublicKey
+}
+
+// NewMinisignVerifier returns a MinisignVerifier trusting the given public
+// keys, either as the content of the public key files generated by minisign or
+// as their base64 encoded key line.
+func NewMinisignVerifier(publicKeys ...string) (*MinisignVerifier, error) {
+	if len(publicKeys) == 0 {
+		return nil, errors.New("at least one public key is required")
+	}
+	v := &MinisignVerifier{}
+	for _, publicKey := range publicKeys {
+		key, err := parseMinisignPublicKey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		v.keys = append(v.keys, key)
+	}
+	return v, nil
+}
+
+func parseMinisignPublicKey(publicKey string) (minisignPublicKey, error) {
+	var key minisignPublicKey
+	lines := minisignLines(publicKey)
+	if len(lines) != 1 {
+		return key, errors.New("invalid minisign public key")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(lines[0])
+	if err != nil {
+		return key, fmt.Errorf("invalid minisign public key: %s", err)
+	}
+	if len(decoded) != len(minisignAlgorithm)+minisignKeyIDSize+ed25519.PublicKeySize {
+		return key, errors.New("invalid minisign public key size")
+	}
+	if string(decoded[:2]) != minisignAlgorithm {
+		return key, fmt.Errorf("unsupported minisign public key algorithm %q", decoded[:2])
+	}
+	copy(key.id[:], decoded[2:2+minisignKeyIDSize])
+	key.key = ed25519.PublicKey(decoded[2+minisignKeyIDSize:])
+	return key, nil
+}
+
+// minisignLines returns the non-empty lines of a minisign file, without its
+// untrusted comment.
+func minisignLines(content string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, minisignUntrustedComment) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// VerifySignature verifies that the file matches the minisign signature,
+// including its trusted comment, and rewinds the file.
+func (v *MinisignVerifier) VerifySignature(rs io.ReadSeeker, signature []byte) error {
+	lines := minisignLines(string(signature))
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], minisignTrustedComment) {
+		return errors.New("invalid minisign signature")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(lines[0])
+	if err != nil {
+		return fmt.Errorf("invalid minisign signature: %s", err)
+	}
+	if len(decoded) != len(minisignAlgorithm)+minisignKeyIDSize+ed25519.SignatureSize {
+		return errors.New("invalid minisign signature size")
+	}
+	algorithm := string(decoded[:2])
+	keyID := decoded[2 : 2+minisignKeyIDSize]
+	sig := decoded[2+minisignKeyIDSize:]
+	trustedComment := strings.TrimPrefix(lines[1], minisignTrustedComment)
+	globalSig, err := base64.StdEncoding.DecodeString(lines[2])
+	if err != nil {
+		return fmt.Errorf("invalid minisign global signature: %s", err)
+	}
+
+	var key *minisignPublicKey
+	for i := range v.keys {
+		if bytes.Equal(v.keys[i].id[:], keyID) {
+			key = &v.keys[i]
+			break
+		}
+	}
+	if key == nil {
+		return fmt.Errorf("signed by untrusted key %s", strings.ToUpper(hex.EncodeToString(keyID)))
+	}
+
+	switch algorithm {
+	case minisignHashedAlgorithm:
+	case minisignAlgorithm:
+		return errors.New("legacy minisign signatures are not supported, sign the asset with minisign -H")
+	default:
+		return fmt.Errorf("unsupported minisign signature algorithm %q", algorithm)
+	}
+	message, err := hashAndRewind(rs)
+	if err != nil {
+		return fmt.Errorf("reading asset for signature verification failed: %s", err)
+	}
+
+	if !ed25519.Verify(key.key, message, sig) {
+		return errors.New("the signature of the asset is invalid")
+	}
+	if !ed25519.Verify(key.key, append(append([]byte{}, sig...), trustedComment...), globalSig) {
+		return errors.New("the trusted comment of the signature is invalid")
+	}
+	return nil
+}
+
+func hashAndRewind(rs io.ReadSeeker) ([]byte, error) {
+	h, err := blake2b.New512(nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(h, rs); err != nil {
+		return nil, err
+	}
+	if _, err := rs.Seek(0, 0); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,139 @@
+package asset
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+// minisignKey generates a minisign key pair, returning the content of the
+// public key file and a function signing content like minisign does.
+func minisignKey(t *testing.T, keyID string) (string, func(content []byte, hashed bool) []byte) {
+	t.Helper()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	encodedKey := base64.StdEncoding.EncodeToString(append([]byte("Ed"+keyID), publicKey...))
+	publicKeyFile := fmt.Sprintf("untrusted comment: minisign public key %s\n%s\n", keyID, encodedKey)
+
+	sign := func(content []byte, hashed bool) []byte {
+		algorithm := "Ed"
+		if hashed {
+			algorithm = "ED"
+			sum := blake2b.Sum512(content)
+			content = sum[:]
+		}
+		sig := ed25519.Sign(privateKey, content)
+		trustedComment := "timestamp:1634567890\tfile:asset.tar.gz"
+		globalSig := ed25519.Sign(privateKey, append(append([]byte{}, sig...), trustedComment...))
+		return []byte(fmt.Sprintf(
+			"untrusted comment: signature from minisign secret key\n%s\ntrusted comment: %s\n%s\n",
+			base64.StdEncoding.EncodeToString(append([]byte(algorithm+keyID), sig...)),
+			trustedComment,
+			base64.StdEncoding.EncodeToString(globalSig),
+		))
+	}
+	return publicKeyFile, sign
+}
+
+func TestNewMinisignVerifier(t *testing.T) {
+	publicKey, _ := minisignKey(t, "01234567")
+
+	_, err := NewMinisignVerifier(publicKey)
+	assert.NoError(t, err)
+
+	_, err = NewMinisignVerifier()
+	assert.Error(t, err)
+
+	_, err = NewMinisignVerifier("untrusted comment: foo\nbar\n")
+	assert.Error(t, err)
+
+	_, err = NewMinisignVerifier(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Error(t, err)
+}
+
+func TestMinisignVerifier(t *testing.T) {
+	publicKey, sign := minisignKey(t, "01234567")
+	otherPublicKey, otherSign := minisignKey(t, "76543210")
+	verifier, err := NewMinisignVerifier(otherPublicKey, publicKey)
+	require.NoError(t, err)
+
+	content := []byte("asset content")
+
+	tests := []struct {
+		name      string
+		content   []byte
+		signature []byte
+		wantErr   bool
+	}{
+		{
+			name:      "legacy signature",
+			content:   content,
+			signature: sign(content, false),
+			wantErr:   true,
+		},
+		{
+			name:      "hashed signature",
+			content:   content,
+			signature: sign(content, true),
+		},
+		{
+			name:      "signature of the other trusted key",
+			content:   content,
+			signature: otherSign(content, true),
+		},
+		{
+			name:      "tampered content",
+			content:   []byte("tampered content"),
+			signature: sign(content, true),
+			wantErr:   true,
+		},
+		{
+			name:      "tampered trusted comment",
+			content:   content,
+			signature: bytes.Replace(sign(content, true), []byte("timestamp"), []byte("timestomp"), 1),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid signature",
+			content:   content,
+			signature: []byte("untrusted comment: foo\nbar\n"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := bytes.NewReader(tt.content)
+			err := verifier.VerifySignature(file, tt.signature)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// The file is rewound for its expansion
+			b, err := ioutil.ReadAll(file)
+			require.NoError(t, err)
+			assert.Equal(t, tt.content, b)
+		})
+	}
+}
+
+func TestMinisignVerifierUntrustedKey(t *testing.T) {
+	publicKey, _ := minisignKey(t, "01234567")
+	_, sign := minisignKey(t, "76543210")
+	verifier, err := NewMinisignVerifier(publicKey)
+	require.NoError(t, err)
+
+	content := []byte("asset content")
+	err = verifier.VerifySignature(bytes.NewReader(content), sign(content, true))
+	assert.Error(t, err)
+}
@@ -18,6 +18,11 @@ type Verifier interface {
 	Verify(file io.ReadSeeker, sha512 string) error
 }
 
+// A SignatureVerifier verifies that a file matches its detached signature.
+type SignatureVerifier interface {
+	VerifySignature(file io.ReadSeeker, signature []byte) error
+}
+
 // Sha512Verifier verifies that a file matches a specified SHA-512 sum.
 type Sha512Verifier struct{}
 
@@ -97,7 +97,10 @@ type Agentd struct {
 	cancel              context.CancelFunc
 	writeTimeout        int
 	namespaceCache      *cache.Resource
+	checkConfigCache    *cache.Resource
+	assetCache          *cache.Resource
 	watcher             <-chan store.WatchEventEntityConfig
+	checkWatcher        <-chan store.WatchEventCheckConfig
 	client              *clientv3.Client
 	etcdClientTLSConfig *tls.Config
 	healthRouter        *routers.HealthRouter
@@ -115,6 +118,7 @@ type Config struct {
 	Client              *clientv3.Client
 	EtcdClientTLSConfig *tls.Config
 	Watcher             <-chan store.WatchEventEntityConfig
+	CheckWatcher        <-chan store.WatchEventCheckConfig
 }
 
 // Option is a functional option.
@@ -139,6 +143,7 @@ func New(c Config, opts ...Option) (*Agentd, error) {
 		writeTimeout:        c.WriteTimeout,
 		storev2:             etcdstore.NewStore(c.Client),
 		watcher:             c.Watcher,
+		checkWatcher:        c.CheckWatcher,
 		client:              c.Client,
 		etcdClientTLSConfig: c.EtcdClientTLSConfig,
 	}
@@ -199,6 +204,16 @@ func New(c Config, opts ...Option) (*Agentd, error) {
 		return nil, err
 	}
 
+	a.checkConfigCache, err = cache.New(ctx, c.Client, &corev2.CheckConfig{}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	a.assetCache, err = cache.New(ctx, c.Client, &corev2.Asset{}, false)
+	if err != nil {
+		return nil, err
+	}
+
 	return a, nil
 }
 
@@ -227,6 +242,7 @@ func (a *Agentd) Start() error {
 	}()
 
 	go a.runWatcher()
+	go a.runCheckWatcher()
 
 	sessionCounterOnce.Do(func() {
 		if err := prometheus.Register(sessionCounter); err != nil {
@@ -274,6 +290,47 @@ func (a *Agentd) handleEvent(event store.WatchEventEntityConfig) error {
 	return nil
 }
 
+func (a *Agentd) runCheckWatcher() {
+	defer func() {
+		logger.Warn("shutting down check config watcher")
+	}()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case event, ok := <-a.checkWatcher:
+			if !ok {
+				return
+			}
+			if err := a.handleCheckEvent(event); err != nil {
+				logger.WithError(err).Error("error handling check config watch event")
+			}
+		}
+	}
+}
+
+// handleCheckEvent publishes the check config updates to the sessions of the
+// agents of the check namespace, so they prefetch the assets of the check
+func (a *Agentd) handleCheckEvent(event store.WatchEventCheckConfig) error {
+	if event.CheckConfig == nil {
+		return errors.New("nil check received from check config watcher")
+	}
+	if event.Action != store.WatchCreate && event.Action != store.WatchUpdate {
+		return nil
+	}
+	if len(event.CheckConfig.RuntimeAssets) == 0 {
+		return nil
+	}
+
+	topic := messaging.CheckConfigTopic(event.CheckConfig.Namespace)
+	if err := a.bus.Publish(topic, &event); err != nil {
+		logger.WithField("topic", topic).WithError(err).
+			Error("unable to publish a check config update to the bus")
+		return err
+	}
+	return nil
+}
+
 // Stop Agentd.
 func (a *Agentd) Stop() error {
 	a.cancel()
@@ -375,6 +432,9 @@ func (a *Agentd) webSocketHandler(w http.ResponseWriter, r *http.Request) {
 		Storev2:       a.storev2,
 		Marshal:       marshal,
 		Unmarshal:     unmarshal,
+
+		CheckConfigCache: a.checkConfigCache,
+		AssetCache:       a.assetCache,
 	}
 
 	cfg.Subscriptions = corev2.AddEntitySubscription(cfg.AgentName, cfg.Subscriptions)
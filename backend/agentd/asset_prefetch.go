@@ -0,0 +1,83 @@
+package agentd
+
+import (
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// assetPrefetch is a request to send to the agent the assets required by the
+// checks targeting the given subscriptions. All the checks of the namespace
+// are considered when checks is nil.
+type assetPrefetch struct {
+	checks        []*corev2.CheckConfig
+	subscriptions []string
+}
+
+// queueAssetPrefetch queues a request to send the assets required by the checks
+// targeting the subscriptions to the agent, so it fetches them ahead of the
+// first execution of the checks
+func (s *Session) queueAssetPrefetch(checks []*corev2.CheckConfig, subscriptions []string) {
+	select {
+	case s.assetPrefetch <- assetPrefetch{checks: checks, subscriptions: subscriptions}:
+	default:
+		logger.WithField("agent", s.cfg.AgentName).Warn("too many pending asset prefetches, ignoring")
+	}
+}
+
+// prefetchedAssets returns the assets required by the checks of the prefetch
+// request which target its subscriptions. The checks and assets are read from
+// the caches shared by the sessions, so a prefetch never lists the store.
+func (s *Session) prefetchedAssets(req assetPrefetch) *corev2.AssetList {
+	checks := req.checks
+	if checks == nil && s.cfg.CheckConfigCache != nil {
+		for _, value := range s.cfg.CheckConfigCache.Get(s.cfg.Namespace) {
+			if check, ok := value.Resource.(*corev2.CheckConfig); ok {
+				checks = append(checks, check)
+			}
+		}
+	}
+
+	var names []string
+	for _, check := range checks {
+		if len(check.RuntimeAssets) == 0 || !checkTargets(check, req.subscriptions) {
+			continue
+		}
+		names = append(names, check.RuntimeAssets...)
+	}
+	if len(names) == 0 || s.cfg.AssetCache == nil {
+		return nil
+	}
+
+	list := &corev2.AssetList{}
+	for _, value := range s.cfg.AssetCache.Get(s.cfg.Namespace) {
+		asset, ok := value.Resource.(*corev2.Asset)
+		if ok && assetIsRelevant(asset, names) {
+			list.Assets = append(list.Assets, *asset)
+		}
+	}
+	return list
+}
+
+// checkTargets returns whether the check targets one of the subscriptions
+func checkTargets(check *corev2.CheckConfig, subscriptions []string) bool {
+	for _, subscription := range subscriptions {
+		for _, checkSubscription := range check.Subscriptions {
+			if subscription != "" && subscription == checkSubscription {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// assetIsRelevant returns whether the asset is one of the named assets, or one
+// of their builds, following the scheduler
+func assetIsRelevant(asset *corev2.Asset, names []string) bool {
+	for _, name := range names {
+		if strings.HasPrefix(asset.Name, name) {
+			return true
+		}
+	}
+	return false
+}
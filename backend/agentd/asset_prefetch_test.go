@@ -0,0 +1,145 @@
+package agentd
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/mocktransport"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_prefetchedAssets(t *testing.T) {
+	checkFoo := corev2.FixtureCheckConfig("foo")
+	checkFoo.Subscriptions = []string{"linux"}
+	checkFoo.RuntimeAssets = []string{"foo"}
+	checkBar := corev2.FixtureCheckConfig("bar")
+	checkBar.Subscriptions = []string{"windows"}
+	checkBar.RuntimeAssets = []string{"bar"}
+	checkBaz := corev2.FixtureCheckConfig("baz")
+	checkBaz.Subscriptions = []string{"linux"}
+
+	assetFoo := corev2.FixtureAsset("foo")
+	assetFooBuild := corev2.FixtureAsset("foo-build")
+	assetBar := corev2.FixtureAsset("bar")
+
+	s := &Session{
+		cfg: SessionConfig{
+			Namespace: "default",
+			CheckConfigCache: cache.NewFromResources([]corev2.Resource{
+				checkFoo, checkBar, checkBaz,
+			}, false),
+			AssetCache: cache.NewFromResources([]corev2.Resource{
+				assetFoo, assetFooBuild, assetBar,
+			}, false),
+		},
+		ctx: context.Background(),
+	}
+
+	tests := []struct {
+		name          string
+		checks        []*corev2.CheckConfig
+		subscriptions []string
+		want          []string
+	}{
+		{
+			name:          "checks of the namespace targeting the subscriptions",
+			subscriptions: []string{"linux"},
+			want:          []string{"foo", "foo-build"},
+		},
+		{
+			name:          "given check",
+			checks:        []*corev2.CheckConfig{checkBar},
+			subscriptions: []string{"linux", "windows"},
+			want:          []string{"bar"},
+		},
+		{
+			name:          "no check targets the subscriptions",
+			subscriptions: []string{"darwin"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := s.prefetchedAssets(assetPrefetch{checks: tt.checks, subscriptions: tt.subscriptions})
+			var names []string
+			if assets != nil {
+				for _, asset := range assets.Assets {
+					names = append(names, asset.Name)
+				}
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestSession_senderAssetPrefetch(t *testing.T) {
+	check := corev2.FixtureCheckConfig("foo")
+	check.Subscriptions = []string{"linux"}
+	check.RuntimeAssets = []string{"foo"}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	conn := new(mocktransport.MockTransport)
+	conn.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		msg := args[0].(*transport.Message)
+		assert.Equal(t, transport.MessageTypeAssetPrefetch, msg.Type)
+		var assets corev2.AssetList
+		assert.NoError(t, UnmarshalJSON(msg.Payload, &assets))
+		if assert.Len(t, assets.Assets, 1) {
+			assert.Equal(t, "foo", assets.Assets[0].Name)
+		}
+		wg.Done()
+	}).Return(nil)
+	conn.On("Closed").Return(true)
+	conn.On("Close").Return(nil)
+
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	session, err := NewSession(context.Background(), SessionConfig{
+		AgentName:     "testing",
+		Namespace:     "default",
+		Subscriptions: []string{"linux"},
+		Conn:          conn,
+		Bus:           bus,
+		Store:         &mockstore.MockStore{},
+		Unmarshal:     UnmarshalJSON,
+		Marshal:       MarshalJSON,
+		AssetCache: cache.NewFromResources([]corev2.Resource{
+			corev2.FixtureAsset("foo"),
+		}, false),
+	})
+	require.NoError(t, err)
+	session.wg = &sync.WaitGroup{}
+	session.wg.Add(1)
+
+	_, err = bus.Subscribe(messaging.CheckConfigTopic("default"), "testing", session.entityConfig)
+	require.NoError(t, err)
+
+	go session.sender()
+
+	event := &store.WatchEventCheckConfig{Action: store.WatchUpdate, CheckConfig: check}
+	require.NoError(t, bus.Publish(messaging.CheckConfigTopic("default"), event))
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		session.cancel()
+	case <-time.After(5 * time.Second):
+		t.Fatal("the assets were never sent to the agent")
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/sensu/sensu-go/backend/metrics"
 	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
 	"github.com/sensu/sensu-go/handler"
 	"github.com/sensu/sensu-go/transport"
@@ -101,7 +102,7 @@ func MarshalJSON(msg proto.Message) ([]byte, error) { return json.Marshal(msg) }
 type Session struct {
 	cfg              SessionConfig
 	conn             transport.Transport
-	store            store.EntityStore
+	store            store.Store
 	storev2          storev2.Interface
 	handler          *handler.MessageHandler
 	wg               *sync.WaitGroup
@@ -114,6 +115,7 @@ type Session struct {
 	marshal          MarshalFunc
 	unmarshal        UnmarshalFunc
 	entityConfig     *entityConfig
+	assetPrefetch    chan assetPrefetch
 	mu               sync.Mutex
 	subscriptionsMap map[string]subscription
 }
@@ -161,6 +163,11 @@ type SessionConfig struct {
 	Store    store.Store
 	Storev2  storev2.Interface
 
+	// CheckConfigCache and AssetCache are shared by the sessions to resolve
+	// the assets to prefetch.
+	CheckConfigCache *cache.Resource
+	AssetCache       *cache.Resource
+
 	Marshal   MarshalFunc
 	Unmarshal UnmarshalFunc
 }
@@ -194,9 +201,10 @@ func NewSession(ctx context.Context, cfg SessionConfig) (*Session, error) {
 		unmarshal:        cfg.Unmarshal,
 		marshal:          cfg.Marshal,
 		entityConfig: &entityConfig{
-			subscriptions:  make(chan messaging.Subscription, 1),
+			subscriptions:  make(chan messaging.Subscription, 2),
 			updatesChannel: make(chan interface{}, 10),
 		},
+		assetPrefetch: make(chan assetPrefetch, 10),
 	}
 	if err := s.bus.Publish(messaging.TopicKeepalive, makeEntitySwitchBurialEvent(cfg)); err != nil {
 		return nil, err
@@ -299,6 +307,18 @@ func (s *Session) sender() {
 		var msg *transport.Message
 		select {
 		case e := <-s.entityConfig.updatesChannel:
+			// Check config updates are received along with the entity config
+			// updates, and the assets of the checks are sent to the agent
+			if checkEvent, ok := e.(*store.WatchEventCheckConfig); ok {
+				if checkEvent.CheckConfig != nil {
+					s.mu.Lock()
+					subscriptions := append([]string{}, s.cfg.Subscriptions...)
+					s.mu.Unlock()
+					s.queueAssetPrefetch([]*corev2.CheckConfig{checkEvent.CheckConfig}, subscriptions)
+				}
+				continue
+			}
+
 			watchEvent, ok := e.(*store.WatchEventEntityConfig)
 			if !ok {
 				logger.Errorf("session received unexpected struct: %T", e)
@@ -373,6 +393,7 @@ func (s *Session) sender() {
 				// The error will already be logged so we can ignore it, and we still
 				// want to send the entity config update to the agent
 				_ = s.subscribe(added)
+				s.queueAssetPrefetch(nil, added)
 			}
 			if len(removed) > 0 {
 				lager.Debugf("found %d subscription(s) to unsubscribe from: %v", len(removed), removed)
@@ -398,6 +419,19 @@ func (s *Session) sender() {
 			}
 
 			msg = transport.NewMessage(corev2.CheckRequestType, configBytes)
+		case req := <-s.assetPrefetch:
+			assets := s.prefetchedAssets(req)
+			if assets == nil || len(assets.Assets) == 0 {
+				continue
+			}
+
+			assetsBytes, err := s.marshal(assets)
+			if err != nil {
+				logger.WithError(err).Error("session failed to serialize assets to prefetch")
+				continue
+			}
+
+			msg = transport.NewMessage(transport.MessageTypeAssetPrefetch, assetsBytes)
 		case <-s.ctx.Done():
 			return
 		}
@@ -535,6 +569,18 @@ func (s *Session) Start() (err error) {
 		return err
 	}
 
+	// Subscribe the agent to the check config updates of its namespace, and
+	// send it the assets of the checks targeting it
+	topic = messaging.CheckConfigTopic(s.cfg.Namespace)
+	lager.WithField("topic", topic).Debug("subscribing to topic")
+	subscription, err = s.bus.Subscribe(topic, agentName, s.entityConfig)
+	if err != nil {
+		lager.WithError(err).Error("error starting subscription")
+		return err
+	}
+	s.entityConfig.subscriptions <- subscription
+	s.queueAssetPrefetch(nil, subs)
+
 	return nil
 }
 
@@ -261,6 +261,7 @@ func TestSession_sender(t *testing.T) {
 
 			// Mock our store
 			st := &mockstore.MockStore{}
+			st.On("GetCheckConfigs", mock.Anything, mock.Anything).Return([]*corev2.CheckConfig{}, nil)
 			storev2 := &storetest.Store{}
 			if tt.storeFunc != nil {
 				tt.storeFunc(storev2, wg)
@@ -405,6 +406,7 @@ func TestSession_Start(t *testing.T) {
 
 			// Mock our store
 			st := &mockstore.MockStore{}
+			st.On("GetCheckConfigs", mock.Anything, mock.Anything).Return([]*corev2.CheckConfig{}, nil)
 			storev2 := &storetest.Store{}
 			if tt.storeFunc != nil {
 				tt.storeFunc(storev2, wg)
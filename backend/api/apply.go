@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// applyKinds are the kinds of resources that can be applied in bulk, by RBAC
+// name, and whether they are namespaced. Their API stores them as they are,
+// unlike entities, users or silenced entries for instance.
+var applyKinds = map[string]bool{
+	(&corev2.Asset{}).RBACName():              true,
+	(&corev2.CheckConfig{}).RBACName():        true,
+	(&corev2.CheckOverride{}).RBACName():      true,
+	(&corev2.ClusterRole{}).RBACName():        false,
+	(&corev2.ClusterRoleBinding{}).RBACName(): false,
+	(&corev2.EventFilter{}).RBACName():        true,
+	(&corev2.Handler{}).RBACName():            true,
+	(&corev2.HookConfig{}).RBACName():         true,
+	(&corev2.Mutator{}).RBACName():            true,
+	(&corev2.Pipeline{}).RBACName():           true,
+	(&corev2.Role{}).RBACName():               true,
+	(&corev2.RoleBinding{}).RBACName():        true,
+}
+
+// ResourceError is the reason why a resource of a batch can't be applied.
+type ResourceError struct {
+	// Index is the position of the resource in the batch
+	Index int
+	Err   error
+}
+
+func (e *ResourceError) Error() string {
+	return fmt.Sprintf("resource %d: %s", e.Index, e.Err)
+}
+
+// ResourceErrors are returned when resources of a batch can't be applied, in
+// which case none of them are.
+type ResourceErrors []*ResourceError
+
+func (e ResourceErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ApplyClient is an API client applying batches of resources.
+type ApplyClient struct {
+	store store.ResourceStore
+	auth  authorization.Authorizer
+}
+
+// NewApplyClient creates a new ApplyClient, given a store and authorizer.
+func NewApplyClient(store store.ResourceStore, auth authorization.Authorizer) *ApplyClient {
+	return &ApplyClient{
+		store: store,
+		auth:  auth,
+	}
+}
+
+// Apply creates or updates the resources, all of them or none, and returns
+// what applying each of them did. Nothing is written when dryRun is true.
+// ResourceErrors are returned when some of the resources are not valid or the
+// user is not authorized to update them.
+func (a *ApplyClient) Apply(ctx context.Context, resources []corev2.Resource, dryRun bool) ([]store.ApplyAction, error) {
+	var errs ResourceErrors
+	for i, resource := range resources {
+		if err := a.check(ctx, resource); err != nil {
+			errs = append(errs, &ResourceError{Index: i, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	for _, resource := range resources {
+		setCreatedBy(ctx, resource)
+	}
+	return a.store.ApplyResources(ctx, resources, dryRun)
+}
+
+// check verifies that a resource can be applied, and that the user is
+// authorized to update it.
+func (a *ApplyClient) check(ctx context.Context, resource corev2.Resource) error {
+	namespaced, ok := applyKinds[resource.RBACName()]
+	if !ok {
+		return fmt.Errorf("%s can't be applied in bulk", resource.RBACName())
+	}
+	meta := resource.GetObjectMeta()
+	if namespaced && meta.Namespace == "" {
+		return fmt.Errorf("the namespace of %s %q is missing", resource.RBACName(), meta.Name)
+	}
+	if !namespaced && meta.Namespace != "" {
+		return fmt.Errorf("%s %q is not namespaced", resource.RBACName(), meta.Name)
+	}
+	if err := resource.Validate(); err != nil {
+		return err
+	}
+	attrs := &authorization.Attributes{
+		APIGroup:     "core",
+		APIVersion:   "v2",
+		Resource:     resource.RBACName(),
+		Namespace:    meta.Namespace,
+		Verb:         "update",
+		ResourceName: meta.Name,
+	}
+	ctx = context.WithValue(ctx, corev2.NamespaceKey, meta.Namespace)
+	return authorize(ctx, a.auth, attrs)
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func applyAuth(keys ...authorization.AttributesKey) authorization.Authorizer {
+	auth := &mockAuth{attrs: map[authorization.AttributesKey]bool{}}
+	for _, key := range keys {
+		auth.attrs[key] = true
+	}
+	return auth
+}
+
+func applyKey(namespace, resource, name string) authorization.AttributesKey {
+	return authorization.AttributesKey{
+		APIGroup:     "core",
+		APIVersion:   "v2",
+		Namespace:    namespace,
+		Resource:     resource,
+		ResourceName: name,
+		UserName:     "tom",
+		Verb:         "update",
+	}
+}
+
+func TestApplyClient(t *testing.T) {
+	ctx := contextWithUser(context.Background(), "tom", nil)
+	check := corev2.FixtureCheckConfig("check")
+	role := corev2.FixtureClusterRole("role")
+
+	s := &mockstore.MockStore{}
+	actions := []store.ApplyAction{store.ApplyCreated, store.ApplyUnchanged}
+	s.On("ApplyResources", mock.Anything, []corev2.Resource{check, role}, true).Return(actions, nil)
+	client := NewApplyClient(s, applyAuth(
+		applyKey("default", "checks", "check"),
+		applyKey("", "clusterroles", "role"),
+	))
+
+	got, err := client.Apply(ctx, []corev2.Resource{check, role}, true)
+	require.NoError(t, err)
+	assert.Equal(t, actions, got)
+	assert.Equal(t, "tom", check.CreatedBy)
+}
+
+func TestApplyClientResourceErrors(t *testing.T) {
+	ctx := contextWithUser(context.Background(), "tom", nil)
+	invalid := corev2.FixtureCheckConfig("invalid")
+	invalid.Interval = 0
+	unnamespaced := corev2.FixtureCheckConfig("unnamespaced")
+	unnamespaced.Namespace = ""
+	namespaced := corev2.FixtureClusterRole("namespaced")
+	namespaced.Namespace = "default"
+	unauthorized := corev2.FixtureHandler("unauthorized")
+
+	s := &mockstore.MockStore{}
+	auth := &mockAuth{attrs: map[authorization.AttributesKey]bool{
+		applyKey("default", "checks", "check"):          true,
+		applyKey("default", "handlers", "unauthorized"): false,
+	}}
+	client := NewApplyClient(s, auth)
+
+	_, err := client.Apply(ctx, []corev2.Resource{
+		corev2.FixtureCheckConfig("check"),
+		invalid,
+		unnamespaced,
+		namespaced,
+		unauthorized,
+		corev2.FixtureEntity("entity"),
+		corev3.V3ToV2Resource(corev3.FixtureEntityConfig("config")),
+	}, false)
+	require.IsType(t, ResourceErrors{}, err)
+	errs := err.(ResourceErrors)
+	indexes := make([]int, 0, len(errs))
+	for _, err := range errs {
+		indexes = append(indexes, err.Index)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, indexes)
+	assert.Equal(t, authorization.ErrUnauthorized, errs[3].Err)
+	s.AssertNotCalled(t, "ApplyResources", mock.Anything, mock.Anything, mock.Anything)
+}
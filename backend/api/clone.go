@@ -0,0 +1,190 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// cloneKinds are the kinds of resources that can be cloned, by RBAC name: the
+// namespaced kinds that can be applied in bulk.
+var cloneKinds = map[string]corev2.Resource{}
+
+func init() {
+	for _, resource := range []corev2.Resource{
+		&corev2.Asset{},
+		&corev2.CheckConfig{},
+		&corev2.CheckOverride{},
+		&corev2.EventFilter{},
+		&corev2.Handler{},
+		&corev2.HookConfig{},
+		&corev2.Mutator{},
+		&corev2.Pipeline{},
+		&corev2.Role{},
+		&corev2.RoleBinding{},
+	} {
+		cloneKinds[resource.RBACName()] = resource
+	}
+}
+
+// CloneKinds returns the kinds of resources that can be cloned, by RBAC name.
+func CloneKinds() []string {
+	kinds := make([]string, 0, len(cloneKinds))
+	for kind := range cloneKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// ErrNotClonable is returned when resources of a kind can't be cloned.
+type ErrNotClonable struct {
+	Kind string
+}
+
+func (e *ErrNotClonable) Error() string {
+	return fmt.Sprintf("%s can't be cloned", e.Kind)
+}
+
+// CloneClient is an API client copying resources from a namespace to another.
+type CloneClient struct {
+	store store.ResourceStore
+	auth  authorization.Authorizer
+}
+
+// NewCloneClient creates a new CloneClient, given a store and authorizer.
+func NewCloneClient(store store.ResourceStore, auth authorization.Authorizer) *CloneClient {
+	return &CloneClient{
+		store: store,
+		auth:  auth,
+	}
+}
+
+// Resources returns copies of the resources of the given kinds in the src
+// namespace, matched by the selector if any, moved to the dst namespace. All
+// the kinds that can be cloned are copied when none are given. The user must be
+// authorized to list them. Nothing is written: the copies are meant to be
+// applied, in the dst namespace, with an ApplyClient.
+func (c *CloneClient) Resources(ctx context.Context, src, dst string, kinds []string, sel *selector.Selector) ([]corev2.Resource, error) {
+	if len(kinds) == 0 {
+		kinds = CloneKinds()
+	}
+	for _, kind := range kinds {
+		if _, ok := cloneKinds[kind]; !ok {
+			return nil, &ErrNotClonable{Kind: kind}
+		}
+	}
+
+	// Make sure the source namespace exists, rather than copying nothing
+	nsCtx := context.WithValue(ctx, corev2.NamespaceKey, "")
+	if err := c.store.GetResource(nsCtx, src, &corev2.Namespace{}); err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, corev2.NamespaceKey, src)
+	var clones []corev2.Resource
+	for _, kind := range kinds {
+		resources, err := c.list(ctx, cloneKinds[kind], sel)
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range resources {
+			clone, err := rewriteNamespace(resource, src, dst)
+			if err != nil {
+				return nil, err
+			}
+			clones = append(clones, clone)
+		}
+	}
+	return clones, nil
+}
+
+// list returns the resources of a kind in the namespace of the context matched
+// by the selector, if the user is authorized to list them.
+func (c *CloneClient) list(ctx context.Context, kind corev2.Resource, sel *selector.Selector) ([]corev2.Resource, error) {
+	attrs := &authorization.Attributes{
+		APIGroup:   "core",
+		APIVersion: "v2",
+		Resource:   kind.RBACName(),
+		Namespace:  corev2.ContextNamespace(ctx),
+		Verb:       "list",
+	}
+	if err := authorize(ctx, c.auth, attrs); err != nil {
+		return nil, err
+	}
+
+	sliceOfResource := reflect.SliceOf(reflect.TypeOf(kind))
+	ptr := reflect.New(sliceOfResource)
+	ptr.Elem().Set(reflect.MakeSlice(sliceOfResource, 0, 0))
+	pred := &store.SelectionPredicate{Selector: sel}
+	if err := c.store.ListResources(ctx, kind.StorePrefix(), ptr.Interface(), pred); err != nil {
+		return nil, err
+	}
+
+	results := ptr.Elem()
+	resources := make([]corev2.Resource, 0, results.Len())
+	for i := 0; i < results.Len(); i++ {
+		resource := results.Index(i).Interface().(corev2.Resource)
+		if pred.SelectorApplied || pred.Matches(resource) {
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+// rewriteNamespace returns a copy of the resource in the dst namespace. The
+// namespace fields set to src, including the ones of nested references, are
+// set to dst, except in labels and annotations.
+func rewriteNamespace(resource corev2.Resource, src, dst string) (corev2.Resource, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	b, err = json.Marshal(replaceNamespace(value, src, dst))
+	if err != nil {
+		return nil, err
+	}
+	clone := reflect.New(reflect.TypeOf(resource).Elem()).Interface().(corev2.Resource)
+	if err := json.Unmarshal(b, clone); err != nil {
+		return nil, err
+	}
+	meta := clone.GetObjectMeta()
+	meta.Namespace = dst
+	meta.CreatedBy = ""
+	clone.SetObjectMeta(meta)
+	return clone, nil
+}
+
+func replaceNamespace(value interface{}, src, dst string) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for k, v := range value {
+			switch k {
+			case "labels", "annotations":
+				continue
+			case "namespace":
+				if v == src {
+					value[k] = dst
+				}
+			default:
+				value[k] = replaceNamespace(v, src, dst)
+			}
+		}
+	case []interface{}:
+		for i := range value {
+			value[i] = replaceNamespace(value[i], src, dst)
+		}
+	}
+	return value
+}
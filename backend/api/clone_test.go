@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func cloneKey(namespace, resource string) authorization.AttributesKey {
+	return authorization.AttributesKey{
+		APIGroup:   "core",
+		APIVersion: "v2",
+		Namespace:  namespace,
+		Resource:   resource,
+		UserName:   "tom",
+		Verb:       "list",
+	}
+}
+
+func cloneStore() *mockstore.MockStore {
+	s := &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "template", mock.Anything).Return(nil)
+	s.On("GetResource", mock.Anything, "missing", mock.Anything).Return(&store.ErrNotFound{Key: "missing"})
+	s.On("ListResources", mock.Anything, (&corev2.CheckConfig{}).StorePrefix(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		prod := corev2.FixtureCheckConfig("prod")
+		prod.Namespace = "template"
+		prod.Labels = map[string]string{"env": "prod", "namespace": "template"}
+		dev := corev2.FixtureCheckConfig("dev")
+		dev.Namespace = "template"
+		dev.Labels = map[string]string{"env": "dev"}
+		*args.Get(2).(*[]*corev2.CheckConfig) = []*corev2.CheckConfig{prod, dev}
+	}).Return(nil)
+	s.On("ListResources", mock.Anything, (&corev2.RoleBinding{}).StorePrefix(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		binding := corev2.FixtureRoleBinding("binding", "template")
+		binding.CreatedBy = "jane"
+		*args.Get(2).(*[]*corev2.RoleBinding) = []*corev2.RoleBinding{binding}
+	}).Return(nil)
+	return s
+}
+
+func TestCloneClientResources(t *testing.T) {
+	ctx := contextWithUser(context.Background(), "tom", nil)
+	sel, err := selector.New("env == prod", "")
+	require.NoError(t, err)
+
+	client := NewCloneClient(cloneStore(), applyAuth(
+		cloneKey("template", "checks"),
+		cloneKey("template", "rolebindings"),
+	))
+	got, err := client.Resources(ctx, "template", "team", []string{"checks", "rolebindings"}, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	for _, resource := range got {
+		assert.Equal(t, "team", resource.GetObjectMeta().Namespace)
+		assert.Empty(t, resource.GetObjectMeta().CreatedBy)
+	}
+	assert.Equal(t, "template", got[0].GetObjectMeta().Labels["namespace"])
+
+	got, err = client.Resources(ctx, "template", "team", []string{"checks"}, sel)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "prod", got[0].GetObjectMeta().Name)
+}
+
+func TestCloneClientResourcesErrors(t *testing.T) {
+	ctx := contextWithUser(context.Background(), "tom", nil)
+	client := NewCloneClient(cloneStore(), &mockAuth{attrs: map[authorization.AttributesKey]bool{
+		cloneKey("template", "checks"): false,
+	}})
+
+	_, err := client.Resources(ctx, "template", "team", []string{"entities"}, nil)
+	assert.IsType(t, &ErrNotClonable{}, err)
+
+	_, err = client.Resources(ctx, "missing", "team", []string{"checks"}, nil)
+	assert.IsType(t, &store.ErrNotFound{}, err)
+
+	_, err = client.Resources(ctx, "template", "team", []string{"checks"}, nil)
+	assert.Equal(t, authorization.ErrUnauthorized, err)
+}
+
+func TestRewriteNamespace(t *testing.T) {
+	event := corev2.FixtureEvent("entity", "check")
+	event.Entity.Namespace = "template"
+	event.Check.Namespace = "template"
+	event.Check.Annotations = map[string]string{"namespace": "template"}
+
+	clone, err := rewriteNamespace(event, "template", "team")
+	require.NoError(t, err)
+	got := clone.(*corev2.Event)
+	assert.Equal(t, "team", got.Entity.Namespace)
+	assert.Equal(t, "team", got.Check.Namespace)
+	assert.Equal(t, "template", got.Check.Annotations["namespace"])
+	assert.Equal(t, "template", event.Check.Namespace)
+}
@@ -71,7 +71,7 @@ func (c EntityController) Create(ctx context.Context, entity corev2.Entity) erro
 
 	// Persist the resource in the store
 	if err := c.store.UpdateEntity(ctx, &entity); err != nil {
-		return NewError(InternalErr, err)
+		return newWriteError(err)
 	}
 	return nil
 }
@@ -95,7 +95,7 @@ func (c EntityController) CreateOrReplace(ctx context.Context, entity corev2.Ent
 	// See sensu-go#3896.
 	if entity.EntityClass == corev2.EntityProxyClass {
 		if serr := c.store.UpdateEntity(ctx, &entity); serr != nil {
-			return NewError(InternalErr, serr)
+			return newWriteError(serr)
 		}
 	} else {
 		// Determine if the entity already exists
@@ -107,7 +107,7 @@ func (c EntityController) CreateOrReplace(ctx context.Context, entity corev2.Ent
 		// If the entity does not exist, we should just create it with the v1 store
 		if e == nil {
 			if err := c.store.UpdateEntity(ctx, &entity); err != nil {
-				return NewError(InternalErr, err)
+				return newWriteError(err)
 			}
 			return nil
 		}
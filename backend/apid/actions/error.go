@@ -1,6 +1,10 @@
 package actions
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/sensu/sensu-go/backend/store"
+)
 
 //
 // Following defines error type w/ error codes. Helpful for
@@ -52,6 +56,14 @@ const (
 	// the operation has completed successfully. For example, a successful
 	// response from a server could have been delayed long
 	DeadlineExceeded
+
+	// ResourceExhausted is used when a limit has been reached, e.g. when a
+	// client sent too many requests in a given amount of time.
+	ResourceExhausted
+
+	// QuotaExceeded is used when writing a resource would exceed a quota of
+	// its namespace.
+	QuotaExceeded
 )
 
 // Default error messages if not message is provided.
@@ -65,6 +77,8 @@ var standardErrorMessages = map[ErrCode]string{
 	PaymentRequired:    "license required",
 	PreconditionFailed: "precondition failed",
 	DeadlineExceeded:   "deadline exceeded",
+	ResourceExhausted:  "resource exhausted",
+	QuotaExceeded:      "quota exceeded",
 }
 
 // Error describes an issue that ocurred while performing the action.
@@ -107,3 +121,12 @@ func StatusFromError(err error) (ErrCode, bool) {
 
 	return erro.Code, true
 }
+
+// newWriteError returns the error of an action for an error of the store
+// writing a resource.
+func newWriteError(err error) Error {
+	if _, ok := err.(*store.ErrQuotaExceeded); ok {
+		return NewError(QuotaExceeded, err)
+	}
+	return NewError(InternalErr, err)
+}
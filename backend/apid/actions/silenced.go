@@ -63,7 +63,7 @@ func (c SilencedController) Create(ctx context.Context, entry *corev2.Silenced)
 
 	// Persist
 	if err := c.Store.UpdateSilencedEntry(ctx, entry); err != nil {
-		return NewError(InternalErr, err)
+		return newWriteError(err)
 	}
 
 	return nil
@@ -85,7 +85,7 @@ func (c SilencedController) CreateOrReplace(ctx context.Context, entry *corev2.S
 
 	// Persist
 	if err := c.Store.UpdateSilencedEntry(ctx, entry); err != nil {
-		return NewError(InternalErr, err)
+		return newWriteError(err)
 	}
 
 	return nil
@@ -71,6 +71,8 @@ type Config struct {
 	ClusterVersion      string
 	GraphQLService      *graphql.Service
 	HealthRouter        *routers.HealthRouter
+	RateLimiter         *middlewares.RateLimiter
+	HandlerFailures     routers.HandlerFailureStore
 }
 
 // New creates a new APId.
@@ -165,23 +167,30 @@ func CoreSubrouter(router *mux.Router, cfg Config) *mux.Router {
 		middlewares.SimpleLogger{},
 		middlewares.AuthorizationAttributes{},
 		middlewares.Authorization{Authorizer: &rbac.Authorizer{Store: cfg.Store}},
+		middlewares.RateLimit{Limiter: cfg.RateLimiter},
 		middlewares.LimitRequest{Limit: cfg.RequestLimit},
 		middlewares.Pagination{},
+		middlewares.Selector{},
 	)
 	mountRouters(
 		subrouter,
 		routers.NewAssetRouter(cfg.Store),
 		routers.NewAPIKeysRouter(cfg.Store),
+		routers.NewApplyRouter(cfg.Store, &rbac.Authorizer{Store: cfg.Store}),
 		routers.NewChecksRouter(cfg.Store, cfg.QueueGetter),
+		routers.NewCheckOverridesRouter(cfg.Store),
+		routers.NewCloneRouter(cfg.Store, &rbac.Authorizer{Store: cfg.Store}),
 		routers.NewClusterRolesRouter(cfg.Store),
 		routers.NewClusterRoleBindingsRouter(cfg.Store),
 		routers.NewClusterRouter(actions.NewClusterController(cfg.Cluster, cfg.Store)),
 		routers.NewEventFiltersRouter(cfg.Store),
 		routers.NewHandlersRouter(cfg.Store),
+		routers.NewHandlerFailuresRouter(cfg.HandlerFailures),
 		routers.NewHooksRouter(cfg.Store),
 		routers.NewMutatorsRouter(cfg.Store),
 		routers.NewNamespacesRouter(cfg.Store, cfg.Store, &rbac.Authorizer{Store: cfg.Store}, cfg.Storev2),
 		routers.NewPipelinesRouter(cfg.Store),
+		routers.NewQuotasRouter(cfg.Store, cfg.Store),
 		routers.NewRolesRouter(cfg.Store),
 		routers.NewRoleBindingsRouter(cfg.Store),
 		routers.NewSilencedRouter(cfg.Store),
@@ -202,13 +211,15 @@ func EntityLimitedCoreSubrouter(router *mux.Router, cfg Config) *mux.Router {
 		middlewares.SimpleLogger{},
 		middlewares.AuthorizationAttributes{},
 		middlewares.Authorization{Authorizer: &rbac.Authorizer{Store: cfg.Store}},
+		middlewares.RateLimit{Limiter: cfg.RateLimiter},
 		middlewares.LimitRequest{Limit: cfg.RequestLimit},
 		middlewares.Pagination{},
+		middlewares.Selector{},
 	)
 	mountRouters(
 		subrouter,
 		routers.NewEntitiesRouter(cfg.Store, cfg.Storev2, cfg.EventStore),
-		routers.NewEventsRouter(cfg.EventStore, cfg.Bus),
+		routers.NewEventsRouter(cfg.EventStore, cfg.Store, cfg.Bus),
 	)
 
 	return subrouter
@@ -229,6 +240,9 @@ func GraphQLSubrouter(router *mux.Router, cfg Config) *mux.Router {
 		// https://graphql.org/learn/introspection/
 		middlewares.Authentication{IgnoreUnauthorized: true, Store: cfg.Store},
 		middlewares.SimpleLogger{},
+		// GraphQL queries can list many resources at once, they are limited
+		// as list requests
+		middlewares.RateLimit{Limiter: cfg.RateLimiter, Class: middlewares.RateLimitClassList},
 	)
 
 	mountRouters(
@@ -252,6 +266,7 @@ func PublicSubrouter(router *mux.Router, cfg Config) *mux.Router {
 		cfg.HealthRouter,
 		routers.NewVersionRouter(actions.NewVersionController(cfg.ClusterVersion)),
 		routers.NewTessenMetricRouter(actions.NewTessenMetricController(cfg.Bus)),
+		routers.NewSchemasRouter(router, subrouter),
 	)
 
 	subrouter.Handle("/metrics", promhttp.Handler())
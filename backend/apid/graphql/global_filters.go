@@ -1,6 +1,10 @@
 package graphql
 
-import "github.com/sensu/sensu-go/backend/apid/graphql/filter"
+import (
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/graphql/filter"
+	"github.com/sensu/sensu-go/backend/selector"
+)
 
 // GlobalFilters are filters that are applied to all resolvers that accept
 // filter statements.
@@ -8,5 +12,26 @@ var GlobalFilters = DefaultGlobalFilters()
 
 // DefaultGlobalFilters returns the default set of global filters.
 func DefaultGlobalFilters() map[string]filter.Filter {
-	return map[string]filter.Filter{}
+	return map[string]filter.Filter{
+		// labelSelector:region == us-west-1
+		"labelSelector": func(statement string, _ filter.FieldsFunc) (filter.Matcher, error) {
+			sel, err := selector.New(statement, "")
+			if err != nil {
+				return nil, err
+			}
+			return func(res corev2.Resource) bool {
+				return sel.Matches(res.GetObjectMeta().Labels, nil)
+			}, nil
+		},
+		// fieldSelector:check.name in (check-cpu, check-mem)
+		"fieldSelector": func(statement string, fieldsFn filter.FieldsFunc) (filter.Matcher, error) {
+			sel, err := selector.New("", statement)
+			if err != nil {
+				return nil, err
+			}
+			return func(res corev2.Resource) bool {
+				return sel.Matches(nil, fieldsFn(res))
+			}, nil
+		},
+	}
 }
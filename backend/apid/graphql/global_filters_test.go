@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"testing"
+
+	v2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/graphql/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalFilters(t *testing.T) {
+	fs := DefaultGlobalFilters()
+
+	check := v2.FixtureCheckConfig("check-cpu")
+	check.Labels = map[string]string{"region": "us-west-1"}
+
+	testCases := []struct {
+		statement string
+		expect    bool
+	}{
+		{statement: "labelSelector:region == us-west-1", expect: true},
+		{statement: "labelSelector:region notin (us-west-1, eu-west-1)", expect: false},
+		{statement: "fieldSelector:check.name == check-cpu", expect: true},
+		{statement: "fieldSelector:check.name in [check-mem]", expect: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.statement, func(t *testing.T) {
+			matches, err := filter.Compile([]string{tc.statement}, fs, v2.CheckConfigFields)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, matches(check))
+		})
+	}
+
+	_, err := filter.Compile([]string{"labelSelector:region =="}, fs, v2.CheckConfigFields)
+	assert.Error(t, err)
+}
@@ -41,6 +41,8 @@ func (h Handlers) CreateResource(r *http.Request) (interface{}, error) {
 			return nil, actions.NewErrorf(actions.AlreadyExistsErr)
 		case *store.ErrNotValid:
 			return nil, actions.NewError(actions.InvalidArgument, err)
+		case *store.ErrQuotaExceeded:
+			return nil, actions.NewError(actions.QuotaExceeded, err)
 		default:
 			return nil, actions.NewError(actions.InternalErr, err)
 		}
@@ -111,6 +111,8 @@ func (h Handlers) patchV2Resource(ctx context.Context, body []byte, name string,
 			return nil, actions.NewError(actions.InvalidArgument, err)
 		case *store.ErrPreconditionFailed:
 			return nil, actions.NewError(actions.PreconditionFailed, err)
+		case *store.ErrQuotaExceeded:
+			return nil, actions.NewError(actions.QuotaExceeded, err)
 		default:
 			return nil, actions.NewError(actions.InternalErr, err)
 		}
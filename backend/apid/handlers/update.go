@@ -39,6 +39,8 @@ func (h Handlers) CreateOrUpdateResource(r *http.Request) (interface{}, error) {
 		switch err := err.(type) {
 		case *store.ErrNotValid:
 			return nil, actions.NewError(actions.InvalidArgument, err)
+		case *store.ErrQuotaExceeded:
+			return nil, actions.NewError(actions.QuotaExceeded, err)
 		default:
 			return nil, actions.NewError(actions.InternalErr, err)
 		}
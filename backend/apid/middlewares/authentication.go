@@ -3,15 +3,23 @@ package middlewares
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/patch"
 )
 
+// apiKeyLastUsedInterval is the minimum amount of time between two updates of
+// the last used timestamp of an API key, in order to avoid writing to the
+// store on every request.
+const apiKeyLastUsedInterval = time.Minute
+
 // Authentication is a HTTP middleware that enforces authentication
 type Authentication struct {
 	// IgnoreUnauthorized configures the middleware to continue the handler chain
@@ -47,7 +55,7 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 			// if the auth header contains Key, continue with api key auth
 			if strings.HasPrefix(headerString, "Key ") {
 				headerString = strings.TrimPrefix(headerString, "Key ")
-				claims, err := extractAPIKeyClaims(ctx, headerString, a.Store)
+				apiKey, claims, err := extractAPIKeyClaims(ctx, headerString, remoteIP(r), a.Store)
 				if err != nil {
 					logger.WithError(err).Warn("invalid api key")
 					actionErr := actions.NewErrorf(actions.Unauthenticated, "invalid credentials")
@@ -55,8 +63,11 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 					return
 				}
 				if claims != nil {
-					// Set the claims into the request context
+					// Set the claims and the api key into the request context,
+					// so the scope of the key can be enforced during
+					// authorization
 					ctx = jwt.SetClaimsIntoContext(r, claims)
+					ctx = context.WithValue(ctx, corev2.APIKeyKey, apiKey)
 					next.ServeHTTP(w, r.WithContext(ctx))
 					return
 				}
@@ -74,7 +85,7 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 	})
 }
 
-func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*corev2.Claims, error) {
+func extractAPIKeyClaims(ctx context.Context, key string, ip net.IP, store store.Store) (*corev2.APIKey, *corev2.Claims, error) {
 	var claims *corev2.Claims
 	// retrieve the APIKey based on the key provided
 	apiKey := &corev2.APIKey{
@@ -83,19 +94,38 @@ func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*c
 		},
 	}
 	if err := store.GetResource(context.Background(), apiKey.Name, apiKey); err != nil {
-		return claims, err
+		return nil, claims, err
+	}
+
+	now := time.Now()
+	if apiKey.IsExpired(now) {
+		return nil, claims, fmt.Errorf("api key %s expired", apiKey.Name)
+	}
+	if !apiKey.AddressAllowed(ip) {
+		return nil, claims, fmt.Errorf("api key %s not allowed from address %s", apiKey.Name, ip)
 	}
 
 	// retrieve the sensu user associated with the key provided
 	user, err := store.GetUser(ctx, apiKey.Username)
 	if err != nil {
-		return claims, err
+		return nil, claims, err
 	}
 	// this shouldn't happen because user validation happens at key generation,
 	// but in the event a user is deleted after a key has been generated,
 	// the key should not pass authentication
 	if user == nil {
-		return claims, fmt.Errorf("user %s not found", apiKey.Username)
+		return nil, claims, fmt.Errorf("user %s not found", apiKey.Username)
+	}
+
+	if now.Sub(time.Unix(apiKey.LastUsedAt, 0)) >= apiKeyLastUsedInterval {
+		apiKey.LastUsedAt = now.Unix()
+		// patch the stored key rather than overwriting it, so that a key
+		// deleted in the meantime is not created again
+		lastUsed := &patch.Merge{MergePatch: []byte(fmt.Sprintf(`{"last_used_at":%d}`, apiKey.LastUsedAt))}
+		if err := store.PatchResource(context.Background(), apiKey, apiKey.Name, lastUsed, nil); err != nil {
+			// failing to record the last usage must not prevent authentication
+			logger.WithError(err).Warn("could not update the api key last used timestamp")
+		}
 	}
 
 	// inject the username and groups into standard jwt claims
@@ -105,7 +135,16 @@ func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*c
 		APIKey:         true,
 	}
 
-	return claims, nil
+	return apiKey, claims, nil
+}
+
+// remoteIP returns the IP address of the client that sent the request
+func remoteIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
 }
 
 type errorWriter struct {
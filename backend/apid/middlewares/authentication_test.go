@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
@@ -86,6 +87,7 @@ func TestMiddlewareValidAPIKey(t *testing.T) {
 	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
 	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	store.On("GetUser", mock.Anything, mock.Anything).Return(&corev2.User{}, nil)
+	store.On("PatchResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	client := &http.Client{}
 	req, _ := http.NewRequest("GET", server.URL, nil)
@@ -154,3 +156,81 @@ func TestMiddlewareNoUserAPIKey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
 }
+
+func TestMiddlewareExpiredAPIKey(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		apiKey := args.Get(2).(*corev2.APIKey)
+		apiKey.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+	})
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Key %s", key.Name))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestMiddlewareAPIKeyForbiddenAddress(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		apiKey := args.Get(2).(*corev2.APIKey)
+		apiKey.AllowedCIDRs = []string{"10.0.0.0/8"}
+	})
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Key %s", key.Name))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestMiddlewareAPIKeyLastUsed(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	var apiKey *corev2.APIKey
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey = corev2.ContextAPIKey(r.Context())
+	})
+	server := httptest.NewServer(mware.Then(handler))
+	defer server.Close()
+
+	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		apiKey := args.Get(2).(*corev2.APIKey)
+		apiKey.Username = "admin"
+		apiKey.AllowedCIDRs = []string{"127.0.0.0/8", "::1/128"}
+	})
+	store.On("GetUser", mock.Anything, mock.Anything).Return(&corev2.User{Username: "admin"}, nil)
+	store.On("PatchResource", mock.Anything, mock.AnythingOfType("*v2.APIKey"), key.Name, mock.Anything, mock.Anything).Return(nil)
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Key %s", key.Name))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	store.AssertCalled(t, "PatchResource", mock.Anything, mock.Anything, key.Name, mock.Anything, mock.Anything)
+	store.AssertNotCalled(t, "CreateOrUpdateResource", mock.Anything, mock.Anything)
+	if assert.NotNil(t, apiKey) {
+		assert.NotZero(t, apiKey.LastUsedAt)
+	}
+}
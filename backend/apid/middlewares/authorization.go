@@ -21,6 +21,18 @@ func namespaceGetAttrs(attrs *authorization.Attributes) bool {
 		(attrs.Verb == "get" || attrs.Verb == "list"))
 }
 
+func applyAttrs(attrs *authorization.Attributes) bool {
+	return (attrs.APIGroup == "core" &&
+		attrs.APIVersion == "v2" &&
+		attrs.Resource == "apply")
+}
+
+func cloneAttrs(attrs *authorization.Attributes) bool {
+	return (attrs.APIGroup == "core" &&
+		attrs.APIVersion == "v2" &&
+		attrs.Resource == "clone")
+}
+
 // Then middleware
 func (a Authorization) Then(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -42,6 +54,20 @@ func (a Authorization) Then(next http.Handler) http.Handler {
 			return
 		}
 
+		if applyAttrs(attrs) {
+			// Special case for applying resources in bulk - the router authorizes
+			// each of the resources
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		if cloneAttrs(attrs) {
+			// Special case for cloning namespaces - the router authorizes the
+			// listing of the source resources and the update of their copies
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
 		authorized, err := a.Authorizer.Authorize(ctx, attrs)
 		if err != nil {
 			if _, ok := err.(rbac.ErrRoleNotFound); ok {
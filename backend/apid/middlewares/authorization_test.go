@@ -573,6 +573,28 @@ func TestAuthorization(t *testing.T) {
 			attributesMiddleware: AuthorizationAttributes{},
 			expectedCode:         200,
 		},
+		//
+		// The bulk apply endpoint authorizes each of the applied resources
+		//
+		{
+			description:          "anyone can reach the bulk apply endpoint",
+			method:               "PUT",
+			url:                  "/api/core/v2/apply",
+			group:                "system:agents",
+			attributesMiddleware: AuthorizationAttributes{},
+			expectedCode:         200,
+		},
+		//
+		// The namespace clone endpoint authorizes each of the cloned resources
+		//
+		{
+			description:          "anyone can reach the namespace clone endpoint",
+			method:               "POST",
+			url:                  "/api/core/v2/namespaces/default/clone",
+			group:                "system:agents",
+			attributesMiddleware: AuthorizationAttributes{},
+			expectedCode:         200,
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.description, func(t *testing.T) {
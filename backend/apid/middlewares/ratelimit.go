@@ -0,0 +1,231 @@
+package middlewares
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// RateLimitClassList is the class of requests listing resources.
+	RateLimitClassList = "list"
+
+	// RateLimitClassWrite is the class of requests creating, updating or
+	// deleting resources.
+	RateLimitClassWrite = "write"
+
+	// ThrottledRequestsCounterVec is the name of the prometheus counter vec
+	// used to count the requests rejected by the rate limiter.
+	ThrottledRequestsCounterVec = "sensu_go_api_requests_throttled"
+
+	// limiterIdleTimeout is the amount of time after which the limiter of a
+	// subject that did not send any request is discarded.
+	limiterIdleTimeout = 10 * time.Minute
+)
+
+var throttledRequestsCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: ThrottledRequestsCounterVec,
+		Help: "The total number of API requests rejected by the rate limiter",
+	},
+	[]string{"class"},
+)
+
+func init() {
+	throttledRequestsCounter.WithLabelValues(RateLimitClassList)
+	throttledRequestsCounter.WithLabelValues(RateLimitClassWrite)
+	_ = prometheus.Register(throttledRequestsCounter)
+}
+
+// RateLimitConfig configures the token buckets of a RateLimiter, per class of
+// requests. A zero rate disables the rate limiting of the class.
+type RateLimitConfig struct {
+	// ListRate is the number of list requests per second allowed per subject.
+	ListRate rate.Limit
+	// ListBurst is the maximum number of list requests a subject can burst.
+	ListBurst int
+	// WriteRate is the number of write requests per second allowed per
+	// subject.
+	WriteRate rate.Limit
+	// WriteBurst is the maximum number of write requests a subject can burst.
+	WriteBurst int
+}
+
+type limiterKey struct {
+	subject string
+	class   string
+}
+
+type subjectLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter keeps a token bucket per authenticated subject and class of
+// requests.
+type RateLimiter struct {
+	config    RateLimitConfig
+	mu        sync.Mutex
+	limiters  map[limiterKey]*subjectLimiter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewRateLimiter creates a new RateLimiter with the given configuration.
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		config:   config,
+		limiters: make(map[limiterKey]*subjectLimiter),
+		now:      time.Now,
+	}
+}
+
+// Enabled returns true if any class of requests is rate limited.
+func (l *RateLimiter) Enabled() bool {
+	return l != nil && (l.config.ListRate > 0 || l.config.WriteRate > 0)
+}
+
+func (l *RateLimiter) classLimit(class string) (rate.Limit, int) {
+	switch class {
+	case RateLimitClassList:
+		return l.config.ListRate, l.config.ListBurst
+	case RateLimitClassWrite:
+		return l.config.WriteRate, l.config.WriteBurst
+	}
+	return 0, 0
+}
+
+// Reserve takes a token from the bucket of the given subject and class. It
+// returns zero if the request is allowed, or the amount of time the subject
+// must wait before retrying otherwise.
+func (l *RateLimiter) Reserve(subject, class string) time.Duration {
+	limit, burst := l.classLimit(class)
+	if limit <= 0 {
+		return 0
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	now := l.now()
+	key := limiterKey{subject: subject, class: class}
+
+	l.mu.Lock()
+	l.sweep(now)
+	entry, ok := l.limiters[key]
+	if !ok {
+		entry = &subjectLimiter{limiter: rate.NewLimiter(limit, burst)}
+		l.limiters[key] = entry
+	}
+	entry.lastSeen = now
+	l.mu.Unlock()
+
+	reservation := entry.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		// Can only happen if the burst is lower than one, which is prevented
+		// above
+		return time.Second
+	}
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// The request is rejected, give the token back
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+// sweep discards the limiters of idle subjects. It must be called with the
+// lock held.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < limiterIdleTimeout {
+		return
+	}
+	for key, entry := range l.limiters {
+		if now.Sub(entry.lastSeen) >= limiterIdleTimeout {
+			delete(l.limiters, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// RateLimit is an HTTP middleware that limits the rate of list and write
+// requests per authenticated subject. It must be executed after the
+// Authentication and AuthorizationAttributes middlewares.
+type RateLimit struct {
+	Limiter *RateLimiter
+
+	// Class is the class of the requests that have no authorization
+	// attributes, such as GraphQL queries, which are not rate limited if
+	// empty.
+	Class string
+}
+
+// Then middleware
+func (m RateLimit) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.Limiter.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		class := m.Class
+		if attrs := authorization.GetAttributes(ctx); attrs != nil {
+			class = requestClass(attrs)
+		}
+		subject := requestSubject(r)
+		if class == "" || subject == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if delay := m.Limiter.Reserve(subject, class); delay > 0 {
+			throttledRequestsCounter.WithLabelValues(class).Inc()
+			seconds := int64(math.Ceil(delay.Seconds()))
+			w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+			writeErr(w, actions.NewErrorf(actions.ResourceExhausted, "too many requests"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestClass returns the rate limiting class of a request based on its
+// authorization attributes, or an empty string if the request is not rate
+// limited.
+func requestClass(attrs *authorization.Attributes) string {
+	if attrs == nil {
+		return ""
+	}
+	switch attrs.Verb {
+	case "list":
+		return RateLimitClassList
+	case "create", "update", "delete":
+		return RateLimitClassWrite
+	}
+	return ""
+}
+
+// requestSubject returns the identifier of the authenticated subject of a
+// request. Requests authenticated with an API key are limited per key, other
+// requests are limited per user.
+func requestSubject(r *http.Request) string {
+	ctx := r.Context()
+	if apiKey := corev2.ContextAPIKey(ctx); apiKey != nil {
+		return "apikey:" + apiKey.Name
+	}
+	if claims := jwt.GetClaimsFromContext(ctx); claims != nil {
+		return "user:" + claims.Subject
+	}
+	return ""
+}
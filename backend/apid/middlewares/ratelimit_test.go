@@ -0,0 +1,116 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Now()
+	limiter := NewRateLimiter(RateLimitConfig{
+		ListRate:   1,
+		ListBurst:  2,
+		WriteRate:  0,
+		WriteBurst: 0,
+	})
+	limiter.now = func() time.Time { return now }
+
+	assert.Zero(t, limiter.Reserve("user:foo", RateLimitClassList))
+	assert.Zero(t, limiter.Reserve("user:foo", RateLimitClassList))
+	assert.NotZero(t, limiter.Reserve("user:foo", RateLimitClassList))
+
+	// Another subject has its own bucket
+	assert.Zero(t, limiter.Reserve("user:bar", RateLimitClassList))
+
+	// Writes are not limited
+	for i := 0; i < 10; i++ {
+		assert.Zero(t, limiter.Reserve("user:foo", RateLimitClassWrite))
+	}
+
+	// The bucket refills over time
+	now = now.Add(time.Second)
+	assert.Zero(t, limiter.Reserve("user:foo", RateLimitClassList))
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	now := time.Now()
+	limiter := NewRateLimiter(RateLimitConfig{ListRate: 1, ListBurst: 1})
+	limiter.now = func() time.Time { return now }
+
+	limiter.Reserve("user:foo", RateLimitClassList)
+	assert.Len(t, limiter.limiters, 1)
+
+	now = now.Add(limiterIdleTimeout)
+	limiter.Reserve("user:bar", RateLimitClassList)
+	assert.Len(t, limiter.limiters, 1)
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{ListRate: 0.001, ListBurst: 1})
+	mware := RateLimit{Limiter: limiter}
+	handler := mware.Then(testHandler())
+
+	newRequest := func(verb string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		ctx := authorization.SetAttributes(req.Context(), &authorization.Attributes{Verb: verb})
+		apiKey := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+		ctx = context.WithValue(ctx, corev2.APIKeyKey, apiKey)
+		return req.WithContext(ctx)
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest("list"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest("list"))
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+
+	// Requests getting a single resource are not limited
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest("get"))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRateLimitMiddlewareDisabled(t *testing.T) {
+	mware := RateLimit{}
+	handler := mware.Then(testHandler())
+
+	for i := 0; i < 10; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		ctx := authorization.SetAttributes(req.Context(), &authorization.Attributes{Verb: "list"})
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req.WithContext(ctx))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestRateLimitMiddlewareClass(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{ListRate: 0.001, ListBurst: 1})
+	mware := RateLimit{Limiter: limiter, Class: RateLimitClassList}
+	handler := mware.Then(testHandler())
+
+	// Requests without authorization attributes, such as GraphQL queries, are
+	// limited as the class of the middleware
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		apiKey := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+		return req.WithContext(context.WithValue(req.Context(), corev2.APIKeyKey, apiKey))
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest())
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest())
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+}
@@ -0,0 +1,26 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/selector"
+)
+
+// Selector retrieves the "labelSelector" and "fieldSelector" query parameters
+// and adds the selector they describe to the request's context.
+type Selector struct{}
+
+func (s Selector) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sel, err := selector.New(r.FormValue("labelSelector"), r.FormValue("fieldSelector"))
+		if err != nil {
+			writeErr(w, actions.NewError(actions.InvalidArgument, err))
+			return
+		}
+		if sel != nil {
+			r = r.WithContext(selector.ContextWithSelector(r.Context(), sel))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,74 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sensu/sensu-go/backend/selector"
+)
+
+func TestSelectorMiddleware(t *testing.T) {
+	cases := []struct {
+		description    string
+		queryParams    string
+		expectedStatus int
+		expectedLabels int
+		expectedFields int
+	}{
+		{
+			description:    "No query parameters",
+			queryParams:    "",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			description:    "Label selector",
+			queryParams:    "?labelSelector=region%3D%3Dus-west-1",
+			expectedStatus: http.StatusOK,
+			expectedLabels: 1,
+		},
+		{
+			description:    "Label and field selectors",
+			queryParams:    "?labelSelector=region%3D%3Dus-west-1&fieldSelector=check.name%20in%20(a,b),check.publish%3D%3Dtrue",
+			expectedStatus: http.StatusOK,
+			expectedLabels: 1,
+			expectedFields: 2,
+		},
+		{
+			description:    "Invalid field selector",
+			queryParams:    "?fieldSelector=check.name%20in",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sel := selector.SelectorFromContext(r.Context())
+				if tt.expectedLabels == 0 && tt.expectedFields == 0 {
+					assert.Nil(t, sel)
+					return
+				}
+				if assert.NotNil(t, sel) {
+					assert.Len(t, sel.Labels, tt.expectedLabels)
+					assert.Len(t, sel.Fields, tt.expectedFields)
+				}
+			})
+
+			middleware := Selector{}
+
+			w := httptest.NewRecorder()
+			r, err := http.NewRequest("GET", "/"+tt.queryParams, nil)
+			if err != nil {
+				t.Fatal("Couldn't create request: ", err)
+			}
+
+			handler := middleware.Then(testHandler)
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
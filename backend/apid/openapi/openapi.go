@@ -0,0 +1,401 @@
+// Package openapi describes the Sensu API for external tooling: a JSON Schema
+// per resource type, generated from the Go types of the protobuf definitions,
+// and an OpenAPI 3 description of the REST API, generated from its routes.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/version"
+)
+
+// Version is the version of the OpenAPI specification of the documents.
+const Version = "3.0.3"
+
+// Document is an OpenAPI document.
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Paths      map[string]*PathItem  `json:"paths"`
+	Components Components            `json:"components"`
+	Security   []map[string][]string `json:"security,omitempty"`
+}
+
+// Info describes the API.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Components holds the schemas and security schemes referenced by the
+// operations.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme describes a way of authenticating requests.
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Description  string `json:"description,omitempty"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	Name         string `json:"name,omitempty"`
+	In           string `json:"in,omitempty"`
+}
+
+// PathItem holds the operations available on a path.
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters,omitempty"`
+	Get        *Operation   `json:"get,omitempty"`
+	Put        *Operation   `json:"put,omitempty"`
+	Post       *Operation   `json:"post,omitempty"`
+	Delete     *Operation   `json:"delete,omitempty"`
+	Patch      *Operation   `json:"patch,omitempty"`
+}
+
+// Operation describes an API operation on a path.
+type Operation struct {
+	Summary     string                 `json:"summary,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+	Parameters  []*Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody           `json:"requestBody,omitempty"`
+	Responses   map[string]*Response   `json:"responses"`
+	Security    *[]map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter describes a path or query parameter.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request.
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response describes the response to an operation.
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a request or response body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// errorSchema is the name of the schema of the error responses.
+const errorSchema = "Error"
+
+// Resources returns the resource types served by the API, core/v2 ones
+// first.
+func Resources() []interface{} {
+	resources := []interface{}{
+		&corev2.APIKey{},
+		&corev2.Asset{},
+		&corev2.CheckConfig{},
+		&corev2.CheckOverride{},
+		&corev2.ClusterRole{},
+		&corev2.ClusterRoleBinding{},
+		&corev2.Entity{},
+		&corev2.Event{},
+		&corev2.EventFilter{},
+		&corev2.Handler{},
+		&corev2.HookConfig{},
+		&corev2.Mutator{},
+		&corev2.Namespace{},
+		&corev2.Pipeline{},
+		&corev2.Quota{},
+		&corev2.Role{},
+		&corev2.RoleBinding{},
+		&corev2.Silenced{},
+		&corev2.TessenConfig{},
+		&corev2.User{},
+	}
+	for _, resource := range corev3.ListResources() {
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+// FindResource returns the resource type with the given API version and type
+// among Resources.
+func FindResource(apiVersion, typ string) (interface{}, error) {
+	for _, resource := range Resources() {
+		tm := typeMeta(resource)
+		if tm.APIVersion == apiVersion && tm.Type == typ {
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown resource type: %s.%s", apiVersion, typ)
+}
+
+// route is an API route, its path template in the OpenAPI syntax.
+type route struct {
+	path       string
+	methods    []string
+	parameters []string
+	// vars holds the values of the variables matching a single literal,
+	// like {resource:checks}
+	vars map[string]string
+	// last is the last segment of the path template
+	last string
+}
+
+var literalRe = regexp.MustCompile(`^[\w.-]+$`)
+
+// parseTemplate converts a gorilla/mux path template to an OpenAPI one.
+func parseTemplate(tpl string) (*route, error) {
+	r := &route{vars: map[string]string{}}
+	var b strings.Builder
+	for i := 0; i < len(tpl); i++ {
+		if tpl[i] != '{' {
+			b.WriteByte(tpl[i])
+			continue
+		}
+		// Variable patterns may themselves contain braces
+		depth, end := 0, -1
+		for j := i; j < len(tpl) && end < 0; j++ {
+			switch tpl[j] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					end = j
+				}
+			}
+		}
+		if end < 0 {
+			return nil, fmt.Errorf("unbalanced braces in route %q", tpl)
+		}
+		parts := strings.SplitN(tpl[i+1:end], ":", 2)
+		if len(parts) == 2 && literalRe.MatchString(parts[1]) {
+			r.vars[parts[0]] = parts[1]
+			b.WriteString(parts[1])
+		} else {
+			r.parameters = append(r.parameters, parts[0])
+			b.WriteString("{" + parts[0] + "}")
+		}
+		i = end
+	}
+	r.path = b.String()
+	segments := strings.Split(strings.TrimSuffix(tpl, "/"), "/")
+	r.last = segments[len(segments)-1]
+	return r, nil
+}
+
+// walk returns the routes of a router that have methods.
+func walk(router *mux.Router) ([]*route, error) {
+	var routes []*route
+	err := router.Walk(func(mr *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		tpl, err := mr.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		methods, err := mr.GetMethods()
+		if err != nil {
+			// Subrouters and handlers serving any method are not described
+			return nil
+		}
+		r, err := parseTemplate(tpl)
+		if err != nil {
+			return err
+		}
+		r.methods = methods
+		routes = append(routes, r)
+		return nil
+	})
+	return routes, err
+}
+
+// Generate returns the OpenAPI description of the routes of router. The
+// routes of public don't require authentication.
+func Generate(router *mux.Router, public *mux.Router) (*Document, error) {
+	routes, err := walk(router)
+	if err != nil {
+		return nil, err
+	}
+	publicRoutes, err := walk(public)
+	if err != nil {
+		return nil, err
+	}
+	isPublic := map[string]bool{}
+	for _, r := range publicRoutes {
+		for _, method := range r.methods {
+			isPublic[method+" "+r.path] = true
+		}
+	}
+
+	reflector := newReflector("#/components/schemas/")
+	reflector.definitions[errorSchema] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"message": {Type: "string"},
+			"code":    {Type: "integer", Minimum: &zero},
+		},
+	}
+	byRBACName := map[string]*Schema{}
+	for _, resource := range Resources() {
+		if r, ok := resource.(interface{ RBACName() string }); ok {
+			byRBACName[r.RBACName()] = reflector.schemaOf(reflect.TypeOf(resource))
+		}
+	}
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:   "Sensu API",
+			Version: version.Semver(),
+		},
+		Paths: map[string]*PathItem{},
+		Components: Components{
+			Schemas: reflector.definitions,
+			SecuritySchemes: map[string]*SecurityScheme{
+				"bearerAuth": {
+					Type:         "http",
+					Scheme:       "bearer",
+					BearerFormat: "JWT",
+					Description:  "Access token obtained from /auth",
+				},
+				"apiKeyAuth": {
+					Type:        "apiKey",
+					In:          "header",
+					Name:        "Authorization",
+					Description: `API key, given as "Key <api key>"`,
+				},
+			},
+		},
+		Security: []map[string][]string{
+			{"bearerAuth": {}},
+			{"apiKeyAuth": {}},
+		},
+	}
+
+	for _, r := range routes {
+		item, ok := doc.Paths[r.path]
+		if !ok {
+			item = &PathItem{}
+			for _, name := range r.parameters {
+				item.Parameters = append(item.Parameters, &Parameter{
+					Name:     name,
+					In:       "path",
+					Required: true,
+					Schema:   &Schema{Type: "string"},
+				})
+			}
+			doc.Paths[r.path] = item
+		}
+		for _, method := range r.methods {
+			op := operation(r, method, byRBACName[r.vars["resource"]])
+			if isPublic[method+" "+r.path] {
+				op.Security = &[]map[string][]string{}
+			}
+			item.set(method, op)
+		}
+	}
+	return doc, nil
+}
+
+// set adds the operation of a method, unless there's already one.
+func (p *PathItem) set(method string, op *Operation) {
+	var slot **Operation
+	switch method {
+	case http.MethodGet:
+		slot = &p.Get
+	case http.MethodPut:
+		slot = &p.Put
+	case http.MethodPost:
+		slot = &p.Post
+	case http.MethodDelete:
+		slot = &p.Delete
+	case http.MethodPatch:
+		slot = &p.Patch
+	default:
+		return
+	}
+	if *slot == nil {
+		*slot = op
+	}
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
+
+// listParameters are the query parameters of the list operations.
+var listParameters = []*Parameter{
+	{Name: "limit", In: "query", Description: "Maximum number of resources to return", Schema: &Schema{Type: "integer", Minimum: &zero}},
+	{Name: "continue", In: "query", Description: "Continue token of the previous page", Schema: &Schema{Type: "string"}},
+	{Name: "labelSelector", In: "query", Description: "Label selector", Schema: &Schema{Type: "string"}},
+	{Name: "fieldSelector", In: "query", Description: "Field selector", Schema: &Schema{Type: "string"}},
+}
+
+// operation describes a route method. The operations on the collection of a
+// resource, or on one of them, have their bodies described by its schema.
+func operation(r *route, method string, resource *Schema) *Operation {
+	op := &Operation{
+		Summary: fmt.Sprintf("%s %s", method, r.path),
+		Responses: map[string]*Response{
+			"default": {
+				Description: "Error",
+				Content:     jsonContent(&Schema{Ref: "#/components/schemas/" + errorSchema}),
+			},
+		},
+	}
+	if name, ok := r.vars["resource"]; ok {
+		op.Tags = []string{name}
+	} else {
+		op.Tags = []string{strings.Split(strings.TrimPrefix(r.path, "/"), "/")[0]}
+	}
+
+	collection := strings.HasPrefix(r.last, "{resource:")
+	item := r.last == "{id}"
+	if resource == nil || !(collection || item) {
+		op.Responses["200"] = &Response{Description: "OK"}
+		return op
+	}
+
+	switch {
+	case method == http.MethodGet && collection:
+		op.Parameters = listParameters
+		op.Responses["200"] = &Response{
+			Description: "The resources",
+			Content:     jsonContent(&Schema{Type: "array", Items: resource}),
+		}
+	case method == http.MethodGet:
+		op.Responses["200"] = &Response{
+			Description: "The resource",
+			Content:     jsonContent(resource),
+		}
+	case method == http.MethodPatch:
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				"application/merge-patch+json": {Schema: &Schema{Type: "object"}},
+			},
+		}
+		op.Responses["204"] = &Response{Description: "Patched"}
+	case method == http.MethodPut || method == http.MethodPost:
+		op.RequestBody = &RequestBody{Required: true, Content: jsonContent(resource)}
+		op.Responses["201"] = &Response{Description: "Created or updated"}
+	case method == http.MethodDelete:
+		op.Responses["204"] = &Response{Description: "Deleted"}
+	default:
+		op.Responses["200"] = &Response{Description: "OK"}
+	}
+	return op
+}
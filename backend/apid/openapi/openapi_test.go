@@ -0,0 +1,81 @@
+package openapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTemplate(t *testing.T) {
+	r, err := parseTemplate("/api/{group:core}/{version:v2}/namespaces/{namespace}/{resource:checks}/{id}")
+	require.NoError(t, err)
+	assert.Equal(t, "/api/core/v2/namespaces/{namespace}/checks/{id}", r.path)
+	assert.Equal(t, []string{"namespace", "id"}, r.parameters)
+	assert.Equal(t, map[string]string{"group": "core", "version": "v2", "resource": "checks"}, r.vars)
+	assert.Equal(t, "{id}", r.last)
+
+	r, err = parseTemplate("/events/{id:[0-9]{1,3}}/{resource:a|b}")
+	require.NoError(t, err)
+	assert.Equal(t, "/events/{id}/{resource}", r.path)
+	assert.Equal(t, []string{"id", "resource"}, r.parameters)
+
+	_, err = parseTemplate("/events/{id")
+	assert.Error(t, err)
+}
+
+func TestGenerate(t *testing.T) {
+	handler := func(http.ResponseWriter, *http.Request) {}
+
+	router := mux.NewRouter()
+	public := router.NewRoute().Subrouter()
+	public.HandleFunc("/version", handler).Methods(http.MethodGet)
+	core := router.PathPrefix("/api/{group:core}/{version:v2}/").Subrouter()
+	core.HandleFunc("/namespaces/{namespace}/{resource:checks}", handler).Methods(http.MethodGet)
+	core.HandleFunc("/namespaces/{namespace}/{resource:checks}/{id}", handler).Methods(http.MethodGet, http.MethodPut)
+	core.HandleFunc("/namespaces/{namespace}/{resource:checks}/{id}/execute", handler).Methods(http.MethodPost)
+	core.Handle("/metrics", http.HandlerFunc(handler))
+
+	doc, err := Generate(router, public)
+	require.NoError(t, err)
+	assert.Equal(t, Version, doc.OpenAPI)
+	assert.Len(t, doc.Paths, 4)
+	require.Contains(t, doc.Components.Schemas, "core.v2.CheckConfig")
+	check := &Schema{Ref: "#/components/schemas/core.v2.CheckConfig"}
+
+	version := doc.Paths["/version"]
+	require.NotNil(t, version)
+	assert.Equal(t, &[]map[string][]string{}, version.Get.Security)
+
+	list := doc.Paths["/api/core/v2/namespaces/{namespace}/checks"]
+	require.NotNil(t, list)
+	assert.Equal(t, "namespace", list.Parameters[0].Name)
+	assert.Nil(t, list.Get.Security)
+	assert.Equal(t, []string{"checks"}, list.Get.Tags)
+	assert.Equal(t, listParameters, list.Get.Parameters)
+	assert.Equal(t, &Schema{Type: "array", Items: check}, list.Get.Responses["200"].Content["application/json"].Schema)
+
+	item := doc.Paths["/api/core/v2/namespaces/{namespace}/checks/{id}"]
+	require.NotNil(t, item)
+	assert.Len(t, item.Parameters, 2)
+	assert.Equal(t, check, item.Get.Responses["200"].Content["application/json"].Schema)
+	assert.Equal(t, check, item.Put.RequestBody.Content["application/json"].Schema)
+	assert.Contains(t, item.Put.Responses, "201")
+	assert.Nil(t, item.Post)
+
+	execute := doc.Paths["/api/core/v2/namespaces/{namespace}/checks/{id}/execute"]
+	require.NotNil(t, execute)
+	assert.Nil(t, execute.Post.RequestBody)
+	assert.Contains(t, execute.Post.Responses, "default")
+}
+
+func TestFindResource(t *testing.T) {
+	resource, err := FindResource("core/v3", "EntityConfig")
+	require.NoError(t, err)
+	assert.Equal(t, "EntityConfig", typeMeta(resource).Type)
+
+	_, err = FindResource("core/v2", "Check")
+	assert.Error(t, err)
+}
@@ -0,0 +1,242 @@
+package openapi
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/types"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect of the resource schemas.
+const JSONSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// Schema is a JSON Schema, restricted to the keywords that the OpenAPI 3.0
+// schema object shares with JSON Schema so that it can be used by both.
+type Schema struct {
+	Schema      string        `json:"$schema,omitempty" yaml:"$schema,omitempty"`
+	Ref         string        `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+	Title       string        `json:"title,omitempty" yaml:"title,omitempty"`
+	Description string        `json:"description,omitempty" yaml:"description,omitempty"`
+	Type        string        `json:"type,omitempty" yaml:"type,omitempty"`
+	Format      string        `json:"format,omitempty" yaml:"format,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Default     interface{}   `json:"default,omitempty" yaml:"default,omitempty"`
+	Minimum     *float64      `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+
+	Properties map[string]*Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty" yaml:"required,omitempty"`
+	// AdditionalProperties is either a *Schema or false.
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+	Items                *Schema     `json:"items,omitempty" yaml:"items,omitempty"`
+	OneOf                []*Schema   `json:"oneOf,omitempty" yaml:"oneOf,omitempty"`
+
+	Definitions map[string]*Schema `json:"definitions,omitempty" yaml:"definitions,omitempty"`
+}
+
+var zero = float64(0)
+
+var (
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+	// typeSchemas describes the types whose JSON encoding is custom.
+	typeSchemas = map[reflect.Type]*Schema{
+		reflect.TypeOf(corev2.HookList{}): {
+			Type: "object",
+			AdditionalProperties: &Schema{
+				Type:  "array",
+				Items: &Schema{Type: "string"},
+			},
+		},
+	}
+
+	// fieldSchemas describes the fields whose JSON encoding is custom.
+	fieldSchemas = map[reflect.Type]map[string]*Schema{
+		reflect.TypeOf(corev2.Event{}): {
+			"id": {Type: "string", Format: "uuid"},
+		},
+	}
+)
+
+// reflector builds the schemas of Go types from their fields and JSON tags.
+// Named struct types are described once, in definitions, and referenced.
+type reflector struct {
+	refPrefix   string
+	definitions map[string]*Schema
+}
+
+func newReflector(refPrefix string) *reflector {
+	return &reflector{
+		refPrefix:   refPrefix,
+		definitions: map[string]*Schema{},
+	}
+}
+
+// definitionName returns the name of the definition of a named type, made
+// unique across the API groups, e.g. core.v2.CheckConfig.
+func definitionName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/api/"); i >= 0 {
+		pkg = strings.Replace(pkg[i+len("/api/"):], "/", ".", -1)
+	} else {
+		pkg = path.Base(pkg)
+	}
+	return pkg + "." + t.Name()
+}
+
+func (r *reflector) schemaOf(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if s, ok := typeSchemas[t]; ok {
+		copied := *s
+		return &copied
+	}
+	if t == rawMessageType {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Minimum: &zero}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		name := definitionName(t)
+		if _, ok := r.definitions[name]; !ok {
+			// Reserve the name first, in case the type is recursive
+			r.definitions[name] = nil
+			r.definitions[name] = r.structSchema(t)
+		}
+		return &Schema{Ref: r.refPrefix + name}
+	}
+
+	// Interfaces and anything else can hold any value
+	return &Schema{}
+}
+
+// structSchema describes the JSON object a struct is encoded to.
+func (r *reflector) structSchema(t reflect.Type) *Schema {
+	s := &Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{},
+	}
+	if _, ok := t.FieldByName("ExtendedAttributes"); !ok {
+		// Extended attributes are encoded as additional properties
+		s.AdditionalProperties = false
+	}
+	r.addFields(s, t)
+	return s
+}
+
+func (r *reflector) addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				r.addFields(s, embedded)
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if custom, ok := fieldSchemas[t][name]; ok {
+			copied := *custom
+			s.Properties[name] = &copied
+			continue
+		}
+		s.Properties[name] = r.schemaOf(field.Type)
+	}
+}
+
+// typeMeta returns the type and API version of a core/v2 or core/v3 resource.
+func typeMeta(resource interface{}) types.TypeMeta {
+	if getter, ok := resource.(interface{ GetTypeMeta() corev2.TypeMeta }); ok {
+		return getter.GetTypeMeta()
+	}
+	typ := reflect.Indirect(reflect.ValueOf(resource)).Type()
+	return types.TypeMeta{
+		Type:       typ.Name(),
+		APIVersion: types.ApiVersion(typ.PkgPath()),
+	}
+}
+
+// wrappedSchema describes a resource as it is written in resource files and
+// given to sensuctl create, with its type, API version, metadata and spec.
+func (r *reflector) wrappedSchema(resource interface{}) *Schema {
+	if proxy, ok := resource.(*corev3.V2ResourceProxy); ok {
+		resource = proxy.Resource
+	}
+	tm := typeMeta(resource)
+	spec := r.structSchema(reflect.Indirect(reflect.ValueOf(resource)).Type())
+	delete(spec.Properties, "metadata")
+
+	apiVersion := &Schema{Type: "string", Enum: []interface{}{tm.APIVersion}}
+	if tm.APIVersion == "core/v2" {
+		apiVersion.Default = tm.APIVersion
+	}
+	return &Schema{
+		Title: tm.APIVersion + "." + tm.Type,
+		Type:  "object",
+		Properties: map[string]*Schema{
+			"type":        {Type: "string", Enum: []interface{}{tm.Type}},
+			"api_version": apiVersion,
+			"metadata":    r.schemaOf(reflect.TypeOf(corev2.ObjectMeta{})),
+			"spec":        spec,
+		},
+		Required:             []string{"type", "spec"},
+		AdditionalProperties: false,
+	}
+}
+
+// ResourceSchema returns the JSON Schema of resources, as they are written in
+// resource files. When several resources are given, the schema validates any
+// of them.
+func ResourceSchema(resources ...interface{}) *Schema {
+	r := newReflector("#/definitions/")
+	var schema *Schema
+	if len(resources) == 1 {
+		schema = r.wrappedSchema(resources[0])
+	} else {
+		schema = &Schema{}
+		for _, resource := range resources {
+			schema.OneOf = append(schema.OneOf, r.wrappedSchema(resource))
+		}
+	}
+	schema.Schema = JSONSchemaDraft
+	schema.Definitions = r.definitions
+	return schema
+}
@@ -0,0 +1,89 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// refs returns the references found in a schema.
+func refs(s *Schema) []string {
+	if s == nil {
+		return nil
+	}
+	var result []string
+	if s.Ref != "" {
+		result = append(result, s.Ref)
+	}
+	for _, p := range s.Properties {
+		result = append(result, refs(p)...)
+	}
+	if additional, ok := s.AdditionalProperties.(*Schema); ok {
+		result = append(result, refs(additional)...)
+	}
+	result = append(result, refs(s.Items)...)
+	for _, o := range s.OneOf {
+		result = append(result, refs(o)...)
+	}
+	for _, d := range s.Definitions {
+		result = append(result, refs(d)...)
+	}
+	return result
+}
+
+func TestResourceSchema(t *testing.T) {
+	schema := ResourceSchema(&corev2.CheckConfig{})
+	assert.Equal(t, JSONSchemaDraft, schema.Schema)
+	assert.Equal(t, "core/v2.CheckConfig", schema.Title)
+	assert.Equal(t, []interface{}{"CheckConfig"}, schema.Properties["type"].Enum)
+	assert.Equal(t, "core/v2", schema.Properties["api_version"].Default)
+	assert.Equal(t, "#/definitions/core.v2.ObjectMeta", schema.Properties["metadata"].Ref)
+
+	spec := schema.Properties["spec"]
+	assert.NotContains(t, spec.Properties, "metadata")
+	assert.Equal(t, "integer", spec.Properties["interval"].Type)
+	assert.Equal(t, float64(0), *spec.Properties["interval"].Minimum)
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}}, spec.Properties["subscriptions"])
+	// Hook lists are encoded as a map of hook names by status
+	hooks := spec.Properties["check_hooks"].Items
+	assert.Equal(t, "object", hooks.Type)
+	assert.Equal(t, "array", hooks.AdditionalProperties.(*Schema).Type)
+	assert.NotContains(t, spec.Properties, "ExtendedAttributes")
+	// Custom attributes of checks are allowed
+	assert.Nil(t, spec.AdditionalProperties)
+
+	meta := schema.Definitions["core.v2.ObjectMeta"]
+	require.NotNil(t, meta)
+	assert.Equal(t, false, meta.AdditionalProperties)
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}, meta.Properties["labels"])
+}
+
+func TestResourceSchemaV3(t *testing.T) {
+	schema := ResourceSchema(corev3.V3ToV2Resource(&corev3.EntityConfig{}))
+	assert.Equal(t, "core/v3.EntityConfig", schema.Title)
+	assert.Nil(t, schema.Properties["api_version"].Default)
+	assert.Contains(t, schema.Properties["spec"].Properties, "entity_class")
+	assert.NotContains(t, schema.Properties["spec"].Properties, "metadata")
+}
+
+func TestResourceSchemaAll(t *testing.T) {
+	schema := ResourceSchema(Resources()...)
+	assert.Len(t, schema.OneOf, len(Resources()))
+
+	for _, ref := range refs(schema) {
+		name := strings.TrimPrefix(ref, "#/definitions/")
+		assert.NotNil(t, schema.Definitions[name], ref)
+	}
+	_, err := json.Marshal(schema)
+	require.NoError(t, err)
+}
+
+func TestEventID(t *testing.T) {
+	schema := ResourceSchema(&corev2.Event{})
+	assert.Equal(t, "uuid", schema.Properties["spec"].Properties["id"].Format)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/handlers"
 	"github.com/sensu/sensu-go/backend/store"
 )
@@ -44,10 +45,46 @@ func (r *APIKeysRouter) Mount(parent *mux.Router) {
 	routes.Get(r.handlers.GetResource)
 	routes.List(r.handlers.ListResources, corev2.APIKeyFields)
 	parent.HandleFunc(routes.PathPrefix, r.create).Methods(http.MethodPost)
-	routes.Patch(r.handlers.PatchResource)
+	routes.Patch(r.patch)
+}
+
+// apiKeyScopeFields are the JSON fields of an api key that define what it
+// permits. They can't be patched, since a key could otherwise widen its own
+// scope; a new key must be created instead.
+var apiKeyScopeFields = []string{
+	"username",
+	"created_at",
+	"expires_at",
+	"namespaces",
+	"rules",
+	"allowed_cidrs",
+}
+
+func (r *APIKeysRouter) patch(req *http.Request) (interface{}, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	for _, field := range apiKeyScopeFields {
+		if _, ok := fields[field]; ok {
+			return nil, actions.NewErrorf(actions.InvalidArgument, "the %s of an api key can't be changed, create a new key instead", field)
+		}
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return r.handlers.PatchResource(req)
 }
 
 func (r *APIKeysRouter) create(w http.ResponseWriter, req *http.Request) {
+	// A scoped api key can't mint keys, which could be wider than its own scope
+	if apikey := corev2.ContextAPIKey(req.Context()); apikey != nil && apikey.IsScoped() {
+		http.Error(w, "scoped api keys can't create api keys", http.StatusForbidden)
+		return
+	}
+
 	apikey := &corev2.APIKey{}
 	if err := UnmarshalBody(req, apikey); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -71,6 +108,7 @@ func (r *APIKeysRouter) create(w http.ResponseWriter, req *http.Request) {
 	}
 	apikey.Name = key.String()
 	apikey.CreatedAt = time.Now().Unix()
+	apikey.LastUsedAt = 0
 	newBytes, err := json.Marshal(apikey)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
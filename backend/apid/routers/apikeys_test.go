@@ -2,6 +2,7 @@ package routers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -93,3 +94,83 @@ func TestPostAPIKeyInvalidUser(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
 }
+
+func TestPatchAPIKeyScope(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("PatchResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	router := NewAPIKeysRouter(s)
+	parentRouter := mux.NewRouter()
+	router.Mount(parentRouter)
+	server := httptest.NewServer(parentRouter)
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		body           string
+		wantStatusCode int
+	}{
+		{
+			name:           "labels can be patched",
+			body:           `{"metadata":{"labels":{"team":"ops"}}}`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "namespaces can't be patched",
+			body:           `{"namespaces":[]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "rules can't be patched",
+			body:           `{"rules":null}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "expiration can't be patched",
+			body:           `{"expires_at":0}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPatch, server.URL+"/apikeys/226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", bytes.NewReader([]byte(tt.body)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			assert.Equal(t, tt.wantStatusCode, res.StatusCode)
+		})
+	}
+}
+
+func TestPostAPIKeyWithScopedKey(t *testing.T) {
+	s := &mockstore.MockStore{}
+	router := NewAPIKeysRouter(s)
+	parentRouter := mux.NewRouter()
+	parentRouter.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			apikey := corev2.FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "admin")
+			apikey.Namespaces = []string{"dev"}
+			ctx := context.WithValue(r.Context(), corev2.APIKeyKey, apikey)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	router.Mount(parentRouter)
+	server := httptest.NewServer(parentRouter)
+	defer server.Close()
+
+	payload, err := json.Marshal(corev2.FixtureAPIKey("8b2a6c1e-3f1c-4d5e-9a4b-2c7d8e9f0a1b", "admin"))
+	assert.NoError(t, err)
+	res, err := http.Post(server.URL+"/apikeys", "application/json", bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	s.AssertNotCalled(t, "CreateResource", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,163 @@
+package routers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ApplyResult is the outcome of applying a resource of a batch.
+type ApplyResult struct {
+	APIVersion string            `json:"api_version"`
+	Type       string            `json:"type"`
+	Namespace  string            `json:"namespace,omitempty"`
+	Name       string            `json:"name"`
+	Action     store.ApplyAction `json:"action,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// ApplyReport is the response to a bulk apply request.
+type ApplyReport struct {
+	DryRun  bool          `json:"dry_run"`
+	Applied bool          `json:"applied"`
+	Message string        `json:"message,omitempty"`
+	Results []ApplyResult `json:"results"`
+}
+
+// ApplyRouter handles requests for /apply, which creates or updates batches of
+// wrapped resources, all of them or none.
+type ApplyRouter struct {
+	store store.ResourceStore
+	auth  authorization.Authorizer
+}
+
+// NewApplyRouter instantiates a new router applying batches of resources
+func NewApplyRouter(store store.ResourceStore, auth authorization.Authorizer) *ApplyRouter {
+	return &ApplyRouter{
+		store: store,
+		auth:  auth,
+	}
+}
+
+// Mount the ApplyRouter to a parent Router. The route is left to the router to
+// authorize, resource by resource.
+func (r *ApplyRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/{resource:apply}", r.apply).Methods(http.MethodPut)
+}
+
+func (r *ApplyRouter) apply(w http.ResponseWriter, req *http.Request) {
+	dryRun, err := parseDryRun(req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	report := ApplyReport{DryRun: dryRun, Results: []ApplyResult{}}
+
+	var wrappers []types.Wrapper
+	if err := json.NewDecoder(req.Body).Decode(&wrappers); err != nil {
+		WriteError(w, actions.NewError(actions.InvalidArgument, err))
+		return
+	}
+	resources := make([]corev2.Resource, 0, len(wrappers))
+	valid := true
+	for _, wrapper := range wrappers {
+		result := ApplyResult{
+			APIVersion: wrapper.APIVersion,
+			Type:       wrapper.Type,
+			Namespace:  wrapper.ObjectMeta.Namespace,
+			Name:       wrapper.ObjectMeta.Name,
+		}
+		if resource, ok := wrapper.Value.(corev2.Resource); ok {
+			resources = append(resources, resource)
+		} else {
+			result.Error = fmt.Sprintf("%s.%s can't be applied in bulk", wrapper.APIVersion, wrapper.Type)
+			valid = false
+		}
+		report.Results = append(report.Results, result)
+	}
+	if !valid {
+		report.Message = "some resources can't be applied, none were"
+		respondWithReport(w, http.StatusBadRequest, report)
+		return
+	}
+
+	applyResources(w, req, api.NewApplyClient(r.store, r.auth), resources, report)
+}
+
+// parseDryRun returns the value of the dryRun query parameter, false if unset.
+func parseDryRun(req *http.Request) (bool, error) {
+	value := req.URL.Query().Get("dryRun")
+	if value == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, actions.NewErrorf(actions.InvalidArgument, "invalid dryRun value: %s", value)
+	}
+	return dryRun, nil
+}
+
+// applyResources applies the resources, all of them or none, and responds with
+// the report completed with what was done to each of them, or why none were.
+func applyResources(w http.ResponseWriter, req *http.Request, client *api.ApplyClient, resources []corev2.Resource, report ApplyReport) {
+	applied, err := client.Apply(req.Context(), resources, report.DryRun)
+	if err != nil {
+		code := actions.InternalErr
+		switch err := err.(type) {
+		case api.ResourceErrors:
+			code = actions.InvalidArgument
+			for _, rerr := range err {
+				report.Results[rerr.Index].Error = rerr.Err.Error()
+				if rerr.Err == authorization.ErrUnauthorized || rerr.Err == authorization.ErrNoClaims {
+					code = actions.PermissionDenied
+				}
+			}
+			report.Message = "some resources can't be applied, none were"
+		case *store.ErrNamespaceMissing:
+			code = actions.InvalidArgument
+			for i := range report.Results {
+				if report.Results[i].Namespace == err.Namespace {
+					report.Results[i].Error = err.Error()
+				}
+			}
+			report.Message = err.Error()
+		case *store.ErrNotValid:
+			code = actions.InvalidArgument
+			report.Message = err.Error()
+		case *store.ErrQuotaExceeded:
+			code = actions.QuotaExceeded
+			report.Message = err.Error()
+		case *store.ErrPreconditionFailed:
+			// The resources were modified while being applied
+			code = actions.PreconditionFailed
+			report.Message = err.Error()
+		default:
+			report.Message = err.Error()
+		}
+		respondWithReport(w, HTTPStatusFromCode(code), report)
+		return
+	}
+
+	for i, action := range applied {
+		report.Results[i].Action = action
+	}
+	report.Applied = !report.DryRun
+	respondWithReport(w, http.StatusOK, report)
+}
+
+func respondWithReport(w http.ResponseWriter, status int, report ApplyReport) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logger.WithError(err).Error("failed to write response")
+	}
+}
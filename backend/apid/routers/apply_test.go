@@ -0,0 +1,155 @@
+package routers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockauthorizer"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newApplyTest(t *testing.T, s store.ResourceStore, authorized bool) *httptest.Server {
+	authorizer := &mockauthorizer.Authorizer{}
+	authorizer.On("Authorize", mock.Anything, mock.Anything).Return(authorized, nil)
+	router := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Use(mockedClaims)
+	NewApplyRouter(s, authorizer).Mount(router)
+	return httptest.NewServer(router)
+}
+
+func applyRequest(t *testing.T, server *httptest.Server, query string, resources ...interface{}) (int, ApplyReport) {
+	wrappers := []types.Wrapper{}
+	for _, resource := range resources {
+		wrappers = append(wrappers, types.WrapResource(resource.(types.Resource)))
+	}
+	body, err := json.Marshal(wrappers)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/api/core/v2/apply"+query, bytes.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var report ApplyReport
+	if resp.StatusCode < 500 {
+		_ = json.NewDecoder(resp.Body).Decode(&report)
+	}
+	return resp.StatusCode, report
+}
+
+func TestApplyRouter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	actions := []store.ApplyAction{store.ApplyCreated, store.ApplyUpdated}
+	s.On("ApplyResources", mock.Anything, mock.Anything, false).Return(actions, nil)
+	server := newApplyTest(t, s, true)
+	defer server.Close()
+
+	code, report := applyRequest(t, server, "", corev2.FixtureCheckConfig("check"), corev2.FixtureClusterRole("role"))
+	require.Equal(t, http.StatusOK, code)
+	assert.True(t, report.Applied)
+	assert.False(t, report.DryRun)
+	assert.Equal(t, []ApplyResult{
+		{APIVersion: "core/v2", Type: "CheckConfig", Namespace: "default", Name: "check", Action: store.ApplyCreated},
+		{APIVersion: "core/v2", Type: "ClusterRole", Name: "role", Action: store.ApplyUpdated},
+	}, report.Results)
+}
+
+func TestApplyRouterDryRun(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("ApplyResources", mock.Anything, mock.Anything, true).Return([]store.ApplyAction{store.ApplyUnchanged}, nil)
+	server := newApplyTest(t, s, true)
+	defer server.Close()
+
+	code, report := applyRequest(t, server, "?dryRun=true", corev2.FixtureCheckConfig("check"))
+	require.Equal(t, http.StatusOK, code)
+	assert.False(t, report.Applied)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, store.ApplyUnchanged, report.Results[0].Action)
+
+	code, _ = applyRequest(t, server, "?dryRun=maybe", corev2.FixtureCheckConfig("check"))
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestApplyRouterErrors(t *testing.T) {
+	invalid := corev2.FixtureCheckConfig("invalid")
+	invalid.Interval = 0
+	missing := corev2.FixtureCheckConfig("missing")
+	missing.Namespace = "missing"
+
+	tests := []struct {
+		name       string
+		storeErr   error
+		authorized bool
+		resources  []interface{}
+		wantCode   int
+		wantErrors []bool
+	}{
+		{
+			name:       "invalid resource",
+			authorized: true,
+			resources:  []interface{}{corev2.FixtureCheckConfig("check"), invalid},
+			wantCode:   http.StatusBadRequest,
+			wantErrors: []bool{false, true},
+		},
+		{
+			name:       "unsupported resource",
+			authorized: true,
+			resources:  []interface{}{corev2.FixtureCheckConfig("check"), corev3.V3ToV2Resource(corev3.FixtureEntityConfig("entity"))},
+			wantCode:   http.StatusBadRequest,
+			wantErrors: []bool{false, true},
+		},
+		{
+			name:       "unauthorized",
+			authorized: false,
+			resources:  []interface{}{corev2.FixtureCheckConfig("check")},
+			wantCode:   HTTPStatusFromCode(actions.PermissionDenied),
+			wantErrors: []bool{true},
+		},
+		{
+			name:       "missing namespace",
+			storeErr:   &store.ErrNamespaceMissing{Namespace: "missing"},
+			authorized: true,
+			resources:  []interface{}{corev2.FixtureCheckConfig("check"), missing},
+			wantCode:   http.StatusBadRequest,
+			wantErrors: []bool{false, true},
+		},
+		{
+			name:       "concurrent modification",
+			storeErr:   &store.ErrPreconditionFailed{Key: "check"},
+			authorized: true,
+			resources:  []interface{}{corev2.FixtureCheckConfig("check")},
+			wantCode:   http.StatusPreconditionFailed,
+			wantErrors: []bool{false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockstore.MockStore{}
+			s.On("ApplyResources", mock.Anything, mock.Anything, false).Return([]store.ApplyAction(nil), tt.storeErr)
+			server := newApplyTest(t, s, tt.authorized)
+			defer server.Close()
+
+			code, report := applyRequest(t, server, "", tt.resources...)
+			assert.Equal(t, tt.wantCode, code)
+			assert.False(t, report.Applied)
+			assert.NotEmpty(t, report.Message)
+			require.Len(t, report.Results, len(tt.wantErrors))
+			for i, wantErr := range tt.wantErrors {
+				assert.Equal(t, wantErr, report.Results[i].Error != "", "result %d", i)
+				assert.Empty(t, report.Results[i].Action)
+			}
+		})
+	}
+}
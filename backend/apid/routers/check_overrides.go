@@ -0,0 +1,39 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// CheckOverridesRouter handles requests for /checkoverrides
+type CheckOverridesRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewCheckOverridesRouter instantiates new router for controlling check override resources
+func NewCheckOverridesRouter(store store.ResourceStore) *CheckOverridesRouter {
+	return &CheckOverridesRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.CheckOverride{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the CheckOverridesRouter to a parent Router
+func (r *CheckOverridesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:checkoverrides}",
+	}
+
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.CheckOverrideFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:checkoverrides}", corev2.CheckOverrideFields)
+	routes.Patch(r.handlers.PatchResource)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Del(r.handlers.DeleteResource)
+}
@@ -0,0 +1,29 @@
+package routers
+
+import (
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+)
+
+func TestCheckOverridesRouter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	router := NewCheckOverridesRouter(s)
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	empty := &corev2.CheckOverride{}
+	fixture := corev2.FixtureCheckOverride("foo", "check")
+
+	tests := []routerTestCase{}
+	tests = append(tests, getTestCases(fixture)...)
+	tests = append(tests, listTestCases(empty)...)
+	tests = append(tests, createTestCases(empty)...)
+	tests = append(tests, updateTestCases(fixture)...)
+	tests = append(tests, deleteTestCases(fixture)...)
+	for _, tt := range tests {
+		run(t, tt, parentRouter, s)
+	}
+}
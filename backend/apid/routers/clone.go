@@ -0,0 +1,108 @@
+package routers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// CloneRequest is the body of a request cloning the resources of a namespace.
+type CloneRequest struct {
+	// Destination is the namespace the resources are copied to
+	Destination string `json:"destination"`
+
+	// Types are the kinds of resources copied, by RBAC name, all of those that
+	// can be cloned when empty
+	Types []string `json:"types,omitempty"`
+}
+
+// CloneRouter handles requests for /namespaces/:namespace/clone, which copies
+// resources of a namespace to another one, all of them or none.
+type CloneRouter struct {
+	store store.ResourceStore
+	auth  authorization.Authorizer
+}
+
+// NewCloneRouter instantiates a new router cloning namespaces
+func NewCloneRouter(store store.ResourceStore, auth authorization.Authorizer) *CloneRouter {
+	return &CloneRouter{
+		store: store,
+		auth:  auth,
+	}
+}
+
+// Mount the CloneRouter to a parent Router. The route is left to the router to
+// authorize, resource by resource.
+func (r *CloneRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/namespaces/{namespace}/{resource:clone}", r.clone).Methods(http.MethodPost)
+}
+
+func (r *CloneRouter) clone(w http.ResponseWriter, req *http.Request) {
+	dryRun, err := parseDryRun(req)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	source, err := url.PathUnescape(mux.Vars(req)["namespace"])
+	if err != nil {
+		WriteError(w, actions.NewError(actions.InvalidArgument, err))
+		return
+	}
+	var body CloneRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		WriteError(w, actions.NewError(actions.InvalidArgument, err))
+		return
+	}
+	if body.Destination == "" {
+		WriteError(w, actions.NewError(actions.InvalidArgument, errors.New("the destination namespace is missing")))
+		return
+	}
+	if body.Destination == source {
+		WriteError(w, actions.NewError(actions.InvalidArgument, errors.New("a namespace can't be cloned into itself")))
+		return
+	}
+	sel, err := selector.New(req.URL.Query().Get("labelSelector"), "")
+	if err != nil {
+		WriteError(w, actions.NewError(actions.InvalidArgument, err))
+		return
+	}
+
+	client := api.NewCloneClient(r.store, r.auth)
+	resources, err := client.Resources(req.Context(), source, body.Destination, body.Types, sel)
+	if err != nil {
+		switch err.(type) {
+		case *api.ErrNotClonable:
+			WriteError(w, actions.NewError(actions.InvalidArgument, err))
+		case *store.ErrNotFound:
+			WriteError(w, actions.NewErrorf(actions.NotFound))
+		default:
+			if err == authorization.ErrUnauthorized || err == authorization.ErrNoClaims {
+				WriteError(w, actions.NewError(actions.PermissionDenied, err))
+				return
+			}
+			WriteError(w, actions.NewError(actions.InternalErr, err))
+		}
+		return
+	}
+
+	report := ApplyReport{DryRun: dryRun, Results: []ApplyResult{}}
+	for _, resource := range resources {
+		wrapper := types.WrapResource(resource)
+		report.Results = append(report.Results, ApplyResult{
+			APIVersion: wrapper.APIVersion,
+			Type:       wrapper.Type,
+			Namespace:  wrapper.ObjectMeta.Namespace,
+			Name:       wrapper.ObjectMeta.Name,
+		})
+	}
+	applyResources(w, req, api.NewApplyClient(r.store, r.auth), resources, report)
+}
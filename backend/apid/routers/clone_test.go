@@ -0,0 +1,150 @@
+package routers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockauthorizer"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newCloneTest(t *testing.T, s store.ResourceStore, authorized bool) *httptest.Server {
+	authorizer := &mockauthorizer.Authorizer{}
+	authorizer.On("Authorize", mock.Anything, mock.Anything).Return(authorized, nil)
+	router := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Use(mockedClaims)
+	NewCloneRouter(s, authorizer).Mount(router)
+	return httptest.NewServer(router)
+}
+
+func cloneRequest(t *testing.T, server *httptest.Server, source, query string, body CloneRequest) (int, ApplyReport) {
+	b, err := json.Marshal(body)
+	require.NoError(t, err)
+	url := server.URL + "/api/core/v2/namespaces/" + source + "/clone" + query
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(b))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var report ApplyReport
+	if resp.StatusCode == http.StatusOK {
+		_ = json.NewDecoder(resp.Body).Decode(&report)
+	}
+	return resp.StatusCode, report
+}
+
+func cloneStore() *mockstore.MockStore {
+	s := &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "template", mock.Anything).Return(nil)
+	s.On("GetResource", mock.Anything, "missing", mock.Anything).Return(&store.ErrNotFound{Key: "missing"})
+	s.On("ListResources", mock.Anything, (&corev2.CheckConfig{}).StorePrefix(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		prod := corev2.FixtureCheckConfig("prod")
+		prod.Namespace = "template"
+		prod.Labels = map[string]string{"env": "prod"}
+		dev := corev2.FixtureCheckConfig("dev")
+		dev.Namespace = "template"
+		*args.Get(2).(*[]*corev2.CheckConfig) = []*corev2.CheckConfig{prod, dev}
+	}).Return(nil)
+	return s
+}
+
+func TestCloneRouter(t *testing.T) {
+	s := cloneStore()
+	s.On("ApplyResources", mock.Anything, mock.Anything, true).Return([]store.ApplyAction{store.ApplyCreated}, nil)
+	server := newCloneTest(t, s, true)
+	defer server.Close()
+
+	body := CloneRequest{Destination: "team", Types: []string{"checks"}}
+	code, report := cloneRequest(t, server, "template", "?dryRun=true&labelSelector=env%3D%3Dprod", body)
+	require.Equal(t, http.StatusOK, code)
+	assert.True(t, report.DryRun)
+	assert.False(t, report.Applied)
+	assert.Equal(t, []ApplyResult{
+		{APIVersion: "core/v2", Type: "CheckConfig", Namespace: "team", Name: "prod", Action: store.ApplyCreated},
+	}, report.Results)
+	s.AssertCalled(t, "ApplyResources", mock.Anything, mock.MatchedBy(func(resources []corev2.Resource) bool {
+		return len(resources) == 1 && resources[0].GetObjectMeta().Namespace == "team"
+	}), true)
+}
+
+func TestCloneRouterErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     string
+		query      string
+		body       CloneRequest
+		authorized bool
+		wantCode   int
+	}{
+		{
+			name:       "missing destination",
+			source:     "template",
+			authorized: true,
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "same namespace",
+			source:     "template",
+			body:       CloneRequest{Destination: "template"},
+			authorized: true,
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "invalid label selector",
+			source:     "template",
+			query:      "?labelSelector=env%3D%3D",
+			body:       CloneRequest{Destination: "team"},
+			authorized: true,
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "invalid dry run",
+			source:     "template",
+			query:      "?dryRun=maybe",
+			body:       CloneRequest{Destination: "team"},
+			authorized: true,
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "type that can't be cloned",
+			source:     "template",
+			body:       CloneRequest{Destination: "team", Types: []string{"entities"}},
+			authorized: true,
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "missing source",
+			source:     "missing",
+			body:       CloneRequest{Destination: "team", Types: []string{"checks"}},
+			authorized: true,
+			wantCode:   http.StatusNotFound,
+		},
+		{
+			name:     "unauthorized",
+			source:   "template",
+			body:     CloneRequest{Destination: "team", Types: []string{"checks"}},
+			wantCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := cloneStore()
+			server := newCloneTest(t, s, tt.authorized)
+			defer server.Close()
+
+			code, _ := cloneRequest(t, server, tt.source, tt.query, tt.body)
+			assert.Equal(t, tt.wantCode, code)
+			s.AssertNotCalled(t, "ApplyResources", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 // EventsRouter handles requests for /events
 type EventsRouter struct {
 	controller eventController
+	executions store.HandlerExecutionStore
 }
 
 // eventController represents the controller needs of the EventsRouter.
@@ -28,9 +29,10 @@ type eventController interface {
 }
 
 // NewEventsRouter instantiates new events controller
-func NewEventsRouter(store store.EventStore, bus messaging.MessageBus) *EventsRouter {
+func NewEventsRouter(store store.EventStore, executions store.HandlerExecutionStore, bus messaging.MessageBus) *EventsRouter {
 	return &EventsRouter{
 		controller: actions.NewEventController(store, bus),
+		executions: executions,
 	}
 }
 
@@ -47,6 +49,7 @@ func (r *EventsRouter) Mount(parent *mux.Router) {
 	routes.Path("{entity}/{check}", r.get).Methods(http.MethodGet)
 	routes.Path("{entity}/{check}", r.delete).Methods(http.MethodDelete)
 	routes.Path("{entity}/{check}", r.createOrReplace).Methods(http.MethodPost, http.MethodPut)
+	routes.Path("{entity}/{check}/handlers", r.getHandlerExecutions).Methods(http.MethodGet)
 
 	// Additionaly allow a subcollection to be specified when listing events,
 	// which correspond to the entity name here
@@ -62,6 +65,17 @@ func (r *EventsRouter) get(req *http.Request) (interface{}, error) {
 	return record, err
 }
 
+func (r *EventsRouter) getHandlerExecutions(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	entity := url.PathEscape(params["entity"])
+	check := url.PathEscape(params["check"])
+	executions, err := r.executions.GetHandlerExecutions(req.Context(), entity, check)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return executions, nil
+}
+
 func (r *EventsRouter) delete(req *http.Request) (interface{}, error) {
 	params := actions.QueryParams(mux.Vars(req))
 	entity := url.PathEscape(params["entity"])
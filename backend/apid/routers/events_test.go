@@ -3,6 +3,8 @@ package routers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -12,6 +14,7 @@ import (
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -412,3 +415,40 @@ func TestEventsRouter(t *testing.T) {
 		})
 	}
 }
+
+func TestEventsRouterHandlerExecutions(t *testing.T) {
+	fixture := corev2.FixtureEvent("foo", "check-cpu")
+	execution := corev2.NewHandlerExecution(fixture, "slack")
+
+	s := &mockstore.MockStore{}
+	s.On("GetHandlerExecutions", mock.Anything, "foo", "check-cpu").
+		Return([]*corev2.HandlerExecution{execution}, nil).Once()
+	s.On("GetHandlerExecutions", mock.Anything, "foo", "check-mem").
+		Return([]*corev2.HandlerExecution(nil), errors.New("error")).Once()
+
+	router := EventsRouter{controller: &mockEventController{}, executions: s}
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	req := httptest.NewRequest(http.MethodGet, fixture.URIPath()+"/handlers", nil)
+	res := httptest.NewRecorder()
+	parentRouter.ServeHTTP(res, req)
+	if got, want := res.Code, http.StatusOK; got != want {
+		t.Fatalf("bad status: got %d, want %d", got, want)
+	}
+	var executions []*corev2.HandlerExecution
+	if err := json.Unmarshal(res.Body.Bytes(), &executions); err != nil {
+		t.Fatal(err)
+	}
+	if len(executions) != 1 || executions[0].Name != "slack" {
+		t.Fatalf("bad executions: %v", executions)
+	}
+
+	fixture.Check.Name = "check-mem"
+	req = httptest.NewRequest(http.MethodGet, fixture.URIPath()+"/handlers", nil)
+	res = httptest.NewRecorder()
+	parentRouter.ServeHTTP(res, req)
+	if got, want := res.Code, http.StatusInternalServerError; got != want {
+		t.Fatalf("bad status: got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// HandlerFailureStore manages the dead-letter list of failed handler
+// executions.
+type HandlerFailureStore interface {
+	ListFailures(ctx context.Context) ([]*corev2.HandlerFailure, error)
+	RetryFailure(ctx context.Context, name string) error
+	PurgeFailures(ctx context.Context, name string) error
+}
+
+// HandlerFailuresRouter handles requests for /handler-failures
+type HandlerFailuresRouter struct {
+	store HandlerFailureStore
+}
+
+// NewHandlerFailuresRouter instantiates new router for managing the failed
+// handler executions
+func NewHandlerFailuresRouter(store HandlerFailureStore) *HandlerFailuresRouter {
+	return &HandlerFailuresRouter{store: store}
+}
+
+// Mount the HandlerFailuresRouter to a parent Router
+func (r *HandlerFailuresRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:handler-failures}",
+	}
+	routes.Path("", r.list).Methods(http.MethodGet)
+	routes.Path("", r.purgeAll).Methods(http.MethodDelete)
+	routes.Del(r.purge)
+	routes.Path("{id}/retry", r.retry).Methods(http.MethodPost)
+}
+
+func (r *HandlerFailuresRouter) list(req *http.Request) (interface{}, error) {
+	failures, err := r.store.ListFailures(req.Context())
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return failures, nil
+}
+
+func (r *HandlerFailuresRouter) retry(req *http.Request) (interface{}, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	return nil, handlerFailureError(r.store.RetryFailure(req.Context(), id))
+}
+
+func (r *HandlerFailuresRouter) purge(req *http.Request) (interface{}, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	return nil, handlerFailureError(r.store.PurgeFailures(req.Context(), id))
+}
+
+func (r *HandlerFailuresRouter) purgeAll(req *http.Request) (interface{}, error) {
+	return nil, handlerFailureError(r.store.PurgeFailures(req.Context(), ""))
+}
+
+func handlerFailureError(err error) error {
+	switch err := err.(type) {
+	case nil:
+		return nil
+	case *store.ErrNotFound:
+		return actions.NewErrorf(actions.NotFound)
+	case *store.ErrNamespaceMissing:
+		return actions.NewError(actions.NotFound, err)
+	default:
+		return actions.NewError(actions.InternalErr, err)
+	}
+}
@@ -0,0 +1,125 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockHandlerFailureStore struct {
+	mock.Mock
+}
+
+func (m *mockHandlerFailureStore) ListFailures(ctx context.Context) ([]*corev2.HandlerFailure, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*corev2.HandlerFailure), args.Error(1)
+}
+
+func (m *mockHandlerFailureStore) RetryFailure(ctx context.Context, name string) error {
+	return m.Called(ctx, name).Error(0)
+}
+
+func (m *mockHandlerFailureStore) PurgeFailures(ctx context.Context, name string) error {
+	return m.Called(ctx, name).Error(0)
+}
+
+func TestHandlerFailuresRouter(t *testing.T) {
+	failure := corev2.NewHandlerFailure(corev2.FixtureHandler("slack"), corev2.FixtureEvent("foo", "check-cpu"), nil, errors.New("error"))
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		storeFunc      func(*mockHandlerFailureStore)
+		wantStatusCode int
+	}{
+		{
+			name:   "it lists the handler failures",
+			method: http.MethodGet,
+			path:   "/api/core/v2/namespaces/default/handler-failures",
+			storeFunc: func(s *mockHandlerFailureStore) {
+				s.On("ListFailures", mock.Anything).Return([]*corev2.HandlerFailure{failure}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "it returns 500 if the handler failures cannot be listed",
+			method: http.MethodGet,
+			path:   "/api/core/v2/namespaces/default/handler-failures",
+			storeFunc: func(s *mockHandlerFailureStore) {
+				s.On("ListFailures", mock.Anything).Return([]*corev2.HandlerFailure(nil), errors.New("error"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "it retries a handler failure",
+			method: http.MethodPost,
+			path:   "/api/core/v2/namespaces/default/handler-failures/abc/retry",
+			storeFunc: func(s *mockHandlerFailureStore) {
+				s.On("RetryFailure", mock.Anything, "abc").Return(nil)
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:   "it returns 404 if the handler failure to retry does not exist",
+			method: http.MethodPost,
+			path:   "/api/core/v2/namespaces/default/handler-failures/abc/retry",
+			storeFunc: func(s *mockHandlerFailureStore) {
+				s.On("RetryFailure", mock.Anything, "abc").Return(&store.ErrNotFound{Key: "abc"})
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "it purges a handler failure",
+			method: http.MethodDelete,
+			path:   "/api/core/v2/namespaces/default/handler-failures/abc",
+			storeFunc: func(s *mockHandlerFailureStore) {
+				s.On("PurgeFailures", mock.Anything, "abc").Return(nil)
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:   "it purges all the handler failures",
+			method: http.MethodDelete,
+			path:   "/api/core/v2/namespaces/default/handler-failures",
+			storeFunc: func(s *mockHandlerFailureStore) {
+				s.On("PurgeFailures", mock.Anything, "").Return(nil)
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockHandlerFailureStore{}
+			tt.storeFunc(s)
+			router := NewHandlerFailuresRouter(s)
+			parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+			router.Mount(parentRouter)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			res := httptest.NewRecorder()
+			parentRouter.ServeHTTP(res, req)
+			if got, want := res.Code, tt.wantStatusCode; got != want {
+				t.Fatalf("bad status: got %d, want %d (%s)", got, want, res.Body.String())
+			}
+			if tt.method == http.MethodGet && res.Code == http.StatusOK {
+				var failures []*corev2.HandlerFailure
+				if err := json.Unmarshal(res.Body.Bytes(), &failures); err != nil {
+					t.Fatal(err)
+				}
+				if len(failures) != 1 || failures[0].Handler != "slack" {
+					t.Fatalf("bad failures: %v", failures)
+				}
+			}
+			s.AssertExpectations(t)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/selector"
 	"github.com/sensu/sensu-go/backend/store"
 )
 
@@ -29,12 +30,16 @@ func init() {
 	Lister = List
 }
 
-// List handles resources listing with pagination support
+// List handles resources listing with pagination and selectors support. The
+// selector is handed to the store, and applied to the results unless the store
+// reports having done so already.
 func List(list ListControllerFunc, fields FieldsFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		pred := &store.SelectionPredicate{
 			Continue: corev2.PageContinueFromContext(r.Context()),
 			Limit:    int64(corev2.PageSizeFromContext(r.Context())),
+			Selector: selector.SelectorFromContext(r.Context()),
+			Fields:   fields,
 		}
 
 		params := actions.QueryParams(mux.Vars(r))
@@ -48,6 +53,16 @@ func List(list ListControllerFunc, fields FieldsFunc) http.HandlerFunc {
 			return
 		}
 
+		if pred.Selector != nil && !pred.SelectorApplied {
+			selected := results[:0]
+			for _, result := range results {
+				if pred.Matches(result) {
+					selected = append(selected, result)
+				}
+			}
+			results = selected
+		}
+
 		if pred.Continue != "" {
 			encodedContinue := base64.RawURLEncoding.EncodeToString([]byte(pred.Continue))
 			w.Header().Set(corev2.PaginationContinueHeader, encodedContinue)
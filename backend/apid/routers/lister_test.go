@@ -11,6 +11,7 @@ import (
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/middlewares"
+	"github.com/sensu/sensu-go/backend/selector"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -25,6 +26,12 @@ func (m *mockGenericController) List(ctx context.Context, pred *store.SelectionP
 	return args.Get(0).([]corev2.Resource), args.Error(1)
 }
 
+func fixtureCheckWithRegion(name, region string) *corev2.Check {
+	check := corev2.FixtureCheck(name)
+	check.Labels = map[string]string{"region": region}
+	return check
+}
+
 func TestList(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -36,6 +43,7 @@ func TestList(t *testing.T) {
 		expectedLen            int
 		expectedPred           *store.SelectionPredicate
 		expectedStatus         int
+		selectorApplied        bool
 	}{
 		{
 			name:           "list without pagination",
@@ -70,6 +78,48 @@ func TestList(t *testing.T) {
 			expectedStatus:         http.StatusOK,
 			expectedContinueHeader: "YmFy",
 		},
+		{
+			name:        "label selector",
+			path:        "/foo?labelSelector=region%20%3D%3D%20us-west-1",
+			results:     []corev2.Resource{fixtureCheckWithRegion("check-cpu", "us-west-1"), fixtureCheckWithRegion("check-mem", "eu-west-1")},
+			expectedLen: 1,
+			expectedPred: &store.SelectionPredicate{
+				Selector: &selector.Selector{Labels: []selector.Requirement{
+					{Key: "region", Operator: selector.DoubleEqualSignOperator, Values: []string{"us-west-1"}},
+				}},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "field selector",
+			path:        "/foo?fieldSelector=check.name%20in%20(check-mem,check-disk)",
+			results:     []corev2.Resource{corev2.FixtureCheck("check-cpu"), corev2.FixtureCheck("check-mem")},
+			expectedLen: 1,
+			expectedPred: &store.SelectionPredicate{
+				Selector: &selector.Selector{Fields: []selector.Requirement{
+					{Key: "check.name", Operator: selector.InOperator, Values: []string{"check-mem", "check-disk"}},
+				}},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:            "selector applied by the store",
+			path:            "/foo?labelSelector=region%20%3D%3D%20us-west-1",
+			results:         []corev2.Resource{fixtureCheckWithRegion("check-cpu", "us-west-1"), fixtureCheckWithRegion("check-mem", "eu-west-1")},
+			selectorApplied: true,
+			expectedLen:     2,
+			expectedPred: &store.SelectionPredicate{
+				Selector: &selector.Selector{Labels: []selector.Requirement{
+					{Key: "region", Operator: selector.DoubleEqualSignOperator, Values: []string{"us-west-1"}},
+				}},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid selector",
+			path:           "/foo?labelSelector=region%20%3D%3D",
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -78,11 +128,14 @@ func TestList(t *testing.T) {
 				Return(tt.results, tt.controllerErr).
 				Run(func(args mock.Arguments) {
 					pred := args[1].(*store.SelectionPredicate)
-					assert.Equal(t, tt.expectedPred, pred)
+					got := *pred
+					got.Fields = nil
+					assert.Equal(t, tt.expectedPred, &got)
 
 					if tt.continueToken != "" {
 						pred.Continue = tt.continueToken
 					}
+					pred.SelectorApplied = tt.selectorApplied
 				})
 
 			r, err := http.NewRequest("GET", tt.path, nil)
@@ -96,10 +149,11 @@ func TestList(t *testing.T) {
 				func(r corev2.Resource) map[string]string { return map[string]string{} },
 			))
 			router.PathPrefix("/foo").HandlerFunc(List(controller.List,
-				func(r corev2.Resource) map[string]string { return map[string]string{} },
+				func(r corev2.Resource) map[string]string {
+					return map[string]string{"check.name": r.GetObjectMeta().Name}
+				},
 			))
-			middleware := middlewares.Pagination{}
-			router.Use(middleware.Then)
+			router.Use(middlewares.Pagination{}.Then, middlewares.Selector{}.Then)
 			router.ServeHTTP(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
@@ -0,0 +1,61 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// QuotasRouter handles requests for /quotas
+type QuotasRouter struct {
+	handlers   handlers.Handlers
+	quotaStore store.QuotaStore
+}
+
+// NewQuotasRouter instantiates new router for controlling quota resources
+func NewQuotasRouter(store store.ResourceStore, quotaStore store.QuotaStore) *QuotasRouter {
+	return &QuotasRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.Quota{},
+			Store:    store,
+		},
+		quotaStore: quotaStore,
+	}
+}
+
+// Mount the QuotasRouter to a parent Router
+func (r *QuotasRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:quotas}",
+	}
+
+	// The usage must be routed before the quotas, since it would otherwise be
+	// handled as a quota named "usage"
+	routes.Path(corev2.QuotaUsagePath, r.usage).Methods(http.MethodGet)
+
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.QuotaFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:quotas}", corev2.QuotaFields)
+	routes.Patch(r.handlers.PatchResource)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Del(r.handlers.DeleteResource)
+}
+
+func (r *QuotasRouter) usage(req *http.Request) (interface{}, error) {
+	namespace, err := url.PathUnescape(mux.Vars(req)["namespace"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	usage, err := r.quotaStore.GetQuotaUsage(req.Context(), namespace)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return usage, nil
+}
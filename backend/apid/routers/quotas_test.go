@@ -0,0 +1,56 @@
+package routers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQuotasRouter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	router := NewQuotasRouter(s, s)
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	empty := &corev2.Quota{}
+	fixture := corev2.FixtureQuota("foo")
+
+	tests := []routerTestCase{}
+	tests = append(tests, getTestCases(fixture)...)
+	tests = append(tests, listTestCases(empty)...)
+	tests = append(tests, createTestCases(empty)...)
+	tests = append(tests, updateTestCases(fixture)...)
+	tests = append(tests, deleteTestCases(fixture)...)
+	tests = append(tests, []routerTestCase{
+		{
+			name:   "it returns 500 if the usage can't be computed",
+			method: http.MethodGet,
+			path:   "/api/core/v2/namespaces/acme/quotas/usage",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetQuotaUsage", mock.Anything, "acme").
+					Return((*corev2.QuotaUsage)(nil), errors.New("error")).
+					Once()
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:   "it returns the usage of the quotas",
+			method: http.MethodGet,
+			path:   "/api/core/v2/namespaces/acme/quotas/usage",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetQuotaUsage", mock.Anything, "acme").
+					Return(corev2.NewQuotaUsage("acme", nil), nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}...)
+	for _, tt := range tests {
+		run(t, tt, parentRouter, s)
+	}
+}
@@ -119,6 +119,10 @@ func HTTPStatusFromCode(code actions.ErrCode) int {
 		return http.StatusPreconditionFailed
 	case actions.DeadlineExceeded:
 		return http.StatusGatewayTimeout
+	case actions.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case actions.QuotaExceeded:
+		return http.StatusForbidden
 	}
 
 	logger.WithField("code", code).Error("unknown error code")
@@ -0,0 +1,60 @@
+package routers
+
+import (
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+)
+
+// SchemasRouter handles requests for /schemas/v1, the JSON Schemas of the
+// resources and the OpenAPI description of the API.
+type SchemasRouter struct {
+	root   *mux.Router
+	public *mux.Router
+
+	once     sync.Once
+	document *openapi.Document
+	err      error
+}
+
+// NewSchemasRouter instantiates a new router describing the routes of root,
+// the ones of public not requiring authentication.
+func NewSchemasRouter(root, public *mux.Router) *SchemasRouter {
+	return &SchemasRouter{
+		root:   root,
+		public: public,
+	}
+}
+
+// Mount the SchemasRouter to a parent Router
+func (r *SchemasRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/schemas/v1/openapi.json", r.openAPI).Methods(http.MethodGet)
+	parent.HandleFunc("/schemas/v1/{group}/{version}/{type}.json", r.resource).Methods(http.MethodGet)
+}
+
+func (r *SchemasRouter) openAPI(w http.ResponseWriter, req *http.Request) {
+	// The routes are only all mounted once the API has been set up, so the
+	// document is generated on the first request
+	r.once.Do(func() {
+		r.document, r.err = openapi.Generate(r.root, r.public)
+	})
+	if r.err != nil {
+		WriteError(w, r.err)
+		return
+	}
+	RespondWith(w, req, r.document)
+}
+
+func (r *SchemasRouter) resource(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	resource, err := openapi.FindResource(path.Join(vars["group"], vars["version"]), vars["type"])
+	if err != nil {
+		WriteError(w, actions.NewError(actions.NotFound, err))
+		return
+	}
+	RespondWith(w, req, openapi.ResourceSchema(resource))
+}
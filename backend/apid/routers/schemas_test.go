@@ -0,0 +1,65 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSchemasTest(t *testing.T) *httptest.Server {
+	router := mux.NewRouter()
+	public := router.NewRoute().Subrouter()
+	NewSchemasRouter(router, public).Mount(public)
+	core := router.PathPrefix("/api/{group:core}/{version:v2}/").Subrouter()
+	NewAssetRouter(&mockstore.MockStore{}).Mount(core)
+	return httptest.NewServer(router)
+}
+
+func TestSchemasOpenAPI(t *testing.T) {
+	server := newSchemasTest(t)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/schemas/v1/openapi.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var doc openapi.Document
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	assert.Contains(t, doc.Paths, "/schemas/v1/openapi.json")
+	require.Contains(t, doc.Paths, "/api/core/v2/namespaces/{namespace}/assets/{id}")
+	item := doc.Paths["/api/core/v2/namespaces/{namespace}/assets/{id}"]
+	assert.NotNil(t, item.Get)
+	assert.NotNil(t, item.Put)
+	assert.NotNil(t, item.Patch)
+	assert.NotNil(t, item.Delete)
+	assert.Contains(t, doc.Components.Schemas, "core.v2.Asset")
+}
+
+func TestSchemasResource(t *testing.T) {
+	server := newSchemasTest(t)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/schemas/v1/core/v2/CheckConfig.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var schema openapi.Schema
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&schema))
+	assert.Equal(t, "core/v2.CheckConfig", schema.Title)
+	assert.Equal(t, openapi.JSONSchemaDraft, schema.Schema)
+
+	resp, err = http.Get(server.URL + "/schemas/v1/core/v2/Check.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -90,6 +90,12 @@ func (a *Authorizer) VisitRulesFor(ctx context.Context, attrs *authorization.Att
 		return
 	}
 
+	// The quotas of a namespace limit what its RoleBindings grant, so they can
+	// only read them, even through a rule granting all resources
+	if attrs.Resource == corev2.QuotasResource && attrs.Verb != "get" && attrs.Verb != "list" {
+		return
+	}
+
 	roleBindings, err := a.Store.ListRoleBindings(ctx, &store.SelectionPredicate{})
 	if err != nil {
 		if !visitor(nil, empty, err) {
@@ -138,6 +144,15 @@ func (a *Authorizer) Authorize(ctx context.Context, attrs *authorization.Attribu
 		})
 	}
 
+	// A request authenticated with an API key must also be permitted by the
+	// scope of that key, which can only narrow down the permissions of its user
+	if apiKey := corev2.ContextAPIKey(ctx); apiKey != nil && attrs != nil {
+		if !apiKey.Permits(attrs.Namespace, attrs.Resource, attrs.ResourceName, attrs.Verb) {
+			logger.Debug("request forbidden by the api key scope")
+			return false, nil
+		}
+	}
+
 	var (
 		authorized bool
 		visitErr   error
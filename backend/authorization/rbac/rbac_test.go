@@ -302,6 +302,52 @@ func TestAuthorize(t *testing.T) {
 	}
 }
 
+func TestAuthorizeAPIKeyScope(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("ListClusterRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+		Return([]*corev2.ClusterRoleBinding{{
+			RoleRef: corev2.RoleRef{
+				Type: "ClusterRole",
+				Name: "admin",
+			},
+			Subjects: []corev2.Subject{
+				{Type: corev2.UserType, Name: "foo"},
+			},
+		}}, nil)
+	s.On("GetClusterRole", mock.Anything, "admin").
+		Return(&corev2.ClusterRole{Rules: []corev2.Rule{
+			{Verbs: []string{"*"}, Resources: []string{"*"}},
+		}}, nil)
+	a := &Authorizer{Store: s}
+
+	apiKey := corev2.FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "foo")
+	apiKey.Rules = []corev2.Rule{{Verbs: []string{"get", "list"}, Resources: []string{"checks"}}}
+	ctx := context.WithValue(context.Background(), corev2.APIKeyKey, apiKey)
+
+	attrs := &authorization.Attributes{
+		Namespace: "default",
+		Resource:  "checks",
+		Verb:      "list",
+		User:      corev2.User{Username: "foo"},
+	}
+	got, err := a.Authorize(ctx, attrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got {
+		t.Error("expected the request to be authorized by the api key scope")
+	}
+
+	attrs.Verb = "delete"
+	got, err = a.Authorize(ctx, attrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got {
+		t.Error("expected the request to be forbidden by the api key scope")
+	}
+}
+
 func TestMatchesUser(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -495,3 +541,49 @@ func TestVisitRulesFor(t *testing.T) {
 		t.Fatalf("wrong number of rules: got %d, want %d", got, want)
 	}
 }
+
+func TestAuthorizeQuotas(t *testing.T) {
+	tests := []struct {
+		verb string
+		want bool
+	}{
+		{verb: "get", want: true},
+		{verb: "list", want: true},
+		{verb: "create", want: false},
+		{verb: "update", want: false},
+		{verb: "delete", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.verb, func(t *testing.T) {
+			// A namespace admin granting themselves all the resources of
+			// their namespace can only read its quotas
+			s := &mockstore.MockStore{}
+			s.On("ListClusterRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+				Return([]*corev2.ClusterRoleBinding{}, nil)
+			s.On("ListRoleBindings", mock.Anything, &store.SelectionPredicate{}).
+				Return([]*corev2.RoleBinding{{
+					RoleRef:  corev2.RoleRef{Type: "Role", Name: "self"},
+					Subjects: []corev2.Subject{{Type: corev2.UserType, Name: "foo"}},
+				}}, nil)
+			s.On("GetRole", mock.Anything, "self").
+				Return(&corev2.Role{Rules: []corev2.Rule{{
+					Verbs:     []string{corev2.VerbAll},
+					Resources: []string{corev2.ResourceAll},
+				}}}, nil)
+
+			a := &Authorizer{Store: s}
+			got, err := a.Authorize(context.Background(), &authorization.Attributes{
+				Namespace: "acme",
+				User:      corev2.User{Username: "foo"},
+				Verb:      tt.verb,
+				Resource:  corev2.QuotasResource,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Authorize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
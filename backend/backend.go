@@ -25,6 +25,7 @@ import (
 	"github.com/sensu/sensu-go/backend/apid"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/graphql"
+	"github.com/sensu/sensu-go/backend/apid/middlewares"
 	"github.com/sensu/sensu-go/backend/apid/routers"
 	"github.com/sensu/sensu-go/backend/authentication"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
@@ -47,6 +48,7 @@ import (
 	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/schedulerd"
 	"github.com/sensu/sensu-go/backend/secrets"
+	"github.com/sensu/sensu-go/backend/sqlite"
 	"github.com/sensu/sensu-go/backend/store"
 	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
@@ -98,6 +100,7 @@ type Backend struct {
 	Client                 *clientv3.Client
 	Daemons                []daemon.Daemon
 	Etcd                   *etcd.Etcd
+	SQLite                 *sqlite.Server
 	Store                  store.Store
 	StoreV2                storev2.Interface
 	StoreUpdater           StoreUpdater
@@ -178,6 +181,10 @@ func newClient(ctx context.Context, config *Config, backend *Backend) (*clientv3
 		return client, nil
 	}
 
+	if config.Store == StoreSQLite {
+		return newSQLiteClient(ctx, config, backend)
+	}
+
 	// Initialize and start etcd, because we'll need to provide an etcd client to
 	// the Wizard bus, which requires etcd to be started.
 	cfg := etcd.NewConfig()
@@ -240,6 +247,42 @@ func newClient(ctx context.Context, config *Config, backend *Backend) (*clientv3
 	return client, nil
 }
 
+// newSQLiteClient starts the SQLite server in place of the embedded etcd, on
+// the same client URLs, and returns a client of it.
+func newSQLiteClient(ctx context.Context, config *Config, backend *Backend) (*clientv3.Client, error) {
+	cfg := sqlite.NewConfig()
+	cfg.DataDir = config.StateDir
+	cfg.Name = config.EtcdName
+	cfg.ListenClientURLs = config.EtcdListenClientURLs
+	cfg.AdvertiseClientURLs = config.EtcdAdvertiseClientURLs
+	cfg.ClientTLSInfo = config.EtcdClientTLSInfo
+	if config.EtcdMaxRequestBytes != 0 {
+		cfg.MaxRequestBytes = config.EtcdMaxRequestBytes
+	}
+
+	s, err := sqlite.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error starting sqlite: %s", err)
+	}
+
+	backend.SQLite = s
+
+	var client *clientv3.Client
+	if config.EtcdUseEmbeddedClient {
+		client = s.NewEmbeddedClientWithContext(ctx)
+	} else {
+		cl, err := s.NewClientContext(backend.runCtx)
+		if err != nil {
+			return nil, err
+		}
+		client = cl
+	}
+	if _, err := client.Get(ctx, "/sensu.io"); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
 // Initialize instantiates a Backend struct with the provided config, by
 // configuring etcd and establishing a list of daemons, which constitute our
 // backend. The daemons will later be started according to their position in the
@@ -342,9 +385,12 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 
 	// Initialize PipelineAdapterV1
 	storeTimeout := 2 * time.Minute
+	handlerRetryQueue := queue.NewHandlerRetryQueue(b.Client)
 	b.PipelineAdapterV1 = pipeline.AdapterV1{
-		Store:        b.Store,
-		StoreTimeout: storeTimeout,
+		Store:               b.Store,
+		StoreTimeout:        storeTimeout,
+		HandlerExecutionTTL: b.Cfg.HandlerExecutionTTL,
+		RetryQueue:          handlerRetryQueue,
 	}
 
 	// Initialize PipelineAdapterV1 filter adapters
@@ -404,6 +450,7 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		eventd.Config{
 			Store:               b.StoreV2,
 			EventStore:          b.Store,
+			QuotaStore:          b.Store,
 			Bus:                 bus,
 			LivenessFactory:     liveness.EtcdFactory(b.RunContext(), b.Client),
 			Client:              b.Client,
@@ -414,6 +461,7 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 			LogBufferSize:       b.Cfg.EventLogBufferSize,
 			LogBufferWait:       b.Cfg.EventLogBufferWait,
 			LogParallelEncoders: b.Cfg.EventLogParallelEncoders,
+			LogSinks:            b.Cfg.EventLogSinks,
 		},
 	)
 	if err != nil {
@@ -465,6 +513,7 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		WriteTimeout:        config.AgentWriteTimeout,
 		Client:              b.Client,
 		Watcher:             entityConfigWatcher,
+		CheckWatcher:        b.Store.GetCheckConfigWatcher(b.ctx),
 		EtcdClientTLSConfig: b.EtcdClientTLSConfig,
 	})
 	if err != nil {
@@ -474,16 +523,17 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 
 	// Initialize keepalived
 	keepalive, err := keepalived.New(keepalived.Config{
-		DeregistrationHandler: config.DeregistrationHandler,
-		Bus:                   bus,
-		Store:                 b.Store,
-		StoreV2:               b.StoreV2,
-		EventStore:            b.Store,
-		LivenessFactory:       liveness.EtcdFactory(b.RunContext(), b.Client),
-		RingPool:              b.RingPool,
-		BufferSize:            viper.GetInt(FlagKeepalivedBufferSize),
-		WorkerCount:           viper.GetInt(FlagKeepalivedWorkers),
-		StoreTimeout:          2 * time.Minute,
+		DeregistrationHandler:  config.DeregistrationHandler,
+		DeregistrationPolicies: config.DeregistrationPolicies,
+		Bus:                    bus,
+		Store:                  b.Store,
+		StoreV2:                b.StoreV2,
+		EventStore:             b.Store,
+		LivenessFactory:        liveness.EtcdFactory(b.RunContext(), b.Client),
+		RingPool:               b.RingPool,
+		BufferSize:             viper.GetInt(FlagKeepalivedBufferSize),
+		WorkerCount:            viper.GetInt(FlagKeepalivedWorkers),
+		StoreTimeout:           2 * time.Minute,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing %s: %s", keepalive.Name(), err)
@@ -500,7 +550,7 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 
 	var clusterVersion string
 	// only retrieve the cluster version if etcd is embedded
-	if !config.NoEmbedEtcd {
+	if b.Etcd != nil {
 		clusterVersion = b.Etcd.GetClusterVersion()
 	}
 
@@ -552,6 +602,13 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		ClusterVersion:      clusterVersion,
 		GraphQLService:      b.GraphQLService,
 		HealthRouter:        b.HealthRouter,
+		HandlerFailures:     handlerRetryQueue,
+		RateLimiter: middlewares.NewRateLimiter(middlewares.RateLimitConfig{
+			ListRate:   config.APIListRateLimit,
+			ListBurst:  config.APIListBurstLimit,
+			WriteRate:  config.APIWriteRateLimit,
+			WriteBurst: config.APIWriteBurstLimit,
+		}),
 	}
 	api, err := apid.New(b.APIDConfig)
 	if err != nil {
@@ -604,6 +661,15 @@ func (b *Backend) runOnce() error {
 		}()
 	}
 
+	if b.SQLite != nil {
+		defer func() {
+			logger.Info("shutting down sqlite")
+			if err := b.SQLite.Shutdown(); derr == nil {
+				derr = err
+			}
+		}()
+	}
+
 	sg := stopGroup{}
 
 	// Loop across the daemons in order to start them, then add them to our groups
@@ -663,6 +729,9 @@ func (b *Backend) runOnce() error {
 		// Add etcd to our errGroup, since it's not included in the daemon list
 		eg.daemons = append(eg.daemons, b.Etcd)
 	}
+	if b.SQLite != nil {
+		eg.daemons = append(eg.daemons, b.SQLite)
+	}
 
 	errCtx, errCancel := context.WithCancel(b.RunContext())
 	defer errCancel()
@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sensu/sensu-go/backend/etcd"
+	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.etcd.io/etcd/client/pkg/v3/transport"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	flagReplace = "replace"
+)
+
+// BackupCommand returns the command taking a backup of the Sensu data.
+func BackupCommand() *cobra.Command {
+	var setupErr error
+	cmd := &cobra.Command{
+		Use:           "backup FILE",
+		Short:         "back up the sensu data to a file",
+		Args:          cobra.ExactArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			if setupErr != nil {
+				return setupErr
+			}
+
+			client, err := newEtcdClient()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			// Write to a temporary file first, so an interrupted backup never
+			// leaves a truncated file behind under the requested name
+			tmp := args[0] + ".tmp"
+			f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+			if err != nil {
+				return err
+			}
+			header, err := etcdstore.Backup(context.Background(), client, f)
+			if err == nil {
+				err = f.Sync()
+			}
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(tmp)
+				return fmt.Errorf("error backing up sensu data: %s", err)
+			}
+			if err := os.Rename(tmp, args[0]); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "backed up revision %d of database version %d to %s\n",
+				header.Revision, header.DatabaseVersion, args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTimeout, defaultTimeout, "duration to wait before a connection attempt to etcd is considered failed (must be >= 1s)")
+
+	setupErr = handleConfig(cmd, os.Args[1:], false)
+
+	return cmd
+}
+
+// RestoreCommand returns the command restoring a backup of the Sensu data.
+func RestoreCommand() *cobra.Command {
+	var setupErr error
+	cmd := &cobra.Command{
+		Use:   "restore FILE",
+		Short: "restore the sensu data from a backup",
+		Long: "Restore the sensu data from a backup taken with the backup command. " +
+			"The backup is verified before anything is written, and the database " +
+			"is then migrated if the backup comes from an older version of Sensu. " +
+			"The sensu backends must be stopped while restoring. Restoring is not " +
+			"atomic: if it fails after the existing data was replaced, run it " +
+			"again with --replace.",
+		Args:          cobra.ExactArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			if setupErr != nil {
+				return setupErr
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			// Verify the backup before connecting to etcd, to fail early
+			header, err := etcdstore.VerifyBackup(f)
+			if err != nil {
+				return err
+			}
+			if _, err := f.Seek(0, 0); err != nil {
+				return err
+			}
+
+			replace := viper.GetBool(flagReplace)
+			if replace && !viper.GetBool(flagSkipConfirm) {
+				var confirm bool
+				prompt := &survey.Confirm{
+					Message: fmt.Sprintf("Do you really want to replace all the sensu data with the backup taken at %s? This operation cannot be undone!", header.CreatedAt.Format(time.RFC3339)),
+				}
+				if err := survey.AskOne(prompt, &confirm, nil); err != nil {
+					return err
+				}
+				if !confirm {
+					return errors.New("restore aborted by operator")
+				}
+			}
+
+			client, err := newEtcdClient()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			opts := etcdstore.RestoreOptions{
+				Replace:         replace,
+				MaxRequestBytes: int(viper.GetUint(flagEtcdMaxRequestBytes)),
+			}
+			if _, err := etcdstore.Restore(context.Background(), client, f, opts); err != nil {
+				if err == etcdstore.ErrStoreNotEmpty {
+					return fmt.Errorf("%s, use --%s to replace it", err, flagReplace)
+				}
+				if errors.Is(err, etcdstore.ErrRestoreIncomplete) {
+					return fmt.Errorf("error restoring backup: %s; the sensu data is incomplete, run the restore again with --%s", err, flagReplace)
+				}
+				return fmt.Errorf("error restoring backup: %s", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "restored revision %d of database version %d from %s\n",
+				header.Revision, header.DatabaseVersion, args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTimeout, defaultTimeout, "duration to wait before a connection attempt to etcd is considered failed (must be >= 1s)")
+	cmd.Flags().Bool(flagReplace, false, "delete the existing sensu data before restoring the backup")
+	cmd.Flags().Bool(flagSkipConfirm, false, "skip interactive confirmation")
+
+	setupErr = handleConfig(cmd, os.Args[1:], false)
+
+	return cmd
+}
+
+// newEtcdClient connects to the etcd cluster described by the configuration,
+// once at least one of its endpoints is reachable.
+func newEtcdClient() (*clientv3.Client, error) {
+	tlsInfo := (transport.TLSInfo)(etcd.TLSInfo{
+		CertFile:       viper.GetString(flagEtcdCertFile),
+		KeyFile:        viper.GetString(flagEtcdKeyFile),
+		TrustedCAFile:  viper.GetString(flagEtcdTrustedCAFile),
+		ClientCertAuth: viper.GetBool(flagEtcdClientCertAuth),
+	})
+	tlsConfig, err := tlsInfo.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := viper.GetDuration(flagTimeout)
+	if timeout < 1*time.Second {
+		timeout = timeout * time.Second
+	}
+
+	clientURLs := fallbackStringSlice(flagEtcdClientURLs, flagEtcdAdvertiseClientURLs)
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   clientURLs,
+		DialTimeout: timeout,
+		TLS:         tlsConfig,
+		Username:    viper.GetString(envEtcdClientUsername),
+		Password:    viper.GetString(envEtcdClientPassword),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to cluster: %s", err)
+	}
+
+	for _, url := range clientURLs {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		_, err = client.Status(ctx, url)
+		cancel()
+		if err == nil {
+			return client, nil
+		}
+	}
+	_ = client.Close()
+	return nil, fmt.Errorf("no etcd endpoints are available: %s", err)
+}
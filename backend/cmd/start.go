@@ -49,6 +49,10 @@ const (
 	flagAPIListenAddress      = "api-listen-address"
 	flagAPIRequestLimit       = "api-request-limit"
 	flagAPIURL                = "api-url"
+	flagAPIListRateLimit      = "api-list-rate-limit"
+	flagAPIListBurstLimit     = "api-list-burst-limit"
+	flagAPIWriteRateLimit     = "api-write-rate-limit"
+	flagAPIWriteBurstLimit    = "api-write-burst-limit"
 	flagAssetsRateLimit       = "assets-rate-limit"
 	flagAssetsBurstLimit      = "assets-burst-limit"
 	flagDashboardHost         = "dashboard-host"
@@ -79,6 +83,7 @@ const (
 	flagEtcdInitialClusterToken      = "etcd-initial-cluster-token"
 	flagEtcdNodeName                 = "etcd-name"
 	flagNoEmbedEtcd                  = "no-embed-etcd"
+	flagStore                        = "store"
 	flagEtcdAdvertiseClientURLs      = "etcd-advertise-client-urls"
 	flagEtcdHeartbeatInterval        = "etcd-heartbeat-interval"
 	flagEtcdElectionTimeout          = "etcd-election-timeout"
@@ -118,6 +123,17 @@ const (
 	// flagEventLogParallelEncoders used to indicate parallel encoders should be used for event logging
 	flagEventLogParallelEncoders = "event-log-parallel-encoders"
 
+	// flagHandlerExecutionTTL indicates how long handler executions are recorded for
+	flagHandlerExecutionTTL = "handler-execution-ttl"
+
+	// configEventLogSinks is the configuration file key of the additional
+	// event log sinks. It has no flag counterpart.
+	configEventLogSinks = "event-log-sinks"
+
+	// configDeregistrationPolicies is the configuration file key of the
+	// keepalive deregistration policies. It has no flag counterpart.
+	configDeregistrationPolicies = "keepalive-deregistration-policies"
+
 	// Default values
 
 	// defaultEtcdClientURL is the default URL to listen for Etcd clients
@@ -220,6 +236,10 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
 				APIListenAddress:      viper.GetString(flagAPIListenAddress),
 				APIRequestLimit:       viper.GetInt64(flagAPIRequestLimit),
 				APIURL:                viper.GetString(flagAPIURL),
+				APIListRateLimit:      rate.Limit(viper.GetFloat64(flagAPIListRateLimit)),
+				APIListBurstLimit:     viper.GetInt(flagAPIListBurstLimit),
+				APIWriteRateLimit:     rate.Limit(viper.GetFloat64(flagAPIWriteRateLimit)),
+				APIWriteBurstLimit:    viper.GetInt(flagAPIWriteBurstLimit),
 				AssetsRateLimit:       rate.Limit(viper.GetFloat64(flagAssetsRateLimit)),
 				AssetsBurstLimit:      viper.GetInt(flagAssetsBurstLimit),
 				DashboardHost:         viper.GetString(flagDashboardHost),
@@ -250,6 +270,7 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
         EtcdClientUsername:             viper.GetString(envEtcdClientUsername),
 				EtcdClientPassword:             viper.GetString(envEtcdClientPassword),
 				NoEmbedEtcd:                    viper.GetBool(flagNoEmbedEtcd),
+				Store:                          viper.GetString(flagStore),
 				Labels:                         viper.GetStringMapString(flagLabels),
 				Annotations:                    viper.GetStringMapString(flagAnnotations),
 				DisablePlatformMetrics:         viper.GetBool(flagDisablePlatformMetrics),
@@ -259,6 +280,25 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
 				EventLogBufferWait:             viper.GetDuration(flagEventLogBufferWait),
 				EventLogFile:                   viper.GetString(flagEventLogFile),
 				EventLogParallelEncoders:       viper.GetBool(flagEventLogParallelEncoders),
+				HandlerExecutionTTL:            viper.GetDuration(flagHandlerExecutionTTL),
+			}
+
+			if err := viper.UnmarshalKey(configEventLogSinks, &cfg.EventLogSinks); err != nil {
+				return fmt.Errorf("error parsing %s: %s", configEventLogSinks, err)
+			}
+			for i := range cfg.EventLogSinks {
+				if err := cfg.EventLogSinks[i].Validate(); err != nil {
+					return fmt.Errorf("invalid %s: %s", configEventLogSinks, err)
+				}
+			}
+
+			if err := viper.UnmarshalKey(configDeregistrationPolicies, &cfg.DeregistrationPolicies); err != nil {
+				return fmt.Errorf("error parsing %s: %s", configDeregistrationPolicies, err)
+			}
+			for i := range cfg.DeregistrationPolicies {
+				if err := cfg.DeregistrationPolicies[i].Validate(); err != nil {
+					return fmt.Errorf("invalid %s: %s", configDeregistrationPolicies, err)
+				}
 			}
 
 			if flag := cmd.Flags().Lookup(flagLabels); flag != nil && flag.Changed {
@@ -289,6 +329,16 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
 					flagCertFile, flagKeyFile)
 			}
 
+			switch cfg.Store {
+			case backend.StoreEtcd:
+			case backend.StoreSQLite:
+				if cfg.NoEmbedEtcd {
+					return fmt.Errorf("--%s %s cannot be used with --%s", flagStore, cfg.Store, flagNoEmbedEtcd)
+				}
+			default:
+				return fmt.Errorf("invalid --%s %q, must be %q or %q", flagStore, cfg.Store, backend.StoreEtcd, backend.StoreSQLite)
+			}
+
 			if cf, kf := len(cfg.DashboardTLSCertFile) == 0, len(cfg.DashboardTLSKeyFile) == 0; cf != kf {
 				return fmt.Errorf(
 					"dashboard tls configuration error, both flags --%s and --%s are required",
@@ -387,6 +437,10 @@ func handleConfig(cmd *cobra.Command, arguments []string, server bool) error {
 		viper.SetDefault(flagAPIListenAddress, "[::]:8080")
 		viper.SetDefault(flagAPIRequestLimit, middlewares.MaxBytesLimit)
 		viper.SetDefault(flagAPIURL, "http://localhost:8080")
+		viper.SetDefault(flagAPIListRateLimit, 0)
+		viper.SetDefault(flagAPIListBurstLimit, 10)
+		viper.SetDefault(flagAPIWriteRateLimit, 0)
+		viper.SetDefault(flagAPIWriteBurstLimit, 10)
 		viper.SetDefault(flagAssetsRateLimit, asset.DefaultAssetsRateLimit)
 		viper.SetDefault(flagAssetsBurstLimit, asset.DefaultAssetsBurstLimit)
 		viper.SetDefault(flagDashboardHost, "[::]")
@@ -415,6 +469,7 @@ func handleConfig(cmd *cobra.Command, arguments []string, server bool) error {
 		viper.SetDefault(flagEventLogBufferSize, 100000)
 		viper.SetDefault(flagEventLogFile, "")
 		viper.SetDefault(flagEventLogParallelEncoders, false)
+		viper.SetDefault(flagHandlerExecutionTTL, 24*time.Hour)
 	}
 
 	// Etcd defaults
@@ -435,6 +490,7 @@ func handleConfig(cmd *cobra.Command, arguments []string, server bool) error {
 
 	if server {
 		viper.SetDefault(flagNoEmbedEtcd, false)
+		viper.SetDefault(flagStore, backend.StoreEtcd)
 	}
 
 	// Merge in flag set so that it appears in command usage
@@ -512,6 +568,10 @@ func flagSet(server bool) *pflag.FlagSet {
 		flagSet.String(flagAPIListenAddress, viper.GetString(flagAPIListenAddress), "address to listen on for api traffic")
 		flagSet.Int64(flagAPIRequestLimit, viper.GetInt64(flagAPIRequestLimit), "maximum API request body size, in bytes")
 		flagSet.String(flagAPIURL, viper.GetString(flagAPIURL), "url of the api to connect to")
+		flagSet.Float64(flagAPIListRateLimit, viper.GetFloat64(flagAPIListRateLimit), "maximum number of API list requests per second per user or api key (0 to disable)")
+		flagSet.Int(flagAPIListBurstLimit, viper.GetInt(flagAPIListBurstLimit), "API list requests burst limit per user or api key")
+		flagSet.Float64(flagAPIWriteRateLimit, viper.GetFloat64(flagAPIWriteRateLimit), "maximum number of API write requests per second per user or api key (0 to disable)")
+		flagSet.Int(flagAPIWriteBurstLimit, viper.GetInt(flagAPIWriteBurstLimit), "API write requests burst limit per user or api key")
 		flagSet.Float64(flagAssetsRateLimit, viper.GetFloat64(flagAssetsRateLimit), "maximum number of assets fetched per second")
 		flagSet.Int(flagAssetsBurstLimit, viper.GetInt(flagAssetsBurstLimit), "asset fetch burst limit")
 		flagSet.String(flagDashboardHost, viper.GetString(flagDashboardHost), "dashboard listener host")
@@ -562,6 +622,8 @@ func flagSet(server bool) *pflag.FlagSet {
 		_ = flagSet.SetAnnotation(flagEtcdListenClientURLs, "categories", []string{"store"})
 		flagSet.Bool(flagNoEmbedEtcd, viper.GetBool(flagNoEmbedEtcd), "don't embed etcd, use external etcd instead")
 		_ = flagSet.SetAnnotation(flagNoEmbedEtcd, "categories", []string{"store"})
+		flagSet.String(flagStore, viper.GetString(flagStore), "backend datastore, \"etcd\" or \"sqlite\" (sqlite only supports a single backend)")
+		_ = flagSet.SetAnnotation(flagStore, "categories", []string{"store"})
 		flagSet.Int64(flagEtcdQuotaBackendBytes, viper.GetInt64(flagEtcdQuotaBackendBytes), "maximum etcd database size in bytes (use with caution)")
 		_ = flagSet.SetAnnotation(flagEtcdQuotaBackendBytes, "categories", []string{"store"})
 		flagSet.Uint(flagEtcdHeartbeatInterval, viper.GetUint(flagEtcdHeartbeatInterval), "interval in ms with which the etcd leader will notify followers that it is still the leader")
@@ -597,6 +659,8 @@ func flagSet(server bool) *pflag.FlagSet {
 		// event back-pressure could stop the backend and its agent sessions from
 		// producing and processing new events and possibly lead to a crash.
 		_ = flagSet.String(flagEventLogBufferWait, "10ms", "full buffer wait time")
+
+		_ = flagSet.Duration(flagHandlerExecutionTTL, 24*time.Hour, "amount of time handler executions are recorded for (0 to disable)")
 	}
 
 	flagSet.SetOutput(ioutil.Discard)
@@ -5,6 +5,8 @@ import (
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/backend/eventd"
+	"github.com/sensu/sensu-go/backend/keepalived"
 	"github.com/sensu/sensu-go/backend/licensing"
 	"golang.org/x/time/rate"
 )
@@ -19,6 +21,13 @@ const (
 	// DefaultEtcdPeerURL is the default URL to listen for Etcd peers (single-node cluster only)
 	DefaultEtcdPeerURL = "http://127.0.0.1:2380"
 
+	// StoreEtcd is the default datastore, an embedded or external etcd
+	StoreEtcd = "etcd"
+
+	// StoreSQLite is the datastore of a single backend, a SQLite database
+	// served through the etcd API on the etcd client URLs
+	StoreSQLite = "sqlite"
+
 	// FlagEventdWorkers defines the number of workers for eventd
 	FlagEventdWorkers = "eventd-workers"
 	// FlagEventdBufferSize defines the buffer size for eventd
@@ -61,6 +70,23 @@ type Config struct {
 	APIRequestLimit  int64
 	APIURL           string
 
+	// APIListRateLimit is the maximum number of list requests per second
+	// allowed per user or API key. Zero disables the limit.
+	APIListRateLimit rate.Limit
+
+	// APIListBurstLimit is the maximum amount of list requests a user or API
+	// key can burst.
+	APIListBurstLimit int
+
+	// APIWriteRateLimit is the maximum number of create, update and delete
+	// requests per second allowed per user or API key. Zero disables the
+	// limit.
+	APIWriteRateLimit rate.Limit
+
+	// APIWriteBurstLimit is the maximum amount of create, update and delete
+	// requests a user or API key can burst.
+	APIWriteBurstLimit int
+
 	// AssetsRateLimit is the maximum number of assets per second that will be fetched.
 	AssetsRateLimit rate.Limit
 
@@ -93,6 +119,7 @@ type Config struct {
 	EtcdListenPeerURLs           []string
 	EtcdName                     string
 	NoEmbedEtcd                  bool
+	Store                        string
 	EtcdHeartbeatInterval        uint
 	EtcdElectionTimeout          uint
 	EtcdDiscovery                string
@@ -123,4 +150,16 @@ type Config struct {
 	EventLogBufferWait       time.Duration
 	EventLogFile             string
 	EventLogParallelEncoders bool
+
+	// HandlerExecutionTTL is the amount of time handler executions are
+	// recorded for. Handler executions are not recorded if it is 0.
+	HandlerExecutionTTL time.Duration
+
+	// EventLogSinks configures additional event log sinks (syslog, tcp or
+	// http). They can only be set in the configuration file.
+	EventLogSinks []eventd.LogSinkConfig
+
+	// DeregistrationPolicies deregister the entities whose keepalive has been
+	// failing for too long. They can only be set in the configuration file.
+	DeregistrationPolicies []keepalived.DeregistrationPolicy
 }
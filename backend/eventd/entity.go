@@ -10,8 +10,9 @@ import (
 )
 
 // createProxyEntity creates a proxy entity for the given event if the entity
-// does not exist already and returns the entity created
-func createProxyEntity(event *corev2.Event, s storev2.Interface) error {
+// does not exist already and returns the entity created. New proxy entities
+// are limited by the quotas of their namespace when quotas is set.
+func createProxyEntity(event *corev2.Event, s storev2.Interface, quotas store.QuotaStore) error {
 	entityName := event.Entity.Name
 	namespace := event.Entity.Namespace
 
@@ -59,6 +60,12 @@ func createProxyEntity(event *corev2.Event, s storev2.Interface) error {
 	} else if err != nil {
 		switch err.(type) {
 		case *store.ErrNotFound:
+			if quotas != nil {
+				if err := quotas.CheckQuota(context.Background(), namespace, corev2.EntitiesResource); err != nil {
+					return err
+				}
+			}
+
 			// If the entity does not exist, create a proxy entity
 			if event.Check.ProxyEntityName != "" {
 				// Create a brand new entity since we can't rely on the provided
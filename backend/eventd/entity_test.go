@@ -13,6 +13,7 @@ import (
 	"github.com/sensu/sensu-go/backend/store"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
 	"github.com/sensu/sensu-go/backend/store/v2/storetest"
+	"github.com/sensu/sensu-go/testing/mockstore"
 )
 
 func TestCreateProxyEntity(t *testing.T) {
@@ -25,6 +26,7 @@ func TestCreateProxyEntity(t *testing.T) {
 		storeFunc      storeFunc
 		wantEntityName string
 		wantEntity     *corev2.Entity
+		quotaErr       error
 		wantErr        bool
 	}{
 		{
@@ -226,6 +228,29 @@ func TestCreateProxyEntity(t *testing.T) {
 			wantEntityName: "bar",
 			wantErr:        true,
 		},
+		{
+			// We receive an event for a new proxy entity "bar" but the quota of
+			// entities of the namespace is reached.
+			//
+			// We expect the quota error to be returned and nothing to be created.
+			name: "quota exceeded while creating new proxy entity",
+			event: &corev2.Event{
+				Check: &corev2.Check{
+					ProxyEntityName: "bar",
+				},
+				Entity: corev2.FixtureEntity("foo"),
+			},
+			storeFunc: func(s *storetest.Store, e *corev2.Event) {
+				config := corev3.FixtureEntityConfig("bar")
+				configReq := storev2.NewResourceRequestFromResource(context.Background(), config)
+
+				s.On("Get", configReq).
+					Return(nilWrapper, &store.ErrNotFound{})
+			},
+			quotaErr:       &store.ErrQuotaExceeded{Namespace: "default", Reason: "at most 1 entities are allowed"},
+			wantEntityName: "foo",
+			wantErr:        true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -234,8 +259,10 @@ func TestCreateProxyEntity(t *testing.T) {
 				tt.storeFunc(store, tt.event)
 			}
 			defer store.AssertExpectations(t)
+			quotas := &mockstore.MockStore{}
+			quotas.On("CheckQuota", mock.Anything, "default", "entities").Return(tt.quotaErr)
 
-			if err := createProxyEntity(tt.event, store); (err != nil) != tt.wantErr {
+			if err := createProxyEntity(tt.event, store, quotas); (err != nil) != tt.wantErr {
 				t.Errorf("createProxyEntity() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
@@ -117,6 +117,7 @@ type Eventd struct {
 	cancel              context.CancelFunc
 	store               storev2.Interface
 	eventStore          store.EventStore
+	quotaStore          store.QuotaStore
 	bus                 messaging.MessageBus
 	workerCount         int
 	livenessFactory     liveness.Factory
@@ -134,6 +135,7 @@ type Eventd struct {
 	logBufferSize       int
 	logBufferWait       time.Duration
 	logParallelEncoders bool
+	logSinks            []LogSinkConfig
 }
 
 // Cache interfaces the cache.Resource struct for easier testing
@@ -148,6 +150,7 @@ type Option func(*Eventd) error
 type Config struct {
 	Store               storev2.Interface
 	EventStore          store.EventStore
+	QuotaStore          store.QuotaStore
 	Bus                 messaging.MessageBus
 	LivenessFactory     liveness.Factory
 	Client              *clientv3.Client
@@ -158,6 +161,7 @@ type Config struct {
 	LogBufferSize       int
 	LogBufferWait       time.Duration
 	LogParallelEncoders bool
+	LogSinks            []LogSinkConfig
 }
 
 // New creates a new Eventd.
@@ -178,6 +182,7 @@ func New(ctx context.Context, c Config, opts ...Option) (*Eventd, error) {
 	e := &Eventd{
 		store:               c.Store,
 		eventStore:          c.EventStore,
+		quotaStore:          c.QuotaStore,
 		bus:                 c.Bus,
 		workerCount:         c.WorkerCount,
 		livenessFactory:     c.LivenessFactory,
@@ -192,6 +197,7 @@ func New(ctx context.Context, c Config, opts ...Option) (*Eventd, error) {
 		logBufferSize:       c.LogBufferSize,
 		logBufferWait:       c.LogBufferWait,
 		logParallelEncoders: c.LogParallelEncoders,
+		logSinks:            c.LogSinks,
 		Logger:              NoopLogger{},
 	}
 
@@ -232,7 +238,8 @@ func (e *Eventd) Start() error {
 		return err
 	}
 
-	// Start the event logger if configured
+	// Start the event loggers if configured
+	var loggers MultiLogger
 	if e.logPath != "" {
 		logger := FileLogger{
 			Path:                 e.logPath,
@@ -243,7 +250,22 @@ func (e *Eventd) Start() error {
 		}
 		logger.Start()
 
-		e.Logger = &logger
+		loggers = append(loggers, &logger)
+	}
+	for _, cfg := range e.logSinks {
+		logger := &SinkLogger{Config: cfg}
+		if err := logger.Start(); err != nil {
+			loggers.Stop()
+			return fmt.Errorf("error starting event log sink %q: %s", cfg.name(), err)
+		}
+		loggers = append(loggers, logger)
+	}
+	switch len(loggers) {
+	case 0:
+	case 1:
+		e.Logger = loggers[0]
+	default:
+		e.Logger = loggers
 	}
 
 	e.startHandlers()
@@ -381,7 +403,7 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 
 	// Create a proxy entity if required and update the event's entity with it,
 	// but only if the event's entity is not an agent.
-	if err := createProxyEntity(event, e.store); err != nil {
+	if err := createProxyEntity(event, e.store, e.quotaStore); err != nil {
 		EventsProcessed.WithLabelValues(EventsProcessedLabelError, EventsProcessedTypeLabelCheck).Inc()
 		return err
 	}
@@ -105,8 +105,14 @@ type rawLogger struct {
 	output       chan []byte
 	writer       LogWriter
 	wait         time.Duration
+	policy       string
+	sink         string
 	metrics      *metrics
 	done         chan interface{}
+
+	// batched is true when the writer only knows whether the events were
+	// written once their batch is sent, and reports it with reportBatch
+	batched bool
 }
 
 // newRawLogger initializes the raw event logger
@@ -126,6 +132,7 @@ func newRawLogger(path string, bufferSize int, bufferWait time.Duration, sighup
 	}
 
 	l.writer = writer
+	l.sink = LogSinkFile
 
 	return l, nil
 }
@@ -168,18 +175,33 @@ func (l *rawLogger) ringBuffer() {
 		select {
 		case l.encoderInput <- v:
 		case <-time.After(l.wait):
+			if l.policy == BufferFullBlock {
+				l.encoderInput <- v
+				continue
+			}
 			dropped := false
-			select {
-			case <-l.encoderInput:
-				dropped = true
-			case l.encoderInput <- v:
+			if l.policy == BufferFullDropNewest {
+				select {
+				case l.encoderInput <- v:
+				default:
+					dropped = true
+				}
+			} else {
+				select {
+				case <-l.encoderInput:
+					dropped = true
+				case l.encoderInput <- v:
+				}
+				if dropped {
+					l.encoderInput <- v
+				}
 			}
 			if dropped {
 				// Increment the eventsDropped counter
 				mu.Lock()
 				eventsDropped++
 				mu.Unlock()
-				l.encoderInput <- v
+				eventLogEvents.WithLabelValues(l.sinkName(), EventLogEventsLabelDropped).Inc()
 			}
 		}
 	}
@@ -223,10 +245,14 @@ func (l *rawLogger) write() {
 			}
 
 			if _, err := l.writer.Write(b); err != nil {
-				logger.WithError(err).Warning("could not write event")
+				logger.WithError(err).WithField("sink", l.sinkName()).Warning("could not write event")
+				eventLogEvents.WithLabelValues(l.sinkName(), EventLogEventsLabelFailed).Inc()
 				continue
 			}
-			l.metrics.Accumulate(1, len(b))
+			if !l.batched {
+				l.metrics.Accumulate(1, len(b))
+				eventLogEvents.WithLabelValues(l.sinkName(), EventLogEventsLabelWritten).Inc()
+			}
 		case <-ticker.C:
 			if err := l.writer.Sync(); err != nil {
 				logger.WithError(err).Error("error syncing event log")
@@ -235,6 +261,26 @@ func (l *rawLogger) write() {
 	}
 }
 
+// reportBatch counts the events of a batch sent by the writer as written, or
+// failed if it could not be sent
+func (l *rawLogger) reportBatch(result logging.BatchResult) {
+	if result.Err != nil {
+		eventLogEvents.WithLabelValues(l.sinkName(), EventLogEventsLabelFailed).Add(float64(result.Messages))
+		return
+	}
+	l.metrics.Accumulate(result.Messages, result.Bytes)
+	eventLogEvents.WithLabelValues(l.sinkName(), EventLogEventsLabelWritten).Add(float64(result.Messages))
+}
+
+// sinkName returns the name of the sink the logger writes to, used to label
+// its metrics
+func (l *rawLogger) sinkName() string {
+	if l.sink == "" {
+		return LogSinkFile
+	}
+	return l.sink
+}
+
 func (l *rawLogger) metricsWriter() {
 	ticker := time.NewTicker(time.Minute * 1)
 	defer ticker.Stop()
@@ -248,6 +294,7 @@ func (l *rawLogger) metricsWriter() {
 			metrics := l.metrics.computeMetrics()
 
 			logger.WithFields(logrus.Fields{
+				"sink":     l.sinkName(),
 				"count":    metrics.count,
 				"bytes":    metrics.totalBytes,
 				"rate":     fmt.Sprintf("%.2f", metrics.rate),
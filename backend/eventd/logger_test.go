@@ -245,6 +245,7 @@ func TestRawLogger_ringBuffer(t *testing.T) {
 		encoderInput chan interface{}
 		output       chan []byte
 		writer       LogWriter
+		policy       string
 		want         interface{}
 		wantLog      bool
 	}{
@@ -265,6 +266,16 @@ func TestRawLogger_ringBuffer(t *testing.T) {
 			want:         []interface{}{1, 2, 3, 4},
 			wantLog:      true,
 		},
+		{
+			name:         "newer messages are dropped when over the buffer size with the drop-newest policy",
+			input:        make(chan interface{}),
+			encoderInput: make(chan interface{}, 4),
+			output:       make(chan []byte, 4),
+			writer:       &nilWriter{},
+			policy:       BufferFullDropNewest,
+			want:         []interface{}{0, 1, 2, 3},
+			wantLog:      true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -278,6 +289,7 @@ func TestRawLogger_ringBuffer(t *testing.T) {
 				output:       tt.output,
 				writer:       tt.writer,
 				wait:         wt,
+				policy:       tt.policy,
 				metrics:      newMetrics(),
 				done:         make(chan interface{}),
 			}
@@ -0,0 +1,275 @@
+package eventd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/logging"
+)
+
+const (
+	// LogSinkFile is the type of the event log file sink.
+	LogSinkFile = "file"
+
+	// LogSinkSyslog is the type of the RFC5424 syslog sink.
+	LogSinkSyslog = "syslog"
+
+	// LogSinkTCP is the type of the newline-delimited JSON over TCP sink.
+	LogSinkTCP = "tcp"
+
+	// LogSinkHTTP is the type of the HTTP batch sink.
+	LogSinkHTTP = "http"
+
+	// BufferFullDropOldest discards the oldest buffered event to make room
+	// for a new one when the buffer of a sink is full. It is the default.
+	BufferFullDropOldest = "drop-oldest"
+
+	// BufferFullDropNewest discards the new event when the buffer of a sink
+	// is full.
+	BufferFullDropNewest = "drop-newest"
+
+	// BufferFullBlock waits for room in the buffer of a sink, applying
+	// back-pressure on eventd.
+	BufferFullBlock = "block"
+
+	// EventLogEventsCounterVec is the name of the prometheus counter vec used
+	// to count the events handled by the event log sinks.
+	EventLogEventsCounterVec = "sensu_go_event_log_events"
+
+	// EventLogEventsLabelWritten is the value of the status label for events
+	// written to a sink.
+	EventLogEventsLabelWritten = "written"
+
+	// EventLogEventsLabelDropped is the value of the status label for events
+	// dropped because the buffer of a sink was full.
+	EventLogEventsLabelDropped = "dropped"
+
+	// EventLogEventsLabelFailed is the value of the status label for events
+	// that could not be written to a sink.
+	EventLogEventsLabelFailed = "failed"
+
+	defaultLogSinkBufferSize = 100000
+	defaultLogSinkBufferWait = 10 * time.Millisecond
+)
+
+var eventLogEvents = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: EventLogEventsCounterVec,
+		Help: "The total number of events handled by the event log sinks",
+	},
+	[]string{"sink", "status"},
+)
+
+func init() {
+	_ = prometheus.Register(eventLogEvents)
+}
+
+// LogSinkConfig configures an event log sink, in addition to the event log
+// file.
+type LogSinkConfig struct {
+	// Name identifies the sink in logs and metrics. Defaults to the type.
+	Name string `mapstructure:"name"`
+
+	// Type is the type of sink: syslog, tcp or http.
+	Type string `mapstructure:"type"`
+
+	// Address is the host:port of a syslog or tcp sink, or the URL of an
+	// http sink.
+	Address string `mapstructure:"address"`
+
+	// Protocol is the protocol of a syslog sink: udp, tcp or tls. Defaults to
+	// udp.
+	Protocol string `mapstructure:"protocol"`
+
+	// TLS enables TLS for tcp sinks.
+	TLS bool `mapstructure:"tls"`
+
+	// TrustedCAFile, CertFile, KeyFile and InsecureSkipTLSVerify configure
+	// the TLS connections of the sink.
+	TrustedCAFile         string `mapstructure:"trusted-ca-file"`
+	CertFile              string `mapstructure:"cert-file"`
+	KeyFile               string `mapstructure:"key-file"`
+	InsecureSkipTLSVerify bool   `mapstructure:"insecure-skip-tls-verify"`
+
+	// BufferSize is the number of events the sink can buffer.
+	BufferSize int `mapstructure:"buffer-size"`
+
+	// BufferWait is the amount of time to wait for room in a full buffer
+	// before applying the buffer full policy.
+	BufferWait time.Duration `mapstructure:"buffer-wait"`
+
+	// BufferFullPolicy is either drop-oldest, drop-newest or block.
+	BufferFullPolicy string `mapstructure:"buffer-full-policy"`
+
+	// BatchSize and BatchWait configure the batches of an http sink.
+	BatchSize int           `mapstructure:"batch-size"`
+	BatchWait time.Duration `mapstructure:"batch-wait"`
+}
+
+// Validate returns an error if the sink configuration is invalid.
+func (c *LogSinkConfig) Validate() error {
+	switch c.Type {
+	case LogSinkSyslog:
+		switch c.Protocol {
+		case "", "udp", "tcp", "tls":
+		default:
+			return fmt.Errorf("unsupported syslog protocol %q", c.Protocol)
+		}
+	case LogSinkTCP, LogSinkHTTP:
+	default:
+		return fmt.Errorf("unsupported event log sink type %q", c.Type)
+	}
+	if c.Address == "" {
+		return fmt.Errorf("event log sink %q has no address", c.name())
+	}
+	switch c.BufferFullPolicy {
+	case "", BufferFullDropOldest, BufferFullDropNewest, BufferFullBlock:
+	default:
+		return fmt.Errorf("unsupported buffer full policy %q", c.BufferFullPolicy)
+	}
+	return nil
+}
+
+func (c *LogSinkConfig) name() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return c.Type
+}
+
+func (c *LogSinkConfig) tlsConfig() (*tls.Config, error) {
+	opts := &corev2.TLSOptions{
+		TrustedCAFile:      c.TrustedCAFile,
+		CertFile:           c.CertFile,
+		KeyFile:            c.KeyFile,
+		InsecureSkipVerify: c.InsecureSkipTLSVerify,
+	}
+	return opts.ToClientTLSConfig()
+}
+
+// newWriter creates the writer of the sink. The writes of the network sinks
+// wait for their connection until ctx is done.
+func (c *LogSinkConfig) newWriter(ctx context.Context) (LogWriter, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	var tlsConfig *tls.Config
+	var err error
+	if c.TLS || c.Protocol == "tls" || c.Type == LogSinkHTTP {
+		if tlsConfig, err = c.tlsConfig(); err != nil {
+			return nil, err
+		}
+	}
+	switch c.Type {
+	case LogSinkSyslog:
+		protocol := c.Protocol
+		if protocol == "" {
+			protocol = "udp"
+		}
+		return logging.NewSyslogWriter(ctx, protocol, c.Address, tlsConfig)
+	case LogSinkTCP:
+		if !c.TLS {
+			tlsConfig = nil
+		}
+		return logging.NewTCPWriter(ctx, c.Address, tlsConfig), nil
+	default:
+		return logging.NewHTTPWriter(c.Address, tlsConfig, c.BatchSize, c.BatchWait), nil
+	}
+}
+
+// batchWriter is implemented by the writers that send events in batches, and
+// report the outcome of each batch.
+type batchWriter interface {
+	SetReporter(func(logging.BatchResult))
+}
+
+// SinkLogger logs events to a network sink.
+type SinkLogger struct {
+	Config    LogSinkConfig
+	rawLogger *rawLogger
+	cancel    context.CancelFunc
+}
+
+// Start starts the sink logger. It returns an error if the sink is
+// misconfigured.
+func (s *SinkLogger) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	writer, err := s.Config.newWriter(ctx)
+	if err != nil {
+		cancel()
+		return err
+	}
+	s.cancel = cancel
+
+	bufferSize := s.Config.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultLogSinkBufferSize
+	}
+	bufferWait := s.Config.BufferWait
+	if bufferWait <= 0 {
+		bufferWait = defaultLogSinkBufferWait
+	}
+
+	l := &rawLogger{
+		input:        make(chan interface{}),
+		encoderInput: make(chan interface{}, bufferSize),
+		output:       make(chan []byte, bufferSize),
+		done:         make(chan interface{}),
+		writer:       writer,
+		wait:         bufferWait,
+		policy:       s.Config.BufferFullPolicy,
+		sink:         s.Config.name(),
+		metrics:      newMetrics(),
+	}
+	if writer, ok := writer.(batchWriter); ok {
+		l.batched = true
+		writer.SetReporter(l.reportBatch)
+	}
+	s.rawLogger = l
+
+	for _, status := range []string{EventLogEventsLabelWritten, EventLogEventsLabelDropped, EventLogEventsLabelFailed} {
+		eventLogEvents.WithLabelValues(l.sink, status)
+	}
+
+	go l.ringBuffer()
+	go l.encoder()
+	go l.write()
+	go l.metricsWriter()
+
+	logger.WithField("sink", l.sink).Infof("event logging to %s %s", s.Config.Type, s.Config.Address)
+
+	return nil
+}
+
+// Stop stops the sink logger. The remaining events no longer wait for the
+// connection of the sink.
+func (s *SinkLogger) Stop() {
+	s.cancel()
+	s.rawLogger.Stop()
+}
+
+// Println sends the event to the sink.
+func (s *SinkLogger) Println(v interface{}) {
+	s.rawLogger.Println(v)
+}
+
+// MultiLogger sends events to multiple loggers.
+type MultiLogger []Logger
+
+// Stop stops all of the loggers.
+func (m MultiLogger) Stop() {
+	for _, l := range m {
+		l.Stop()
+	}
+}
+
+// Println sends the event to all of the loggers.
+func (m MultiLogger) Println(v interface{}) {
+	for _, l := range m {
+		l.Println(v)
+	}
+}
@@ -0,0 +1,163 @@
+package eventd
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sensu/sensu-go/backend/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSinkConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  LogSinkConfig
+		wantErr bool
+	}{
+		{
+			name:   "syslog",
+			config: LogSinkConfig{Type: LogSinkSyslog, Address: "127.0.0.1:514"},
+		},
+		{
+			name:   "tcp with policy",
+			config: LogSinkConfig{Type: LogSinkTCP, Address: "127.0.0.1:5000", BufferFullPolicy: BufferFullBlock},
+		},
+		{
+			name:   "http",
+			config: LogSinkConfig{Type: LogSinkHTTP, Address: "https://127.0.0.1/events"},
+		},
+		{
+			name:    "unknown type",
+			config:  LogSinkConfig{Type: "kafka", Address: "127.0.0.1:9092"},
+			wantErr: true,
+		},
+		{
+			name:    "missing address",
+			config:  LogSinkConfig{Type: LogSinkTCP},
+			wantErr: true,
+		},
+		{
+			name:    "unknown syslog protocol",
+			config:  LogSinkConfig{Type: LogSinkSyslog, Address: "127.0.0.1:514", Protocol: "sctp"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown policy",
+			config:  LogSinkConfig{Type: LogSinkTCP, Address: "127.0.0.1:5000", BufferFullPolicy: "explode"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LogSinkConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSinkLogger(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	lines := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	l := &SinkLogger{Config: LogSinkConfig{
+		Name:    "test",
+		Type:    LogSinkTCP,
+		Address: ln.Addr().String(),
+	}}
+	require.NoError(t, l.Start())
+	defer l.Stop()
+
+	var loggers MultiLogger = []Logger{NoopLogger{}, l}
+	loggers.Println(map[string]string{"foo": "bar"})
+
+	select {
+	case line := <-lines:
+		assert.Equal(t, `{"foo":"bar"}`, line)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestSinkLoggerInvalidConfig(t *testing.T) {
+	l := &SinkLogger{Config: LogSinkConfig{Type: "kafka"}}
+	assert.Error(t, l.Start())
+}
+
+func TestSinkLoggerBatchMetrics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	l := &SinkLogger{Config: LogSinkConfig{
+		Name:      "batch-metrics",
+		Type:      LogSinkHTTP,
+		Address:   ts.URL,
+		BatchSize: 1,
+	}}
+	require.NoError(t, l.Start())
+	defer l.Stop()
+	require.True(t, l.rawLogger.batched)
+
+	// Buffered events are only counted once their batch is sent
+	written := eventLogEvents.WithLabelValues("batch-metrics", EventLogEventsLabelWritten)
+	failed := eventLogEvents.WithLabelValues("batch-metrics", EventLogEventsLabelFailed)
+	l.rawLogger.reportBatch(logging.BatchResult{Messages: 2, Bytes: 10})
+	l.rawLogger.reportBatch(logging.BatchResult{Messages: 3, Err: errors.New("unavailable")})
+	assert.Equal(t, float64(2), testutil.ToFloat64(written))
+	assert.Equal(t, float64(3), testutil.ToFloat64(failed))
+}
+
+func TestSinkLoggerOutage(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	l := &SinkLogger{Config: LogSinkConfig{
+		Name:             "outage",
+		Type:             LogSinkTCP,
+		Address:          addr,
+		BufferSize:       1,
+		BufferWait:       time.Millisecond,
+		BufferFullPolicy: BufferFullDropNewest,
+	}}
+	require.NoError(t, l.Start())
+	defer l.Stop()
+
+	// The writes wait for the sink, so the buffer fills and its policy
+	// applies instead of the events failing
+	dropped := eventLogEvents.WithLabelValues("outage", EventLogEventsLabelDropped)
+	failed := eventLogEvents.WithLabelValues("outage", EventLogEventsLabelFailed)
+	droppedBefore, failedBefore := testutil.ToFloat64(dropped), testutil.ToFloat64(failed)
+	for i := 0; i < 10; i++ {
+		l.Println(map[string]int{"i": i})
+	}
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(dropped) > droppedBefore
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, failedBefore, testutil.ToFloat64(failed))
+}
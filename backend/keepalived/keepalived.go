@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -75,22 +76,23 @@ const deletedEventSentinel = -1
 // Keepalived is responsible for monitoring keepalive events and recording
 // keepalives for entities.
 type Keepalived struct {
-	bus                   messaging.MessageBus
-	workerCount           int
-	store                 store.Store
-	storev2               storev2.Interface
-	eventStore            store.EventStore
-	deregistrationHandler string
-	mu                    *sync.Mutex
-	wg                    *sync.WaitGroup
-	keepaliveChan         chan interface{}
-	subscription          messaging.Subscription
-	errChan               chan error
-	livenessFactory       liveness.Factory
-	ringPool              *ringv2.RingPool
-	ctx                   context.Context
-	cancel                context.CancelFunc
-	storeTimeout          time.Duration
+	bus                    messaging.MessageBus
+	workerCount            int
+	store                  store.Store
+	storev2                storev2.Interface
+	eventStore             store.EventStore
+	deregistrationHandler  string
+	deregistrationPolicies []DeregistrationPolicy
+	mu                     *sync.Mutex
+	wg                     *sync.WaitGroup
+	keepaliveChan          chan interface{}
+	subscription           messaging.Subscription
+	errChan                chan error
+	livenessFactory        liveness.Factory
+	ringPool               *ringv2.RingPool
+	ctx                    context.Context
+	cancel                 context.CancelFunc
+	storeTimeout           time.Duration
 }
 
 // Option is a functional option.
@@ -104,10 +106,13 @@ type Config struct {
 	Bus                   messaging.MessageBus
 	LivenessFactory       liveness.Factory
 	DeregistrationHandler string
-	RingPool              *ringv2.RingPool
-	BufferSize            int
-	WorkerCount           int
-	StoreTimeout          time.Duration
+	// DeregistrationPolicies deregister the entities whose keepalive has
+	// been failing for too long.
+	DeregistrationPolicies []DeregistrationPolicy
+	RingPool               *ringv2.RingPool
+	BufferSize             int
+	WorkerCount            int
+	StoreTimeout           time.Duration
 }
 
 // New creates a new Keepalived.
@@ -128,20 +133,21 @@ func New(c Config, opts ...Option) (*Keepalived, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	k := &Keepalived{
-		store:                 c.Store,
-		storev2:               c.StoreV2,
-		eventStore:            c.EventStore,
-		bus:                   c.Bus,
-		deregistrationHandler: c.DeregistrationHandler,
-		livenessFactory:       c.LivenessFactory,
-		keepaliveChan:         make(chan interface{}, c.BufferSize),
-		workerCount:           c.WorkerCount,
-		mu:                    &sync.Mutex{},
-		errChan:               make(chan error, 1),
-		ringPool:              c.RingPool,
-		ctx:                   ctx,
-		cancel:                cancel,
-		storeTimeout:          c.StoreTimeout,
+		store:                  c.Store,
+		storev2:                c.StoreV2,
+		eventStore:             c.EventStore,
+		bus:                    c.Bus,
+		deregistrationHandler:  c.DeregistrationHandler,
+		deregistrationPolicies: c.DeregistrationPolicies,
+		livenessFactory:        c.LivenessFactory,
+		keepaliveChan:          make(chan interface{}, c.BufferSize),
+		workerCount:            c.WorkerCount,
+		mu:                     &sync.Mutex{},
+		errChan:                make(chan error, 1),
+		ringPool:               c.RingPool,
+		ctx:                    ctx,
+		cancel:                 cancel,
+		storeTimeout:           c.StoreTimeout,
 	}
 	for _, o := range opts {
 		if err := o(k); err != nil {
@@ -433,8 +439,11 @@ func (k *Keepalived) handleEntityRegistration(entity *corev2.Entity, event *core
 		return nil
 	}
 
-	// The entity config does not exist so create it and publish a registration
-	// event
+	// The entity config does not exist so create it, within the quotas of its
+	// namespace, and publish a registration event
+	if err := k.store.CheckQuota(tctx, entity.Namespace, corev2.EntitiesResource); err != nil {
+		return err
+	}
 	if err := k.storev2.CreateIfNotExists(req, wrapper); err == nil {
 		event := createRegistrationEvent(entity)
 		return k.bus.Publish(messaging.TopicEvent, event)
@@ -600,7 +609,27 @@ func (k *Keepalived) dead(key string, prev liveness.State, leader bool) bool {
 		return true
 	}
 
-	if entityConfig.Deregister {
+	deregister := entityConfig.Deregister
+	var dryRunPolicy string
+	if policy := k.deregistrationPolicy(currentEvent.Entity, time.Now()); policy != nil && !deregister {
+		lager = lager.WithField("deregistration_policy", policy.Name)
+		deregistrations.WithLabelValues(policy.Name, strconv.FormatBool(policy.DryRun)).Inc()
+		if policy.DryRun {
+			lager.Warn("dry-run: entity would be deregistered by deregistration policy")
+			dryRunPolicy = policy.Name
+		} else {
+			lager.Info("entity deregistered by deregistration policy")
+			deregister = true
+			if currentEvent.Entity.Deregistration.Handler == "" {
+				currentEvent.Entity.Deregistration.Handler = policy.Handler
+			}
+			if currentEvent.Entity.Deregistration.Handler == "" {
+				currentEvent.Entity.Deregistration.Handler = k.deregistrationHandler
+			}
+		}
+	}
+
+	if deregister {
 		deregisterer := &Deregistration{
 			EntityStore:  k.store,
 			EventStore:   k.eventStore,
@@ -631,6 +660,12 @@ func (k *Keepalived) dead(key string, prev liveness.State, leader bool) bool {
 		event.Check.Status = 2
 	}
 	event.Check.Output = fmt.Sprintf("No keepalive sent from %s for %v seconds (>= %v)", event.Entity.Name, timeSinceLastSeen, timeout)
+	if dryRunPolicy != "" {
+		// Report the entity in its keepalive event, so that the entities a
+		// policy would deregister can be listed
+		event.Check.Labels = map[string]string{DryRunDeregistrationLabel: dryRunPolicy}
+		event.Check.Output += fmt.Sprintf(", would be deregistered by the %s deregistration policy (dry-run)", dryRunPolicy)
+	}
 
 	if err := k.bus.Publish(messaging.TopicEventRaw, event); err != nil {
 		lager.WithError(err).Error("error publishing event")
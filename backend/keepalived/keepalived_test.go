@@ -262,6 +262,7 @@ func TestProcessRegistration(t *testing.T) {
 		expectedEventLen       int
 		storeGetErr            error
 		storeCreateOrUpdateErr error
+		quotaErr               error
 		expectedEntityLen      int
 		assertionFunc          assertionFunc
 	}{
@@ -280,6 +281,17 @@ func TestProcessRegistration(t *testing.T) {
 			event:            new(corev2.Event),
 			expectedEventLen: 1,
 		},
+		{
+			name:             "Non-Registered Entity exceeding a quota",
+			entity:           newEntityWithClass("agent"),
+			storeGetErr:      &stor.ErrNotFound{},
+			quotaErr:         &stor.ErrQuotaExceeded{Namespace: "default", Reason: "at most 1 entities are allowed"},
+			event:            new(corev2.Event),
+			expectedEventLen: 0,
+			assertionFunc: func(store *storetest.Store) {
+				store.AssertNotCalled(t, "CreateIfNotExists", mock.Anything, mock.Anything)
+			},
+		},
 		{
 			name:             "agent-managed entity is registered",
 			entity:           newAgentManagedEntity("agent"),
@@ -313,6 +325,8 @@ func TestProcessRegistration(t *testing.T) {
 			require.NoError(t, messageBus.Start())
 
 			storv2 := &storetest.Store{}
+			store := &mockstore.MockStore{}
+			store.On("CheckQuota", mock.Anything, "default", "entities").Return(tc.quotaErr)
 
 			tsubEvent := testSubscriber{
 				ch: make(chan interface{}, 1),
@@ -321,6 +335,7 @@ func TestProcessRegistration(t *testing.T) {
 			require.NoError(t, err)
 
 			keepalived, err := New(Config{
+				Store:           store,
 				StoreV2:         storv2,
 				Bus:             messageBus,
 				LivenessFactory: fakeFactory,
@@ -334,7 +349,7 @@ func TestProcessRegistration(t *testing.T) {
 			storv2.On("UpdateIfExists", mock.Anything, mock.Anything).Return(tc.storeCreateOrUpdateErr)
 			storv2.On("Get", mock.Anything).Return(tc.storeEntity, tc.storeGetErr)
 			err = keepalived.handleEntityRegistration(tc.entity, tc.event)
-			require.NoError(t, err)
+			assert.Equal(t, tc.quotaErr, err)
 
 			assert.Equal(t, tc.expectedEventLen, len(tsubEvent.ch))
 			assert.NoError(t, subscriptionEvent.Cancel())
@@ -0,0 +1,128 @@
+package keepalived
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+const (
+	// DeregistrationsCounterVec is the name of the prometheus counter vec
+	// used to count the entities deregistered by a deregistration policy.
+	DeregistrationsCounterVec = "sensu_go_keepalive_deregistrations"
+
+	// DeregistrationsLabelPolicy is the name of the deregistration policy.
+	DeregistrationsLabelPolicy = "policy"
+
+	// DeregistrationsLabelDryRun is whether the deregistration policy is in
+	// dry-run mode, in which case the entities are only reported.
+	DeregistrationsLabelDryRun = "dry_run"
+
+	// DryRunDeregistrationLabel is the label set on the check of the
+	// keepalive events of the entities that a deregistration policy in
+	// dry-run mode would have deregistered, with the name of the policy as
+	// value. These entities can be listed with e.g.:
+	// sensuctl event list --field-selector 'event.labels.sensu.io/dry_run_deregistration == ephemeral'
+	DryRunDeregistrationLabel = "sensu.io/dry_run_deregistration"
+)
+
+var deregistrations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: DeregistrationsCounterVec,
+		Help: "The total number of entities deregistered by a deregistration policy",
+	},
+	[]string{DeregistrationsLabelPolicy, DeregistrationsLabelDryRun},
+)
+
+func init() {
+	_ = prometheus.Register(deregistrations)
+}
+
+// DeregistrationPolicy deregisters the entities whose keepalive has been
+// failing for a given duration, even if their agent did not ask for it. An
+// entity matches a policy if it matches all of its labels, one of its
+// subscriptions if any, and one of its entity classes if any.
+type DeregistrationPolicy struct {
+	// Name identifies the policy in logs and metrics.
+	Name string `mapstructure:"name"`
+
+	// After is the amount of time the keepalive of an entity must have been
+	// failing before the entity is deregistered.
+	After time.Duration `mapstructure:"after"`
+
+	// Labels are the labels the entities must have, with the same values.
+	Labels map[string]string `mapstructure:"labels"`
+
+	// Subscriptions are the subscriptions the entities must have one of.
+	Subscriptions []string `mapstructure:"subscriptions"`
+
+	// EntityClasses are the entity classes the entities must have one of.
+	EntityClasses []string `mapstructure:"entity-classes"`
+
+	// Handler is the deregistration handler used for the entities that do not
+	// specify one.
+	Handler string `mapstructure:"handler"`
+
+	// DryRun only reports the entities the policy would deregister, in the
+	// logs, metrics and keepalive events of the entities.
+	DryRun bool `mapstructure:"dry-run"`
+}
+
+// Validate returns an error if the deregistration policy is invalid.
+func (p *DeregistrationPolicy) Validate() error {
+	if p.Name == "" {
+		return errors.New("deregistration policy has no name")
+	}
+	if p.After <= 0 {
+		return fmt.Errorf("deregistration policy %q must have a positive after duration", p.Name)
+	}
+	return nil
+}
+
+// Matches returns whether the policy applies to the given entity.
+func (p *DeregistrationPolicy) Matches(entity *corev2.Entity) bool {
+	for key, value := range p.Labels {
+		if v, ok := entity.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	if len(p.Subscriptions) > 0 && !containsAny(entity.Subscriptions, p.Subscriptions) {
+		return false
+	}
+	if len(p.EntityClasses) > 0 && !containsAny([]string{entity.EntityClass}, p.EntityClasses) {
+		return false
+	}
+	return true
+}
+
+// Expired returns whether the keepalive of the given entity has been failing
+// for longer than the policy allows, at the given time.
+func (p *DeregistrationPolicy) Expired(entity *corev2.Entity, now time.Time) bool {
+	return now.Sub(time.Unix(entity.LastSeen, 0)) >= p.After
+}
+
+func containsAny(values, candidates []string) bool {
+	for _, value := range values {
+		for _, candidate := range candidates {
+			if value == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// deregistrationPolicy returns the first policy that matches the entity and
+// expired, or nil.
+func (k *Keepalived) deregistrationPolicy(entity *corev2.Entity, now time.Time) *DeregistrationPolicy {
+	for i := range k.deregistrationPolicies {
+		policy := &k.deregistrationPolicies[i]
+		if policy.Matches(entity) && policy.Expired(entity, now) {
+			return policy
+		}
+	}
+	return nil
+}
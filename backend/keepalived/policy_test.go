@@ -0,0 +1,188 @@
+package keepalived
+
+import (
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/liveness"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	"github.com/sensu/sensu-go/backend/store/v2/storetest"
+	"github.com/sensu/sensu-go/backend/store/v2/wrap"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeregistrationPolicyValidate(t *testing.T) {
+	policy := DeregistrationPolicy{After: time.Hour}
+	assert.Error(t, policy.Validate())
+	policy.Name = "ephemeral"
+	assert.NoError(t, policy.Validate())
+	policy.After = 0
+	assert.Error(t, policy.Validate())
+}
+
+func TestDeregistrationPolicyMatches(t *testing.T) {
+	entity := corev2.FixtureEntity("entity1")
+	entity.Labels = map[string]string{"cloud": "aws", "region": "us-east-1"}
+	entity.Subscriptions = []string{"linux", "web"}
+	entity.EntityClass = corev2.EntityAgentClass
+
+	tests := []struct {
+		name   string
+		policy DeregistrationPolicy
+		want   bool
+	}{
+		{
+			name:   "empty policy matches everything",
+			policy: DeregistrationPolicy{},
+			want:   true,
+		},
+		{
+			name:   "matching labels",
+			policy: DeregistrationPolicy{Labels: map[string]string{"cloud": "aws"}},
+			want:   true,
+		},
+		{
+			name:   "mismatched label value",
+			policy: DeregistrationPolicy{Labels: map[string]string{"cloud": "gcp"}},
+			want:   false,
+		},
+		{
+			name:   "missing label",
+			policy: DeregistrationPolicy{Labels: map[string]string{"team": "ops"}},
+			want:   false,
+		},
+		{
+			name:   "one matching subscription",
+			policy: DeregistrationPolicy{Subscriptions: []string{"db", "web"}},
+			want:   true,
+		},
+		{
+			name:   "no matching subscription",
+			policy: DeregistrationPolicy{Subscriptions: []string{"db"}},
+			want:   false,
+		},
+		{
+			name:   "matching entity class",
+			policy: DeregistrationPolicy{EntityClasses: []string{corev2.EntityAgentClass}},
+			want:   true,
+		},
+		{
+			name:   "mismatched entity class",
+			policy: DeregistrationPolicy{EntityClasses: []string{corev2.EntityProxyClass}},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.Matches(entity))
+		})
+	}
+}
+
+func TestDeregistrationPolicyExpired(t *testing.T) {
+	now := time.Now()
+	entity := corev2.FixtureEntity("entity1")
+	entity.LastSeen = now.Add(-30 * time.Minute).Unix()
+
+	policy := DeregistrationPolicy{After: time.Hour}
+	assert.False(t, policy.Expired(entity, now))
+	assert.True(t, policy.Expired(entity, now.Add(time.Hour)))
+}
+
+func TestDeadCallbackDeregistrationPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		dryRun    bool
+		wantBury  bool
+		wantEvent string
+		wantLabel string
+	}{
+		{
+			name:      "entity is deregistered",
+			wantBury:  true,
+			wantEvent: "deregistration",
+		},
+		{
+			name:      "entity is only reported in dry-run mode",
+			dryRun:    true,
+			wantBury:  false,
+			wantEvent: corev2.KeepaliveCheckName,
+			wantLabel: "ephemeral",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messageBus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+			require.NoError(t, err)
+			require.NoError(t, messageBus.Start())
+			tsub := testSubscriber{
+				ch: make(chan interface{}, 1),
+			}
+			_, err = messageBus.Subscribe(messaging.TopicEvent, "testSubscriber", tsub)
+			require.NoError(t, err)
+			_, err = messageBus.Subscribe(messaging.TopicEventRaw, "testSubscriber", tsub)
+			require.NoError(t, err)
+
+			entityConfig := corev3.FixtureEntityConfig("entity1")
+			entityConfig.Deregister = false
+			wrapper, err := storev2.WrapResource(
+				entityConfig,
+				[]wrap.Option{wrap.CompressNone, wrap.EncodeJSON}...)
+			require.NoError(t, err)
+			s := &storetest.Store{}
+			s.On("Get", mock.Anything).Return(wrapper, nil)
+
+			event := corev2.FixtureEvent("entity1", corev2.KeepaliveCheckName)
+			event.Check.Status = 1
+			event.Entity.EntityClass = corev2.EntityProxyClass
+			event.Entity.LastSeen = time.Now().Add(-2 * time.Hour).Unix()
+
+			eventStore := &mockstore.MockStore{}
+			eventStore.On("GetEventByEntityCheck", mock.Anything, "entity1", "keepalive").Return(event, nil)
+			eventStore.On("DeleteEntity", mock.Anything, mock.Anything).Return(nil)
+			eventStore.On("GetEventsByEntity", mock.Anything, "entity1", &store.SelectionPredicate{}).Return([]*corev2.Event{}, nil)
+			eventStore.On("UpdateFailingKeepalive", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			keepalived, err := New(Config{
+				Store:                 eventStore,
+				StoreV2:               s,
+				EventStore:            eventStore,
+				Bus:                   messageBus,
+				LivenessFactory:       fakeFactory,
+				DeregistrationHandler: "deregistration",
+				DeregistrationPolicies: []DeregistrationPolicy{
+					{
+						Name:   "ephemeral",
+						After:  time.Hour,
+						DryRun: tt.dryRun,
+					},
+				},
+				WorkerCount:  1,
+				BufferSize:   1,
+				StoreTimeout: time.Minute,
+			})
+			require.NoError(t, err)
+
+			if got := keepalived.dead("default/entity1", liveness.Alive, true); got != tt.wantBury {
+				t.Fatalf("got bury: %v, want bury: %v", got, tt.wantBury)
+			}
+
+			select {
+			case msg := <-tsub.ch:
+				published, ok := msg.(*corev2.Event)
+				require.True(t, ok)
+				assert.Equal(t, tt.wantEvent, published.Check.Name)
+				assert.Equal(t, tt.wantLabel, published.Check.Labels[DryRunDeregistrationLabel])
+			case <-time.After(time.Second):
+				t.Fatal("no event published")
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultHTTPBatchSize is the default maximum number of messages sent in
+	// a single request by an HTTPWriter.
+	DefaultHTTPBatchSize = 100
+
+	// DefaultHTTPBatchWait is the default maximum amount of time a message is
+	// buffered by an HTTPWriter before being sent.
+	DefaultHTTPBatchWait = time.Second
+
+	// httpWriterTimeout is the maximum amount of time to wait for a batch to
+	// be sent.
+	httpWriterTimeout = 10 * time.Second
+
+	// httpWriterRetries is the number of times sending a batch is retried
+	// before it is discarded.
+	httpWriterRetries = 3
+
+	// httpWriterRetryWait is the amount of time to wait before retrying to
+	// send a batch, doubled after each attempt.
+	httpWriterRetryWait = time.Second
+
+	// httpWriterMaxBatches is the number of full batches an HTTPWriter
+	// buffers while the endpoint is unavailable, after which messages are
+	// refused.
+	httpWriterMaxBatches = 10
+)
+
+// ErrHTTPWriterFull is returned when writing to an HTTPWriter that can't
+// buffer more messages.
+var ErrHTTPWriterFull = errors.New("too many messages waiting to be sent")
+
+// BatchResult is the outcome of sending a batch of messages.
+type BatchResult struct {
+	// Messages is the number of messages of the batch
+	Messages int
+	// Bytes is the size of the batch
+	Bytes int
+	// Err is the reason why the batch could not be sent, if any
+	Err error
+}
+
+// HTTPWriter buffers newline-delimited messages and sends them in batches to
+// an HTTP endpoint, with POST requests. A batch is sent when it reaches the
+// configured size, or when its oldest message has been buffered for the
+// configured amount of time. Batches that can't be sent are retried a few
+// times, with a backoff, before being discarded.
+type HTTPWriter struct {
+	url       string
+	client    *http.Client
+	batchSize int
+	mu        sync.Mutex
+	messages  [][]byte
+	reporter  func(BatchResult)
+	full      chan struct{}
+	done      chan struct{}
+	wg        sync.WaitGroup
+	retryWait time.Duration
+}
+
+// NewHTTPWriter creates a new HTTPWriter and starts the goroutine that sends
+// the buffered messages.
+func NewHTTPWriter(url string, tlsConfig *tls.Config, batchSize int, batchWait time.Duration) *HTTPWriter {
+	if batchSize <= 0 {
+		batchSize = DefaultHTTPBatchSize
+	}
+	if batchWait <= 0 {
+		batchWait = DefaultHTTPBatchWait
+	}
+	w := &HTTPWriter{
+		url:       url,
+		batchSize: batchSize,
+		client: &http.Client{
+			Timeout:   httpWriterTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		full:      make(chan struct{}, 1),
+		done:      make(chan struct{}),
+		retryWait: httpWriterRetryWait,
+	}
+	w.wg.Add(1)
+	go w.sendPeriodically(batchWait)
+	return w
+}
+
+// SetReporter sets the function called with the outcome of each batch, which
+// is when the messages are actually written, or not.
+func (w *HTTPWriter) SetReporter(reporter func(BatchResult)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.reporter = reporter
+}
+
+func (w *HTTPWriter) sendPeriodically(interval time.Duration) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-w.full:
+		case <-w.done:
+			return
+		}
+		if err := w.send(); err != nil {
+			logger.WithError(err).Warning("could not send batch")
+		}
+	}
+}
+
+// Write buffers the message, to be sent by the writer goroutine. It never
+// waits for the network, and returns ErrHTTPWriterFull if too many messages
+// are already waiting to be sent. It is goroutine-safe.
+func (w *HTTPWriter) Write(b []byte) (int, error) {
+	message := make([]byte, len(b), len(b)+1)
+	copy(message, b)
+	if len(b) > 0 && b[len(b)-1] != '\n' {
+		message = append(message, '\n')
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.messages) >= w.batchSize*httpWriterMaxBatches {
+		return 0, ErrHTTPWriterFull
+	}
+	w.messages = append(w.messages, message)
+	if len(w.messages) >= w.batchSize {
+		select {
+		case w.full <- struct{}{}:
+		default:
+		}
+	}
+	return len(b), nil
+}
+
+// Sync asks the writer goroutine to send the buffered messages, without
+// waiting for them to be sent.
+func (w *HTTPWriter) Sync() error {
+	select {
+	case w.full <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// nextBatch removes the next batch from the buffer.
+func (w *HTTPWriter) nextBatch() ([][]byte, func(BatchResult)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	n := len(w.messages)
+	if n > w.batchSize {
+		n = w.batchSize
+	}
+	batch := w.messages[:n:n]
+	w.messages = w.messages[n:]
+	return batch, w.reporter
+}
+
+// send sends the buffered messages, batch by batch, and returns the error of
+// the last batch that could not be sent. It is only called by the writer
+// goroutine, or once it has stopped, so batches are sent in order.
+func (w *HTTPWriter) send() error {
+	var lastErr error
+	for {
+		batch, reporter := w.nextBatch()
+		if len(batch) == 0 {
+			return lastErr
+		}
+		body := bytes.Join(batch, nil)
+		err := w.sendWithRetries(body, len(batch))
+		if err != nil {
+			lastErr = err
+		}
+		if reporter != nil {
+			reporter(BatchResult{Messages: len(batch), Bytes: len(body), Err: err})
+		}
+	}
+}
+
+// sendWithRetries sends a batch, retrying with a backoff if it fails. The
+// batch is only tried once more when the writer is closed.
+func (w *HTTPWriter) sendWithRetries(body []byte, count int) error {
+	wait := w.retryWait
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = w.post(body, count); err == nil {
+			return nil
+		}
+		if attempt >= httpWriterRetries {
+			return err
+		}
+		select {
+		case <-time.After(wait):
+			wait *= 2
+		case <-w.done:
+			return w.post(body, count)
+		}
+	}
+}
+
+func (w *HTTPWriter) post(body []byte, count int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), httpWriterTimeout)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send %d message(s): %s", count, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("could not send %d message(s): unexpected status %s", count, resp.Status)
+	}
+	return nil
+}
+
+// Close stops the writer goroutine and sends the remaining messages, trying
+// each batch once more at most if it fails.
+func (w *HTTPWriter) Close() error {
+	close(w.done)
+	w.wg.Wait()
+	return w.send()
+}
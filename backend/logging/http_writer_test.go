@@ -0,0 +1,140 @@
+package logging
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPWriterBatchSize(t *testing.T) {
+	batches := make(chan string, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(r.Body)
+		batches <- string(body)
+	}))
+	defer ts.Close()
+
+	w := NewHTTPWriter(ts.URL, nil, 2, time.Hour)
+	defer w.Close()
+
+	_, err := w.Write([]byte(`{"a":1}` + "\n"))
+	require.NoError(t, err)
+	assert.Len(t, batches, 0)
+
+	_, err = w.Write([]byte(`{"b":2}`))
+	require.NoError(t, err)
+
+	select {
+	case batch := <-batches:
+		assert.Equal(t, "{\"a\":1}\n{\"b\":2}\n", batch)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for batch")
+	}
+}
+
+func TestHTTPWriterBatchWait(t *testing.T) {
+	batches := make(chan string, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		batches <- string(body)
+	}))
+	defer ts.Close()
+
+	w := NewHTTPWriter(ts.URL, nil, 100, 10*time.Millisecond)
+	defer w.Close()
+
+	_, err := w.Write([]byte(`{"a":1}`))
+	require.NoError(t, err)
+
+	select {
+	case batch := <-batches:
+		assert.Equal(t, "{\"a\":1}\n", batch)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for batch")
+	}
+}
+
+func TestHTTPWriterErrorStatus(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	results := make(chan BatchResult, 1)
+	w := NewHTTPWriter(ts.URL, nil, 100, time.Hour)
+	w.retryWait = time.Millisecond
+	w.SetReporter(func(result BatchResult) { results <- result })
+	defer w.Close()
+
+	_, err := w.Write([]byte(`{"a":1}`))
+	require.NoError(t, err)
+	require.NoError(t, w.Sync())
+
+	select {
+	case result := <-results:
+		assert.Equal(t, 1, result.Messages)
+		require.Error(t, result.Err)
+		assert.True(t, strings.Contains(result.Err.Error(), "503"), result.Err.Error())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for batch")
+	}
+	assert.Equal(t, int32(httpWriterRetries+1), atomic.LoadInt32(&requests))
+}
+
+func TestHTTPWriterRetry(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	results := make(chan BatchResult, 1)
+	w := NewHTTPWriter(ts.URL, nil, 1, time.Hour)
+	w.retryWait = time.Millisecond
+	w.SetReporter(func(result BatchResult) { results <- result })
+	defer w.Close()
+
+	_, err := w.Write([]byte(`{"a":1}`))
+	require.NoError(t, err)
+
+	select {
+	case result := <-results:
+		assert.NoError(t, result.Err)
+		assert.Equal(t, BatchResult{Messages: 1, Bytes: 8}, result)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for batch")
+	}
+}
+
+func TestHTTPWriterFull(t *testing.T) {
+	unblock := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer ts.Close()
+
+	w := NewHTTPWriter(ts.URL, nil, 1, time.Hour)
+	defer w.Close()
+	defer close(unblock)
+
+	// Writes never wait for the network, until too many messages are waiting
+	// to be sent
+	var err error
+	for i := 0; i <= httpWriterMaxBatches+1 && err == nil; i++ {
+		_, err = w.Write([]byte(`{"a":1}`))
+	}
+	assert.Equal(t, ErrHTTPWriterFull, err)
+}
@@ -0,0 +1,214 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// netWriterDialTimeout is the maximum amount of time to wait for a
+	// connection to be established.
+	netWriterDialTimeout = 5 * time.Second
+
+	// netWriterWriteTimeout is the maximum amount of time to wait for a write
+	// to complete.
+	netWriterWriteTimeout = 5 * time.Second
+
+	// netWriterMinBackoff and netWriterMaxBackoff bound the amount of time to
+	// wait before reconnecting after a failed connection attempt.
+	netWriterMinBackoff = 100 * time.Millisecond
+	netWriterMaxBackoff = 30 * time.Second
+
+	// syslogFacilityLocal0 and syslogSeverityInfo are used to compute the
+	// priority of the syslog messages.
+	syslogFacilityLocal0 = 16
+	syslogSeverityInfo   = 6
+
+	// SyslogAppName is the APP-NAME of the syslog messages.
+	SyslogAppName = "sensu-backend"
+)
+
+// NetWriter writes messages to a network connection. The connection is
+// established on the first write and re-established whenever a write fails,
+// with an exponential backoff between failed attempts. Writes block until the
+// message is written or the context of the writer is done.
+type NetWriter struct {
+	ctx      context.Context
+	dial     func() (net.Conn, error)
+	frame    func([]byte) []byte
+	mu       sync.Mutex
+	conn     net.Conn
+	backoff  time.Duration
+	nextDial time.Time
+}
+
+func newNetWriter(ctx context.Context, network, address string, tlsConfig *tls.Config, frame func([]byte) []byte) *NetWriter {
+	dialer := &net.Dialer{Timeout: netWriterDialTimeout}
+	dial := func() (net.Conn, error) {
+		return dialer.Dial(network, address)
+	}
+	if tlsConfig != nil {
+		dial = func() (net.Conn, error) {
+			return tls.DialWithDialer(dialer, network, address, tlsConfig)
+		}
+	}
+	return &NetWriter{
+		ctx:   ctx,
+		dial:  dial,
+		frame: frame,
+	}
+}
+
+// NewTCPWriter creates a NetWriter that writes newline-delimited messages to
+// the given TCP address, over TLS if a TLS configuration is provided. Writes
+// stop waiting for the connection once ctx is done.
+func NewTCPWriter(ctx context.Context, address string, tlsConfig *tls.Config) *NetWriter {
+	return newNetWriter(ctx, "tcp", address, tlsConfig, func(b []byte) []byte {
+		if len(b) > 0 && b[len(b)-1] != '\n' {
+			b = append(b, '\n')
+		}
+		return b
+	})
+}
+
+// NewSyslogWriter creates a NetWriter that writes RFC5424 messages to the
+// given syslog address. The protocol can be either udp, tcp or tls. Messages
+// sent over a stream are framed using octet counting, as described in RFC6587.
+// Writes stop waiting for the connection once ctx is done.
+func NewSyslogWriter(ctx context.Context, protocol, address string, tlsConfig *tls.Config) (*NetWriter, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+
+	switch protocol {
+	case "udp":
+		return newNetWriter(ctx, "udp", address, nil, func(b []byte) []byte {
+			return formatSyslogMessage(hostname, time.Now(), b)
+		}), nil
+	case "tcp", "tls":
+		if protocol == "tls" && tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		} else if protocol == "tcp" {
+			tlsConfig = nil
+		}
+		return newNetWriter(ctx, "tcp", address, tlsConfig, func(b []byte) []byte {
+			msg := formatSyslogMessage(hostname, time.Now(), b)
+			return append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+		}), nil
+	}
+	return nil, fmt.Errorf("unsupported syslog protocol %q", protocol)
+}
+
+// formatSyslogMessage formats the given message as an RFC5424 syslog message.
+func formatSyslogMessage(hostname string, t time.Time, b []byte) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s %d - - ",
+		syslogFacilityLocal0*8+syslogSeverityInfo,
+		t.UTC().Format(time.RFC3339Nano),
+		hostname,
+		SyslogAppName,
+		os.Getpid(),
+	)
+	buf.Write(bytes.TrimRight(b, "\n"))
+	return buf.Bytes()
+}
+
+// Write frames the message and writes it to the connection. When the write
+// fails, it reconnects and tries again until the message is written or the
+// context of the writer is done, so that the messages pile up in the buffer of
+// the caller during an outage. It is goroutine-safe.
+func (w *NetWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	msg := w.frame(b)
+	for {
+		// A failure on an established connection may only mean that it was
+		// closed by the remote end, retry at once with a new one
+		established := w.conn != nil
+		err := w.write(msg)
+		if err == nil {
+			w.backoff = 0
+			return len(b), nil
+		}
+		w.disconnect()
+		if !established {
+			w.fail(err)
+		}
+
+		timer := time.NewTimer(time.Until(w.nextDial))
+		select {
+		case <-w.ctx.Done():
+			timer.Stop()
+			return 0, err
+		case <-timer.C:
+		}
+	}
+}
+
+func (w *NetWriter) write(msg []byte) error {
+	if err := w.connect(); err != nil {
+		return err
+	}
+	if err := w.conn.SetWriteDeadline(time.Now().Add(netWriterWriteTimeout)); err != nil {
+		return err
+	}
+	_, err := w.conn.Write(msg)
+	return err
+}
+
+// connect establishes the connection if needed. It must be called with the
+// lock held.
+func (w *NetWriter) connect() error {
+	if w.conn != nil {
+		return nil
+	}
+	conn, err := w.dial()
+	if err != nil {
+		return err
+	}
+	w.conn = conn
+	return nil
+}
+
+// fail increases the time to wait before the next connection attempt. It must
+// be called with the lock held.
+func (w *NetWriter) fail(err error) {
+	if w.backoff == 0 {
+		logger.WithError(err).Warning("could not write to the event log sink, retrying")
+		w.backoff = netWriterMinBackoff
+	} else if w.backoff *= 2; w.backoff > netWriterMaxBackoff {
+		w.backoff = netWriterMaxBackoff
+	}
+	w.nextDial = time.Now().Add(w.backoff)
+}
+
+// disconnect closes the connection, if any. It must be called with the lock
+// held.
+func (w *NetWriter) disconnect() {
+	if w.conn != nil {
+		_ = w.conn.Close()
+		w.conn = nil
+	}
+}
+
+// Close closes the connection.
+func (w *NetWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.disconnect()
+	return nil
+}
+
+// Sync is a no-op, messages are not buffered by the writer.
+func (w *NetWriter) Sync() error {
+	return nil
+}
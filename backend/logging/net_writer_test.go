@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTCPWriter(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	lines := make(chan string, 2)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	w := NewTCPWriter(context.Background(), ln.Addr().String(), nil)
+	defer w.Close()
+
+	_, err = w.Write([]byte(`{"foo":"bar"}` + "\n"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`{"baz":"qux"}`))
+	require.NoError(t, err)
+
+	for _, want := range []string{`{"foo":"bar"}`, `{"baz":"qux"}`} {
+		select {
+		case got := <-lines:
+			assert.Equal(t, want, got)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for message")
+		}
+	}
+}
+
+func TestTCPWriterBackoff(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	// The write waits for the connection until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	w := NewTCPWriter(ctx, addr, nil)
+	defer w.Close()
+
+	start := time.Now()
+	_, err = w.Write([]byte("foo"))
+	require.Error(t, err)
+	assert.True(t, time.Since(start) >= 500*time.Millisecond)
+	assert.NotZero(t, w.backoff)
+}
+
+func TestTCPWriterReconnect(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	w := NewTCPWriter(context.Background(), addr, nil)
+	defer w.Close()
+
+	// The sink comes back while the write is waiting
+	lines := make(chan string, 1)
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		ln, err := net.Listen("tcp", addr)
+		if err != nil {
+			return
+		}
+		defer ln.Close()
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	_, err = w.Write([]byte("foo"))
+	require.NoError(t, err)
+	select {
+	case got := <-lines:
+		assert.Equal(t, "foo", got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+}
+
+func TestSyslogWriterUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	w, err := NewSyslogWriter(context.Background(), "udp", conn.LocalAddr().String(), nil)
+	require.NoError(t, err)
+	defer w.Close()
+
+	_, err = w.Write([]byte(`{"foo":"bar"}` + "\n"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 4096)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	msg := string(buf[:n])
+	assert.True(t, strings.HasPrefix(msg, "<134>1 "), msg)
+	assert.Contains(t, msg, " "+SyslogAppName+" ")
+	assert.True(t, strings.HasSuffix(msg, ` - - {"foo":"bar"}`), msg)
+}
+
+func TestSyslogWriterTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	frames := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		var n int
+		for _, c := range strings.TrimSpace(length) {
+			n = n*10 + int(c-'0')
+		}
+		msg := make([]byte, n)
+		if _, err := r.Read(msg); err != nil {
+			return
+		}
+		frames <- string(msg)
+	}()
+
+	w, err := NewSyslogWriter(context.Background(), "tcp", ln.Addr().String(), nil)
+	require.NoError(t, err)
+	defer w.Close()
+
+	_, err = w.Write([]byte(`{"foo":"bar"}` + "\n"))
+	require.NoError(t, err)
+
+	select {
+	case msg := <-frames:
+		assert.True(t, strings.HasPrefix(msg, "<134>1 "), msg)
+		assert.True(t, strings.HasSuffix(msg, `{"foo":"bar"}`), msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+}
+
+func TestSyslogWriterUnsupportedProtocol(t *testing.T) {
+	_, err := NewSyslogWriter(context.Background(), "sctp", "127.0.0.1:514", nil)
+	assert.Error(t, err)
+}
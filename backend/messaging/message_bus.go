@@ -14,6 +14,10 @@ const (
 	// to agents
 	TopicEntityConfig = "sensu:entity-config"
 
+	// TopicCheckConfig is the topic for the check configuration updates sent by
+	// agentd to the agent sessions
+	TopicCheckConfig = "sensu:check-config"
+
 	// TopicEvent is the topic for events that have been written to Etcd and
 	// normalized by eventd.
 	TopicEvent = "sensu:event"
@@ -104,6 +108,12 @@ func EntityConfigTopic(namespace, name string) string {
 	return fmt.Sprintf("%s:%s:%s", TopicEntityConfig, namespace, name)
 }
 
+// CheckConfigTopic is a helper to determine the proper topic name for the
+// check configuration updates of a namespace
+func CheckConfigTopic(namespace string) string {
+	return fmt.Sprintf("%s:%s", TopicCheckConfig, namespace)
+}
+
 // SubscriptionTopic is a helper to determine the proper topic name for a
 // subscription based on the namespace
 func SubscriptionTopic(namespace, sub string) string {
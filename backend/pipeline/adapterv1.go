@@ -26,6 +26,14 @@ type AdapterV1 struct {
 	FilterAdapters  []FilterAdapter
 	MutatorAdapters []MutatorAdapter
 	HandlerAdapters []HandlerAdapter
+
+	// HandlerExecutionTTL is the amount of time the handler executions are
+	// recorded for. Handler executions are not recorded if it is 0.
+	HandlerExecutionTTL time.Duration
+
+	// RetryQueue is the queue the failed handler executions are retried
+	// from. Failed handler executions are not retried if it is nil.
+	RetryQueue HandlerRetryQueue
 }
 
 func (a *AdapterV1) Name() string {
@@ -94,14 +102,32 @@ func (a *AdapterV1) Run(ctx context.Context, ref *corev2.ResourceReference, reso
 			}
 		}
 
+		// Record the execution of the workflow mutator and handler if enabled
+		execution := a.newHandlerExecution(ctx, event, workflow)
+		hctx := ctx
+		if execution != nil {
+			hctx = context.WithValue(ctx, corev2.HandlerExecutionKey, execution)
+		}
+
 		// Process the event through the workflow mutator
-		mutatedData, err := a.processMutator(ctx, workflow.Mutator, event)
+		start := time.Now()
+		mutatedData, err := a.processMutator(hctx, workflow.Mutator, event)
+		if execution != nil {
+			execution.MutatorDuration = time.Since(start).Seconds()
+		}
 		if err != nil {
+			a.recordHandlerExecution(ctx, execution, start, err)
 			return err
 		}
 
 		// Process the event through the workflow handler
-		if err := a.processHandler(ctx, workflow.Handler, event, mutatedData); err != nil {
+		start = time.Now()
+		err = a.processHandler(hctx, workflow.Handler, event, mutatedData)
+		a.recordHandlerExecution(ctx, execution, start, err)
+		if err != nil {
+			if a.enqueueHandlerRetry(ctx, workflow.Handler, event, mutatedData, err) {
+				continue
+			}
 			return err
 		}
 	}
@@ -109,6 +135,49 @@ func (a *AdapterV1) Run(ctx context.Context, ref *corev2.ResourceReference, reso
 	return nil
 }
 
+// newHandlerExecution returns the record of the execution of the given
+// workflow for the event, or nil if handler executions are not recorded.
+func (a *AdapterV1) newHandlerExecution(ctx context.Context, event *corev2.Event, workflow *corev2.PipelineWorkflow) *corev2.HandlerExecution {
+	if a.HandlerExecutionTTL <= 0 || !event.HasCheck() || workflow.Handler == nil {
+		return nil
+	}
+	execution := corev2.NewHandlerExecution(event, workflow.Handler.Name)
+	execution.Pipeline = corev2.ContextPipeline(ctx)
+	execution.Workflow = workflow.Name
+	if workflow.Mutator != nil {
+		execution.Mutator = workflow.Mutator.Name
+	}
+	return execution
+}
+
+// recordHandlerExecution stores the given handler execution, which started at
+// the given time and returned the given error. Failing to store the execution
+// is not fatal.
+func (a *AdapterV1) recordHandlerExecution(ctx context.Context, execution *corev2.HandlerExecution, start time.Time, err error) {
+	if execution == nil {
+		return
+	}
+	execution.Executed = start.Unix()
+	execution.Duration = time.Since(start).Seconds()
+	if err != nil {
+		execution.Error = err.Error()
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, a.StoreTimeout)
+	defer cancel()
+	ttl := int64(a.HandlerExecutionTTL / time.Second)
+	if ttl < 1 {
+		ttl = 1
+	}
+	if err := a.Store.CreateHandlerExecution(tctx, execution, ttl); err != nil {
+		logger.WithFields(logrus.Fields{
+			"handler":           execution.Name,
+			"pipeline":          execution.Pipeline,
+			"pipeline_workflow": execution.Workflow,
+		}).WithError(err).Error("failed to record handler execution")
+	}
+}
+
 func (a *AdapterV1) resolvePipelineReference(ctx context.Context, ref *corev2.ResourceReference, event *corev2.Event) (*corev2.Pipeline, error) {
 	if ref.Name == LegacyPipelineName {
 		return a.generateLegacyPipeline(ctx, event)
@@ -410,6 +410,59 @@ func TestAdapterV1_Run(t *testing.T) {
 	}
 }
 
+func TestAdapterV1_RunRecordsHandlerExecution(t *testing.T) {
+	storedHandler := corev2.FixtureHandler("handler1")
+	handlerStore := &mockstore.MockStore{}
+	handlerStore.On("GetHandlerByName", mock.Anything, storedHandler.GetName()).Return(storedHandler, nil)
+	ex := &mockexecutor.MockExecutor{}
+	ex.Return(command.FixtureExecutionResponse(2, "handler output"), nil)
+
+	pipeline := &corev2.Pipeline{
+		ObjectMeta: corev2.NewObjectMeta("pipeline1", "default"),
+		Workflows: []*corev2.PipelineWorkflow{
+			{
+				Name: "workflow1",
+				Handler: &corev2.ResourceReference{
+					APIVersion: "core/v2",
+					Type:       "Handler",
+					Name:       "handler1",
+				},
+			},
+		},
+	}
+	event := corev2.FixtureEvent("entity1", "check1")
+
+	stor := &mockstore.MockStore{}
+	stor.On("GetPipelineByName", mock.Anything, pipeline.GetName()).Return(pipeline, nil)
+	stor.On("CreateHandlerExecution", mock.Anything, mock.MatchedBy(func(execution *corev2.HandlerExecution) bool {
+		return execution.Name == "handler1" &&
+			execution.Namespace == "default" &&
+			execution.EventID == event.GetUUID().String() &&
+			execution.Entity == "entity1" &&
+			execution.Check == "check1" &&
+			execution.Pipeline == "pipeline1" &&
+			execution.Workflow == "workflow1" &&
+			execution.Type == "pipe" &&
+			execution.Mutator == "json" &&
+			execution.Status == 2 &&
+			execution.Output == "handler output" &&
+			execution.Executed > 0 &&
+			execution.Error == ""
+	}), int64(3600)).Return(nil)
+
+	a := &AdapterV1{
+		Store:               stor,
+		StoreTimeout:        time.Second,
+		MutatorAdapters:     []MutatorAdapter{&mutator.JSONAdapter{}},
+		HandlerAdapters:     []HandlerAdapter{&handler.LegacyAdapter{Store: handlerStore, Executor: ex}},
+		HandlerExecutionTTL: time.Hour,
+	}
+	if err := a.Run(context.Background(), corev2.FixturePipelineReference("pipeline1"), event); err != nil {
+		t.Fatal(err)
+	}
+	stor.AssertExpectations(t)
+}
+
 func TestAdapterV1_resolvePipelineReference(t *testing.T) {
 	type fields struct {
 		Store           store.Store
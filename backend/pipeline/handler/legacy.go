@@ -25,6 +25,10 @@ const (
 
 	// LegacyAdapterName is the name of the handler adapter.
 	LegacyAdapterName = "LegacyAdapter"
+
+	// ExecutionOutputSize is the maximum size, in bytes, of the pipe handler
+	// output recorded in handler executions.
+	ExecutionOutputSize = 4096
 )
 
 // LegacyAdapter is a handler adapter that supports the legacy core.v2/Handler
@@ -67,6 +71,11 @@ func (l *LegacyAdapter) Handle(ctx context.Context, ref *corev2.ResourceReferenc
 		return fmt.Errorf("failed to fetch handler from store: %v", err)
 	}
 
+	execution := corev2.ContextHandlerExecution(ctx)
+	if execution != nil {
+		execution.Type = handler.Type
+	}
+
 	switch handler.Type {
 	case "pipe":
 		result, err := l.pipeHandler(ctx, handler, event, mutatedData)
@@ -79,6 +88,15 @@ func (l *LegacyAdapter) Handle(ctx context.Context, ref *corev2.ResourceReferenc
 		fields["status"] = result.Status
 		fields["output"] = result.Output
 		logger.WithFields(fields).Info("event pipe handler executed")
+		if execution != nil {
+			execution.Status = int32(result.Status)
+			execution.SetOutput(result.Output, ExecutionOutputSize)
+		}
+		// A non-zero exit status is a failure worth retrying for the
+		// handlers configured with retries
+		if result.Status != 0 && handler.Retries > 0 {
+			return fmt.Errorf("handler exited with status %d", result.Status)
+		}
 	case "tcp", "udp":
 		_, err := l.socketHandler(ctx, handler, event, mutatedData)
 		if err != nil {
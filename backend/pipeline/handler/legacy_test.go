@@ -212,6 +212,26 @@ func TestLegacyAdapter_Handle(t *testing.T) {
 	}
 }
 
+func TestLegacyAdapter_HandleRecordsExecution(t *testing.T) {
+	storedHandler := corev2.FixtureHandler("handler1")
+	stor := &mockstore.MockStore{}
+	stor.On("GetHandlerByName", mock.Anything, "handler1").Return(storedHandler, nil)
+	ex := &mockexecutor.MockExecutor{}
+	ex.Return(command.FixtureExecutionResponse(1, strings.Repeat("a", ExecutionOutputSize+1)), nil)
+
+	l := &LegacyAdapter{Store: stor, Executor: ex}
+	event := corev2.FixtureEvent("entity1", "check1")
+	execution := corev2.NewHandlerExecution(event, "handler1")
+	ctx := context.WithValue(context.Background(), corev2.HandlerExecutionKey, execution)
+
+	err := l.Handle(ctx, &corev2.ResourceReference{Name: "handler1"}, event, []byte("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, "pipe", execution.Type)
+	assert.Equal(t, int32(1), execution.Status)
+	assert.Len(t, execution.Output, ExecutionOutputSize)
+	assert.True(t, execution.OutputTruncated)
+}
+
 func TestLegacyAdapter_pipeHandler(t *testing.T) {
 	type fields struct {
 		AssetGetter            asset.Getter
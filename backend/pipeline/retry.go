@@ -0,0 +1,157 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// HandlerRetryQueue is the queue failed handler executions are retried from.
+type HandlerRetryQueue interface {
+	// Enqueue adds a failed handler execution to the queue.
+	Enqueue(context.Context, *corev2.HandlerFailure) error
+
+	// Claim returns the failed handler executions due for a retry at the
+	// given time.
+	Claim(context.Context, time.Time) ([]*corev2.HandlerFailure, error)
+
+	// Ack removes a failed handler execution that was successfully retried.
+	Ack(context.Context, *corev2.HandlerFailure) error
+
+	// Reschedule updates a failed handler execution that failed again.
+	Reschedule(context.Context, *corev2.HandlerFailure) error
+
+	// Bury moves a failed handler execution that exhausted its retries to the
+	// dead-letter list.
+	Bury(context.Context, *corev2.HandlerFailure) error
+}
+
+// HandlerRetrier is implemented by the pipeline adapters that retry failed
+// handler executions.
+type HandlerRetrier interface {
+	// RetryHandlers retries the failed handler executions that are due.
+	RetryHandlers(context.Context) error
+}
+
+// retryableHandlerTypes are the types of handlers that can be retried.
+var retryableHandlerTypes = map[string]bool{
+	corev2.HandlerPipeType: true,
+	corev2.HandlerTCPType:  true,
+	corev2.HandlerUDPType:  true,
+}
+
+// enqueueHandlerRetry adds the failed execution of the given handler to the
+// retry queue, and returns whether it was queued. Only core/v2 handlers
+// configured with retries are queued.
+func (a *AdapterV1) enqueueHandlerRetry(ctx context.Context, ref *corev2.ResourceReference, event *corev2.Event, mutatedData []byte, handlerErr error) bool {
+	if a.RetryQueue == nil || ref == nil || ref.APIVersion != "core/v2" || ref.Type != "Handler" {
+		return false
+	}
+
+	fields := logrus.Fields{
+		"namespace":         corev2.ContextNamespace(ctx),
+		"handler":           ref.Name,
+		"pipeline":          corev2.ContextPipeline(ctx),
+		"pipeline_workflow": corev2.ContextPipelineWorkflow(ctx),
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, a.StoreTimeout)
+	defer cancel()
+	handler, err := a.Store.GetHandlerByName(tctx, ref.Name)
+	if err != nil || handler == nil {
+		return false
+	}
+	if handler.Retries == 0 || !retryableHandlerTypes[handler.Type] {
+		return false
+	}
+
+	failure := corev2.NewHandlerFailure(handler, event, mutatedData, handlerErr)
+	failure.Pipeline = corev2.ContextPipeline(ctx)
+	failure.Workflow = corev2.ContextPipelineWorkflow(ctx)
+	if err := a.RetryQueue.Enqueue(tctx, failure); err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to queue handler retry")
+		return false
+	}
+
+	fields["handler_failure"] = failure.Name
+	logger.WithFields(fields).WithError(handlerErr).Warn("handler failed, queued for retry")
+	return true
+}
+
+// RetryHandlers retries the failed handler executions that are due. Retries
+// that succeed are removed from the queue, while the ones that fail are
+// rescheduled, or moved to the dead-letter list once exhausted.
+func (a *AdapterV1) RetryHandlers(ctx context.Context) error {
+	if a.RetryQueue == nil {
+		return nil
+	}
+
+	failures, err := a.RetryQueue.Claim(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, failure := range failures {
+		a.retryHandler(ctx, failure)
+	}
+
+	return nil
+}
+
+func (a *AdapterV1) retryHandler(ctx context.Context, failure *corev2.HandlerFailure) {
+	fields := logrus.Fields{
+		"namespace":         failure.Namespace,
+		"handler":           failure.Handler,
+		"handler_failure":   failure.Name,
+		"pipeline":          failure.Pipeline,
+		"pipeline_workflow": failure.Workflow,
+		"attempts":          failure.Attempts,
+	}
+
+	ctx = context.WithValue(ctx, corev2.NamespaceKey, failure.Namespace)
+	ctx = context.WithValue(ctx, corev2.PipelineKey, failure.Pipeline)
+	ctx = context.WithValue(ctx, corev2.PipelineWorkflowKey, failure.Workflow)
+
+	ref := &corev2.ResourceReference{
+		APIVersion: "core/v2",
+		Type:       "Handler",
+		Name:       failure.Handler,
+	}
+	err := a.processHandler(ctx, ref, failure.Event, failure.MutatedData)
+
+	tctx, cancel := context.WithTimeout(ctx, a.StoreTimeout)
+	defer cancel()
+
+	if err == nil {
+		if err := a.RetryQueue.Ack(tctx, failure); err != nil {
+			logger.WithFields(fields).WithError(err).Error("failed to remove handler retry")
+			return
+		}
+		logger.WithFields(fields).Info("handler retry succeeded")
+		return
+	}
+
+	now := time.Now()
+	failure.Attempts++
+	failure.LastAttempt = now.Unix()
+	failure.Error = err.Error()
+	fields["attempts"] = failure.Attempts
+
+	if failure.Exhausted() {
+		if err := a.RetryQueue.Bury(tctx, failure); err != nil {
+			logger.WithFields(fields).WithError(err).Error("failed to move handler failure to the dead-letter list")
+			return
+		}
+		logger.WithFields(fields).WithError(err).Error("handler retries exhausted, moved to the dead-letter list")
+		return
+	}
+
+	failure.NextAttempt = now.Add(failure.RetryDelay()).Unix()
+	if err := a.RetryQueue.Reschedule(tctx, failure); err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to reschedule handler retry")
+		return
+	}
+	logger.WithFields(fields).WithError(err).Warn("handler retry failed, rescheduled")
+}
@@ -0,0 +1,161 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/pipeline/handler"
+	"github.com/sensu/sensu-go/backend/pipeline/mutator"
+	"github.com/sensu/sensu-go/command"
+	"github.com/sensu/sensu-go/testing/mockexecutor"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+type testRetryQueue struct {
+	retries map[string]*corev2.HandlerFailure
+	buried  map[string]*corev2.HandlerFailure
+}
+
+func newTestRetryQueue() *testRetryQueue {
+	return &testRetryQueue{
+		retries: map[string]*corev2.HandlerFailure{},
+		buried:  map[string]*corev2.HandlerFailure{},
+	}
+}
+
+func (q *testRetryQueue) Enqueue(ctx context.Context, f *corev2.HandlerFailure) error {
+	q.retries[f.Name] = f
+	return nil
+}
+
+func (q *testRetryQueue) Claim(ctx context.Context, now time.Time) ([]*corev2.HandlerFailure, error) {
+	claimed := []*corev2.HandlerFailure{}
+	for _, f := range q.retries {
+		if f.NextAttempt <= now.Unix() {
+			claimed = append(claimed, f)
+		}
+	}
+	return claimed, nil
+}
+
+func (q *testRetryQueue) Ack(ctx context.Context, f *corev2.HandlerFailure) error {
+	delete(q.retries, f.Name)
+	return nil
+}
+
+func (q *testRetryQueue) Reschedule(ctx context.Context, f *corev2.HandlerFailure) error {
+	q.retries[f.Name] = f
+	return nil
+}
+
+func (q *testRetryQueue) Bury(ctx context.Context, f *corev2.HandlerFailure) error {
+	delete(q.retries, f.Name)
+	q.buried[f.Name] = f
+	return nil
+}
+
+func TestAdapterV1_RunRetriesHandlers(t *testing.T) {
+	storedHandler := corev2.FixtureHandler("handler1")
+	storedHandler.Retries = 1
+	handlerStore := &mockstore.MockStore{}
+	handlerStore.On("GetHandlerByName", mock.Anything, storedHandler.GetName()).Return(storedHandler, nil)
+	ex := &mockexecutor.MockExecutor{}
+	ex.Return(command.FixtureExecutionResponse(1, "handler output"), nil)
+
+	pipeline := &corev2.Pipeline{
+		ObjectMeta: corev2.NewObjectMeta("pipeline1", "default"),
+		Workflows: []*corev2.PipelineWorkflow{
+			{
+				Name: "workflow1",
+				Handler: &corev2.ResourceReference{
+					APIVersion: "core/v2",
+					Type:       "Handler",
+					Name:       "handler1",
+				},
+			},
+		},
+	}
+	event := corev2.FixtureEvent("entity1", "check1")
+
+	stor := &mockstore.MockStore{}
+	stor.On("GetPipelineByName", mock.Anything, pipeline.GetName()).Return(pipeline, nil)
+	stor.On("GetHandlerByName", mock.Anything, storedHandler.GetName()).Return(storedHandler, nil)
+
+	queue := newTestRetryQueue()
+	a := &AdapterV1{
+		Store:           stor,
+		StoreTimeout:    time.Second,
+		MutatorAdapters: []MutatorAdapter{&mutator.JSONAdapter{}},
+		HandlerAdapters: []HandlerAdapter{&handler.LegacyAdapter{Store: handlerStore, Executor: ex}},
+		RetryQueue:      queue,
+	}
+
+	// The failed handler execution is queued instead of failing the pipeline
+	if err := a.Run(context.Background(), corev2.FixturePipelineReference("pipeline1"), event); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(queue.retries), 1; got != want {
+		t.Fatalf("bad number of queued retries: got %d, want %d", got, want)
+	}
+	var failure *corev2.HandlerFailure
+	for _, f := range queue.retries {
+		failure = f
+	}
+	if failure.Handler != "handler1" || failure.Pipeline != "pipeline1" || failure.Workflow != "workflow1" {
+		t.Errorf("bad handler failure: %v", failure)
+	}
+
+	// The retry fails again and exhausts the retries of the handler
+	failure.NextAttempt = 0
+	if err := a.RetryHandlers(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(queue.retries), 0; got != want {
+		t.Errorf("bad number of queued retries: got %d, want %d", got, want)
+	}
+	if got, want := len(queue.buried), 1; got != want {
+		t.Fatalf("bad number of dead letters: got %d, want %d", got, want)
+	}
+	if got, want := failure.Attempts, uint32(2); got != want {
+		t.Errorf("bad attempts: got %d, want %d", got, want)
+	}
+
+	// A successful retry is removed from the queue
+	ex.Return(command.FixtureExecutionResponse(0, "handler output"), nil)
+	failure.Retries = failure.Attempts
+	failure.NextAttempt = 0
+	delete(queue.buried, failure.Name)
+	queue.retries[failure.Name] = failure
+	if err := a.RetryHandlers(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(queue.retries), 0; got != want {
+		t.Errorf("bad number of queued retries: got %d, want %d", got, want)
+	}
+	if got, want := len(queue.buried), 0; got != want {
+		t.Errorf("bad number of dead letters: got %d, want %d", got, want)
+	}
+}
+
+func TestAdapterV1_RunWithoutRetries(t *testing.T) {
+	storedHandler := corev2.FixtureHandler("handler1")
+	stor := &mockstore.MockStore{}
+	stor.On("GetHandlerByName", mock.Anything, storedHandler.GetName()).Return(storedHandler, nil)
+
+	queue := newTestRetryQueue()
+	a := &AdapterV1{
+		Store:        stor,
+		StoreTimeout: time.Second,
+		RetryQueue:   queue,
+	}
+	ref := &corev2.ResourceReference{APIVersion: "core/v2", Type: "Handler", Name: "handler1"}
+	if a.enqueueHandlerRetry(context.Background(), ref, corev2.FixtureEvent("entity1", "check1"), nil, context.DeadlineExceeded) {
+		t.Error("handler without retries should not be queued")
+	}
+	if got, want := len(queue.retries), 0; got != want {
+		t.Errorf("bad number of queued retries: got %d, want %d", got, want)
+	}
+}
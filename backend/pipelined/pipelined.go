@@ -16,6 +16,10 @@ import (
 
 var defaultStoreTimeout = time.Minute
 
+// handlerRetryInterval is how often the failed handler executions due for a
+// retry are claimed.
+var handlerRetryInterval = 5 * time.Second
+
 // Pipelined handles incoming Sensu events and puts them through a
 // Sensu event pipeline, i.e. filter -> mutator -> handler. The Sensu
 // handler configuration determines which Sensu filters and mutator
@@ -103,6 +107,7 @@ func (p *Pipelined) Start() error {
 	p.subscription = sub
 
 	p.createWorkers(p.workerCount, p.eventChan)
+	p.startHandlerRetriers()
 
 	return nil
 }
@@ -161,6 +166,39 @@ func (p *Pipelined) createWorkers(count int, channel chan interface{}) {
 	}
 }
 
+// startHandlerRetriers periodically retries the failed handler executions of
+// the adapters that support it.
+func (p *Pipelined) startHandlerRetriers() {
+	for _, adapter := range p.adapters {
+		retrier, ok := adapter.(pipeline.HandlerRetrier)
+		if !ok {
+			continue
+		}
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				<-p.stopping
+				cancel()
+			}()
+			ticker := time.NewTicker(handlerRetryInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := retrier.RetryHandlers(ctx); err != nil && ctx.Err() == nil {
+						logger.WithError(err).Error("failed to retry handlers")
+					}
+				}
+			}
+		}()
+	}
+}
+
 func (p *Pipelined) handleMessage(ctx context.Context, msg interface{}) error {
 	getter, ok := msg.(PipelineGetter)
 	if !ok {
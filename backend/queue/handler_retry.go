@@ -0,0 +1,245 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/etcd/kvc"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	handlerRetriesPrefix  = "handler_retries"
+	handlerFailuresPrefix = "handler_failures"
+
+	// handlerRetryClaimTimeout is the amount of time a claimed handler retry
+	// stays hidden from the other backends. If the backend that claimed it
+	// does not reschedule, acknowledge or bury it in time, the retry is
+	// claimed again.
+	handlerRetryClaimTimeout = 5 * time.Minute
+)
+
+var (
+	handlerRetryKeyBuilder   = store.NewKeyBuilder(handlerRetriesPrefix)
+	handlerFailureKeyBuilder = store.NewKeyBuilder(handlerFailuresPrefix)
+)
+
+// HandlerRetryQueue is a durable queue of failed handler executions, backed by
+// etcd. Failed executions wait in the retry lane until they are due, and are
+// then claimed by a single backend. The executions that failed more than
+// their maximum number of retries are moved to the dead-letter list, where
+// they stay until they are retried or purged by an operator.
+type HandlerRetryQueue struct {
+	client       *clientv3.Client
+	claimTimeout time.Duration
+}
+
+// NewHandlerRetryQueue returns a new HandlerRetryQueue.
+func NewHandlerRetryQueue(client *clientv3.Client) *HandlerRetryQueue {
+	return &HandlerRetryQueue{
+		client:       client,
+		claimTimeout: handlerRetryClaimTimeout,
+	}
+}
+
+func handlerRetryKey(f *corev2.HandlerFailure) string {
+	return handlerRetryKeyBuilder.WithNamespace(f.Namespace).Build(f.Name)
+}
+
+func handlerFailureKey(f *corev2.HandlerFailure) string {
+	return handlerFailureKeyBuilder.WithNamespace(f.Namespace).Build(f.Name)
+}
+
+// Enqueue adds the failed handler execution to the retry lane.
+func (q *HandlerRetryQueue) Enqueue(ctx context.Context, f *corev2.HandlerFailure) error {
+	if f.Namespace == "" || f.Name == "" {
+		return &store.ErrNotValid{Err: errors.New("must specify namespace and name")}
+	}
+	return q.put(ctx, handlerRetryKey(f), f)
+}
+
+// Reschedule updates the failed handler execution in the retry lane, after
+// an unsuccessful retry.
+func (q *HandlerRetryQueue) Reschedule(ctx context.Context, f *corev2.HandlerFailure) error {
+	return q.put(ctx, handlerRetryKey(f), f)
+}
+
+func (q *HandlerRetryQueue) put(ctx context.Context, key string, f *corev2.HandlerFailure) error {
+	value, err := f.Marshal()
+	if err != nil {
+		return &store.ErrEncode{Key: key, Err: err}
+	}
+	comparator := kvc.Comparisons(
+		kvc.NamespaceExists(f.Namespace),
+	)
+	return kvc.Txn(ctx, q.client, comparator, clientv3.OpPut(key, string(value)))
+}
+
+// Claim returns the failed handler executions of all namespaces that are due
+// for a retry at the given time. The returned executions are postponed, so
+// they are not claimed by another backend while they are retried.
+func (q *HandlerRetryQueue) Claim(ctx context.Context, now time.Time) ([]*corev2.HandlerFailure, error) {
+	var resp *clientv3.GetResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = q.client.Get(ctx, handlerRetryKeyBuilder.Build(""), clientv3.WithPrefix())
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := []*corev2.HandlerFailure{}
+	for _, kv := range resp.Kvs {
+		f := &corev2.HandlerFailure{}
+		if err := f.Unmarshal(kv.Value); err != nil {
+			return nil, &store.ErrDecode{Key: string(kv.Key), Err: err}
+		}
+		if f.NextAttempt > now.Unix() {
+			continue
+		}
+
+		// Postpone the retry, unless another backend did it first
+		postponed := *f
+		postponed.NextAttempt = now.Add(q.claimTimeout).Unix()
+		value, err := postponed.Marshal()
+		if err != nil {
+			return nil, &store.ErrEncode{Key: string(kv.Key), Err: err}
+		}
+		cmp := clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision)
+		put := clientv3.OpPut(string(kv.Key), string(value))
+		var txnResp *clientv3.TxnResponse
+		err = kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+			txnResp, err = q.client.Txn(ctx).If(cmp).Then(put).Commit()
+			return kvc.RetryRequest(n, err)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if txnResp.Succeeded {
+			claimed = append(claimed, f)
+		}
+	}
+
+	return claimed, nil
+}
+
+// Ack removes the failed handler execution from the retry lane, after a
+// successful retry.
+func (q *HandlerRetryQueue) Ack(ctx context.Context, f *corev2.HandlerFailure) error {
+	return kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		_, err = q.client.Delete(ctx, handlerRetryKey(f))
+		return kvc.RetryRequest(n, err)
+	})
+}
+
+// Bury moves the failed handler execution from the retry lane to the
+// dead-letter list, once its retries are exhausted.
+func (q *HandlerRetryQueue) Bury(ctx context.Context, f *corev2.HandlerFailure) error {
+	key := handlerFailureKey(f)
+	value, err := f.Marshal()
+	if err != nil {
+		return &store.ErrEncode{Key: key, Err: err}
+	}
+	comparator := kvc.Comparisons(
+		kvc.NamespaceExists(f.Namespace),
+	)
+	return kvc.Txn(ctx, q.client, comparator,
+		clientv3.OpPut(key, string(value)),
+		clientv3.OpDelete(handlerRetryKey(f)),
+	)
+}
+
+// ListFailures returns the dead-letter list of the namespace stored in ctx,
+// oldest failure first.
+func (q *HandlerRetryQueue) ListFailures(ctx context.Context) ([]*corev2.HandlerFailure, error) {
+	var resp *clientv3.GetResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = q.client.Get(ctx, handlerFailureKeyBuilder.WithContext(ctx).Build(""), clientv3.WithPrefix())
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	failures := make([]*corev2.HandlerFailure, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		f := &corev2.HandlerFailure{}
+		if err := f.Unmarshal(kv.Value); err != nil {
+			return nil, &store.ErrDecode{Key: string(kv.Key), Err: err}
+		}
+		failures = append(failures, f)
+	}
+
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[i].Created < failures[j].Created
+	})
+
+	return failures, nil
+}
+
+// RetryFailure moves the given failed handler execution of the namespace
+// stored in ctx from the dead-letter list back to the retry lane, for one
+// immediate retry.
+func (q *HandlerRetryQueue) RetryFailure(ctx context.Context, name string) error {
+	key := handlerFailureKeyBuilder.WithContext(ctx).Build(name)
+
+	var resp *clientv3.GetResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = q.client.Get(ctx, key)
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) == 0 {
+		return &store.ErrNotFound{Key: key}
+	}
+
+	f := &corev2.HandlerFailure{}
+	if err := f.Unmarshal(resp.Kvs[0].Value); err != nil {
+		return &store.ErrDecode{Key: key, Err: err}
+	}
+	f.Retries = f.Attempts
+	f.NextAttempt = time.Now().Unix()
+	value, err := f.Marshal()
+	if err != nil {
+		return &store.ErrEncode{Key: key, Err: err}
+	}
+
+	comparator := kvc.Comparisons(
+		kvc.NamespaceExists(f.Namespace),
+		kvc.KeyIsFound(key),
+	)
+	return kvc.Txn(ctx, q.client, comparator,
+		clientv3.OpPut(handlerRetryKey(f), string(value)),
+		clientv3.OpDelete(key),
+	)
+}
+
+// PurgeFailures deletes the given failed handler execution from the
+// dead-letter list of the namespace stored in ctx, or all of them if name is
+// empty.
+func (q *HandlerRetryQueue) PurgeFailures(ctx context.Context, name string) error {
+	key := handlerFailureKeyBuilder.WithContext(ctx).Build(name)
+	opts := []clientv3.OpOption{}
+	if name == "" {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+
+	var resp *clientv3.DeleteResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = q.client.Delete(ctx, key, opts...)
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return err
+	}
+	if name != "" && resp.Deleted == 0 {
+		return &store.ErrNotFound{Key: key}
+	}
+	return nil
+}
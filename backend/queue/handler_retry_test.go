@@ -0,0 +1,87 @@
+// +build integration,!race
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/backend/store"
+	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerRetryQueue(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client := e.NewEmbeddedClient()
+	defer client.Close()
+
+	ctx := context.WithValue(context.Background(), corev2.NamespaceKey, "default")
+	s := etcdstore.NewStore(client, "")
+	require.NoError(t, s.CreateNamespace(ctx, corev2.FixtureNamespace("default")))
+
+	handler := corev2.FixtureHandler("handler1")
+	handler.Retries = 1
+	handler.RetryBackoff = 10
+	f := corev2.NewHandlerFailure(handler, corev2.FixtureEvent("entity1", "check1"), nil, errors.New("failure"))
+
+	q := NewHandlerRetryQueue(client)
+	require.NoError(t, q.Enqueue(ctx, f))
+
+	// The retry is not due yet
+	claimed, err := q.Claim(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	// Once due, the retry can only be claimed once
+	now := time.Unix(f.NextAttempt, 0)
+	claimed, err = q.Claim(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, f.Name, claimed[0].Name)
+	claimed, err = q.Claim(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	// Burying the retry moves it to the dead-letter list
+	f.Attempts++
+	require.NoError(t, q.Bury(ctx, f))
+	claimed, err = q.Claim(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+	failures, err := q.ListFailures(ctx)
+	require.NoError(t, err)
+	require.Len(t, failures, 1)
+	assert.Equal(t, uint32(2), failures[0].Attempts)
+
+	// Retrying the failure moves it back to the retry lane
+	require.NoError(t, q.RetryFailure(ctx, f.Name))
+	failures, err = q.ListFailures(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	claimed, err = q.Claim(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.False(t, claimed[0].Exhausted())
+
+	// Acknowledging the retry removes it
+	require.NoError(t, q.Ack(ctx, claimed[0]))
+	claimed, err = q.Claim(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	// Purging removes the failures from the dead-letter list
+	require.NoError(t, q.Bury(ctx, f))
+	var notFound *store.ErrNotFound
+	assert.True(t, errors.As(q.PurgeFailures(ctx, "missing"), &notFound))
+	require.NoError(t, q.PurgeFailures(ctx, ""))
+	failures, err = q.ListFailures(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+}
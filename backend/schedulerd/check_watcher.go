@@ -10,6 +10,7 @@ import (
 	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	cachev2 "github.com/sensu/sensu-go/backend/store/cache/v2"
 )
 
@@ -22,11 +23,12 @@ type CheckWatcher struct {
 	ctx                    context.Context
 	ringPool               *ringv2.RingPool
 	entityCache            *cachev2.Resource
+	overrideCache          *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
 }
 
 // NewCheckWatcher creates a new ScheduleManager.
-func NewCheckWatcher(ctx context.Context, msgBus messaging.MessageBus, store store.Store, pool *ringv2.RingPool, cache *cachev2.Resource, secretsProviderManager *secrets.ProviderManager) *CheckWatcher {
+func NewCheckWatcher(ctx context.Context, msgBus messaging.MessageBus, store store.Store, pool *ringv2.RingPool, cache *cachev2.Resource, overrideCache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *CheckWatcher {
 	watcher := &CheckWatcher{
 		store:                  store,
 		items:                  make(map[string]Scheduler),
@@ -34,6 +36,7 @@ func NewCheckWatcher(ctx context.Context, msgBus messaging.MessageBus, store sto
 		ctx:                    ctx,
 		ringPool:               pool,
 		entityCache:            cache,
+		overrideCache:          overrideCache,
 		secretsProviderManager: secretsProviderManager,
 	}
 
@@ -64,16 +67,16 @@ func (c *CheckWatcher) startScheduler(check *corev2.CheckConfig) error {
 
 	switch GetSchedulerType(check) {
 	case IntervalType:
-		scheduler = NewIntervalScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewIntervalScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.overrideCache, c.secretsProviderManager)
 	case CronType:
-		scheduler = NewCronScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewCronScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.overrideCache, c.secretsProviderManager)
 	case RoundRobinIntervalType:
-		scheduler = NewRoundRobinIntervalScheduler(c.ctx, c.store, c.bus, c.ringPool, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewRoundRobinIntervalScheduler(c.ctx, c.store, c.bus, c.ringPool, check, c.entityCache, c.overrideCache, c.secretsProviderManager)
 	case RoundRobinCronType:
-		scheduler = NewRoundRobinCronScheduler(c.ctx, c.store, c.bus, c.ringPool, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewRoundRobinCronScheduler(c.ctx, c.store, c.bus, c.ringPool, check, c.entityCache, c.overrideCache, c.secretsProviderManager)
 	default:
 		logger.Error("bad scheduler type, falling back to interval scheduler")
-		scheduler = NewIntervalScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewIntervalScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.overrideCache, c.secretsProviderManager)
 	}
 
 	// Start scheduling check
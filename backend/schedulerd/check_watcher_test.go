@@ -41,7 +41,7 @@ func TestCheckWatcherSmoke(t *testing.T) {
 	st.On("GetCheckConfigWatcher", mock.Anything).Return((<-chan store.WatchEventCheckConfig)(watcherChan), nil)
 
 	pm := secrets.NewProviderManager()
-	watcher := NewCheckWatcher(ctx, bus, st, nil, &cachev2.Resource{}, pm)
+	watcher := NewCheckWatcher(ctx, bus, st, nil, &cachev2.Resource{}, nil, pm)
 	require.NoError(t, watcher.Start())
 
 	checkAA := corev2.FixtureCheckConfig("a")
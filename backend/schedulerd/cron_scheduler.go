@@ -6,6 +6,7 @@ import (
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	cachev2 "github.com/sensu/sensu-go/backend/store/cache/v2"
 	"github.com/sirupsen/logrus"
 
@@ -23,11 +24,12 @@ type CronScheduler struct {
 	cancel                 context.CancelFunc
 	interrupt              chan *corev2.CheckConfig
 	entityCache            *cachev2.Resource
+	overrideCache          *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
 }
 
 // NewCronScheduler initializes a CronScheduler
-func NewCronScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, check *corev2.CheckConfig, cache *cachev2.Resource, secretsProviderManager *secrets.ProviderManager) *CronScheduler {
+func NewCronScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, check *corev2.CheckConfig, cache *cachev2.Resource, overrideCache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *CronScheduler {
 	sched := &CronScheduler{
 		store:         store,
 		bus:           bus,
@@ -40,6 +42,7 @@ func NewCronScheduler(ctx context.Context, store store.Store, bus messaging.Mess
 			"scheduler_type": CronType.String(),
 		}),
 		entityCache:            cache,
+		overrideCache:          overrideCache,
 		secretsProviderManager: secretsProviderManager,
 	}
 	sched.ctx, sched.cancel = context.WithCancel(ctx)
@@ -71,7 +74,7 @@ func (s *CronScheduler) Start() {
 func (s *CronScheduler) start() {
 	s.logger.Info("starting new cron scheduler")
 	timer := NewCronTimer(s.check.Name, s.check.Cron)
-	executor := NewCheckExecutor(s.bus, s.check.Namespace, s.store, s.entityCache, s.secretsProviderManager)
+	executor := NewCheckExecutor(s.bus, s.check.Namespace, s.store, s.entityCache, s.overrideCache, s.secretsProviderManager)
 	timer.Start()
 
 	for {
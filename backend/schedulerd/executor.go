@@ -12,6 +12,7 @@ import (
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	cachev2 "github.com/sensu/sensu-go/backend/store/cache/v2"
 	"github.com/sensu/sensu-go/types"
 	stringsutil "github.com/sensu/sensu-go/util/strings"
@@ -37,12 +38,13 @@ type CheckExecutor struct {
 	store                  store.Store
 	namespace              string
 	entityCache            *cachev2.Resource
+	overrideCache          *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
 }
 
 // NewCheckExecutor creates a new check executor
-func NewCheckExecutor(bus messaging.MessageBus, namespace string, store store.Store, cache *cachev2.Resource, secretsProviderManager *secrets.ProviderManager) *CheckExecutor {
-	return &CheckExecutor{bus: bus, namespace: namespace, store: store, entityCache: cache, secretsProviderManager: secretsProviderManager}
+func NewCheckExecutor(bus messaging.MessageBus, namespace string, store store.Store, cache *cachev2.Resource, overrideCache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *CheckExecutor {
+	return &CheckExecutor{bus: bus, namespace: namespace, store: store, entityCache: cache, overrideCache: overrideCache, secretsProviderManager: secretsProviderManager}
 }
 
 // ProcessCheck processes a check by publishing its proxy requests (if any)
@@ -109,7 +111,7 @@ func (c *CheckExecutor) executeOnEntity(check *corev2.CheckConfig, entity string
 }
 
 func (c *CheckExecutor) buildRequest(check *corev2.CheckConfig) (*corev2.CheckRequest, error) {
-	return buildRequest(check, c.store, c.secretsProviderManager)
+	return buildRequest(check, c.store, c.overrideCache, c.secretsProviderManager)
 }
 
 func assetIsRelevant(asset *corev2.Asset, assets []string) bool {
@@ -144,11 +146,12 @@ type AdhocRequestExecutor struct {
 	cancel                 context.CancelFunc
 	listenQueueErr         chan error
 	entityCache            *cachev2.Resource
+	overrideCache          *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
 }
 
 // NewAdhocRequestExecutor returns a new AdhocRequestExecutor.
-func NewAdhocRequestExecutor(ctx context.Context, store store.Store, queue types.Queue, bus messaging.MessageBus, cache *cachev2.Resource, secretsProviderManager *secrets.ProviderManager) *AdhocRequestExecutor {
+func NewAdhocRequestExecutor(ctx context.Context, store store.Store, queue types.Queue, bus messaging.MessageBus, cache *cachev2.Resource, overrideCache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *AdhocRequestExecutor {
 	ctx, cancel := context.WithCancel(ctx)
 	executor := &AdhocRequestExecutor{
 		adhocQueue:             queue,
@@ -157,6 +160,7 @@ func NewAdhocRequestExecutor(ctx context.Context, store store.Store, queue types
 		ctx:                    ctx,
 		cancel:                 cancel,
 		entityCache:            cache,
+		overrideCache:          overrideCache,
 		secretsProviderManager: secretsProviderManager,
 	}
 	go executor.listenQueue(ctx)
@@ -261,7 +265,7 @@ func (a *AdhocRequestExecutor) execute(check *corev2.CheckConfig) error {
 }
 
 func (a *AdhocRequestExecutor) buildRequest(check *corev2.CheckConfig) (*corev2.CheckRequest, error) {
-	return buildRequest(check, a.store, a.secretsProviderManager)
+	return buildRequest(check, a.store, a.overrideCache, a.secretsProviderManager)
 }
 
 func publishProxyCheckRequests(e Executor, entities []*corev3.EntityConfig, check *corev2.CheckConfig) error {
@@ -362,7 +366,7 @@ func publishRoundRobinProxyCheckRequests(executor *CheckExecutor, check *corev2.
 	return nil
 }
 
-func buildRequest(check *corev2.CheckConfig, s store.Store, secretsProviderManager *secrets.ProviderManager) (*corev2.CheckRequest, error) {
+func buildRequest(check *corev2.CheckConfig, s store.Store, overrideCache *cache.Resource, secretsProviderManager *secrets.ProviderManager) (*corev2.CheckRequest, error) {
 	ctx := corev2.SetContextFromResource(context.Background(), check)
 	request := &corev2.CheckRequest{}
 	request.Config = check
@@ -437,6 +441,17 @@ func buildRequest(check *corev2.CheckConfig, s store.Store, secretsProviderManag
 		}
 	}
 
+	// Add the check overrides of the check, the agents apply the ones that
+	// select their entity
+	if overrideCache != nil {
+		for _, value := range overrideCache.Get(check.Namespace) {
+			override, ok := value.Resource.(*corev2.CheckOverride)
+			if ok && override.Check == check.Name {
+				request.Overrides = append(request.Overrides, *override)
+			}
+		}
+	}
+
 	request.Issued = time.Now().Unix()
 
 	return request, nil
@@ -29,7 +29,7 @@ func TestAdhocExecutor(t *testing.T) {
 	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
 	require.NoError(t, err)
 	pm := secrets.NewProviderManager()
-	newAdhocExec := NewAdhocRequestExecutor(context.Background(), store, &queue.Memory{}, bus, &cachev2.Resource{}, pm)
+	newAdhocExec := NewAdhocRequestExecutor(context.Background(), store, &queue.Memory{}, bus, &cachev2.Resource{}, nil, pm)
 	defer newAdhocExec.Stop()
 	assert.NoError(t, newAdhocExec.bus.Start())
 
@@ -260,6 +260,8 @@ func TestCheckBuildRequestInterval(t *testing.T) {
 	assert.NotNil(request.Hooks)
 	assert.NotEmpty(request.Hooks)
 	assert.Len(request.Hooks, 1)
+	assert.Len(request.Overrides, 1)
+	assert.Equal("override1", request.Overrides[0].Name)
 
 	check.RuntimeAssets = []string{}
 	check.CheckHooks = []corev2.HookList{}
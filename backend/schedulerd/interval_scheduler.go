@@ -7,6 +7,7 @@ import (
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	cachev2 "github.com/sensu/sensu-go/backend/store/cache/v2"
 	"github.com/sirupsen/logrus"
 )
@@ -22,11 +23,12 @@ type IntervalScheduler struct {
 	cancel                 context.CancelFunc
 	interrupt              chan *corev2.CheckConfig
 	entityCache            *cachev2.Resource
+	overrideCache          *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
 }
 
 // NewIntervalScheduler initializes an IntervalScheduler
-func NewIntervalScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, check *corev2.CheckConfig, cache *cachev2.Resource, secretsProviderManager *secrets.ProviderManager) *IntervalScheduler {
+func NewIntervalScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, check *corev2.CheckConfig, cache *cachev2.Resource, overrideCache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *IntervalScheduler {
 	sched := &IntervalScheduler{
 		store:             store,
 		bus:               bus,
@@ -39,6 +41,7 @@ func NewIntervalScheduler(ctx context.Context, store store.Store, bus messaging.
 			"scheduler_type": IntervalType.String(),
 		}),
 		entityCache:            cache,
+		overrideCache:          overrideCache,
 		secretsProviderManager: secretsProviderManager,
 	}
 	sched.ctx, sched.cancel = context.WithCancel(ctx)
@@ -70,7 +73,7 @@ func (s *IntervalScheduler) Start() {
 func (s *IntervalScheduler) start() {
 	s.logger.Info("starting new interval scheduler")
 	timer := NewIntervalTimer(s.check.Name, uint(s.check.Interval))
-	executor := NewCheckExecutor(s.bus, s.check.Namespace, s.store, s.entityCache, s.secretsProviderManager)
+	executor := NewCheckExecutor(s.bus, s.check.Namespace, s.store, s.entityCache, s.overrideCache, s.secretsProviderManager)
 
 	timer.Start()
 
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	corev3 "github.com/sensu/sensu-go/api/core/v3"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	cachev2 "github.com/sensu/sensu-go/backend/store/cache/v2"
 	"github.com/sensu/sensu-go/types"
 	"go.etcd.io/etcd/client/v3"
@@ -57,6 +59,7 @@ type Schedulerd struct {
 	errChan                chan error
 	ringPool               *ringv2.RingPool
 	entityCache            *cachev2.Resource
+	overrideCache          *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
 }
 
@@ -84,13 +87,17 @@ func New(ctx context.Context, c Config, opts ...Option) (*Schedulerd, error) {
 		secretsProviderManager: c.SecretsProviderManager,
 	}
 	s.ctx, s.cancel = context.WithCancel(ctx)
-	cache, err := cachev2.New(s.ctx, c.Client, &corev3.EntityConfig{}, true)
+	var err error
+	s.entityCache, err = cachev2.New(s.ctx, c.Client, &corev3.EntityConfig{}, true)
 	if err != nil {
 		return nil, err
 	}
-	s.entityCache = cache
-	s.checkWatcher = NewCheckWatcher(s.ctx, c.Bus, c.Store, c.RingPool, cache, s.secretsProviderManager)
-	s.adhocRequestExecutor = NewAdhocRequestExecutor(s.ctx, s.store, s.queueGetter.GetQueue(adhocQueueName), s.bus, s.entityCache, s.secretsProviderManager)
+	s.overrideCache, err = cache.New(s.ctx, c.Client, &corev2.CheckOverride{}, false)
+	if err != nil {
+		return nil, err
+	}
+	s.checkWatcher = NewCheckWatcher(s.ctx, c.Bus, c.Store, c.RingPool, s.entityCache, s.overrideCache, s.secretsProviderManager)
+	s.adhocRequestExecutor = NewAdhocRequestExecutor(s.ctx, s.store, s.queueGetter.GetQueue(adhocQueueName), s.bus, s.entityCache, s.overrideCache, s.secretsProviderManager)
 
 	for _, o := range opts {
 		if err := o(s); err != nil {
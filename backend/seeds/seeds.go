@@ -243,7 +243,7 @@ func setupClusterRoles(ctx context.Context, store storev1.Store) error {
 	// The admin ClusterRole is intended to be used within a namespace using a
 	// RoleBinding. It gives full access to most resources, including the ability
 	// to create Roles and RoleBindings within the namespace but does not allow
-	// write access to the namespace itself
+	// write access to the namespace itself or its quotas
 	admin := &types.ClusterRole{
 		ObjectMeta: corev2.NewObjectMeta("admin", ""),
 		Rules: []types.Rule{
@@ -258,6 +258,7 @@ func setupClusterRoles(ctx context.Context, store storev1.Store) error {
 				Verbs: []string{"get", "list"},
 				Resources: []string{
 					"namespaces",
+					"quotas",
 				},
 			},
 		},
@@ -280,6 +281,7 @@ func setupClusterRoles(ctx context.Context, store storev1.Store) error {
 				Verbs: []string{"get", "list"},
 				Resources: []string{
 					"namespaces",
+					"quotas",
 				},
 			},
 		},
@@ -298,6 +300,7 @@ func setupClusterRoles(ctx context.Context, store storev1.Store) error {
 				Verbs: []string{"get", "list"},
 				Resources: append(types.CommonCoreResources, []string{
 					"namespaces",
+					"quotas",
 				}...),
 			},
 		},
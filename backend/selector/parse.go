@@ -0,0 +1,185 @@
+package selector
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	identToken tokenKind = iota
+	operatorToken
+	openToken
+	closeToken
+	commaToken
+	andToken
+	eofToken
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == eofToken {
+		return "end of selector"
+	}
+	return fmt.Sprintf("%q at position %d", t.value, t.pos)
+}
+
+// reserved lists the characters that end an unquoted identifier
+const reserved = `,()[]"'=!&`
+
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '(' || c == '[':
+			tokens = append(tokens, token{kind: openToken, value: string(c), pos: i})
+			i++
+		case c == ')' || c == ']':
+			tokens = append(tokens, token{kind: closeToken, value: string(c), pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: commaToken, value: ",", pos: i})
+			i++
+		case strings.HasPrefix(input[i:], "&&"):
+			tokens = append(tokens, token{kind: andToken, value: "&&", pos: i})
+			i += 2
+		case strings.HasPrefix(input[i:], "=="), strings.HasPrefix(input[i:], "!="):
+			tokens = append(tokens, token{kind: operatorToken, value: input[i : i+2], pos: i})
+			i += 2
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(input[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quoted string at position %d", i)
+			}
+			tokens = append(tokens, token{kind: identToken, value: input[i+1 : i+1+end], pos: i})
+			i += end + 2
+		case strings.IndexByte(reserved, c) >= 0:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		default:
+			start := i
+			for i < len(input) && !unicode.IsSpace(rune(input[i])) && strings.IndexByte(reserved, input[i]) < 0 {
+				i++
+			}
+			tokens = append(tokens, token{kind: identToken, value: input[start:i], pos: start})
+		}
+	}
+	return append(tokens, token{kind: eofToken, pos: len(input)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != eofToken {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// Parse parses a selector into its list of requirements. An empty selector
+// has no requirements.
+func Parse(input string) ([]Requirement, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == eofToken {
+		return nil, nil
+	}
+
+	var requirements []Requirement
+	for {
+		r, err := p.parseRequirement()
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, r)
+
+		switch t := p.next(); t.kind {
+		case eofToken:
+			return requirements, nil
+		case commaToken, andToken:
+		default:
+			return nil, fmt.Errorf("expected ',' or '&&', got %s", t)
+		}
+	}
+}
+
+func (p *parser) parseRequirement() (Requirement, error) {
+	key := p.next()
+	if key.kind != identToken {
+		return Requirement{}, fmt.Errorf("expected a key, got %s", key)
+	}
+	r := Requirement{Key: key.value}
+
+	op := p.next()
+	switch {
+	case op.kind == operatorToken:
+		r.Operator = Operator(op.value)
+	case op.kind == identToken && (op.value == string(InOperator) || op.value == string(NotInOperator)):
+		r.Operator = Operator(op.value)
+		values, err := p.parseList()
+		if err != nil {
+			return Requirement{}, err
+		}
+		r.Values = values
+		return r, nil
+	case op.kind == identToken && op.value == string(MatchesOperator):
+		r.Operator = MatchesOperator
+	default:
+		return Requirement{}, fmt.Errorf("expected an operator after key %q, got %s", key.value, op)
+	}
+
+	value := p.next()
+	if value.kind != identToken {
+		return Requirement{}, fmt.Errorf("expected a value after %q, got %s", op.value, value)
+	}
+	r.Values = []string{value.value}
+	return r, nil
+}
+
+func (p *parser) parseList() ([]string, error) {
+	open := p.next()
+	if open.kind != openToken {
+		return nil, fmt.Errorf("expected '(' or '[', got %s", open)
+	}
+	closing := ")"
+	if open.value == "[" {
+		closing = "]"
+	}
+
+	var values []string
+	for {
+		value := p.next()
+		if value.kind != identToken {
+			return nil, fmt.Errorf("expected a value, got %s", value)
+		}
+		values = append(values, value.value)
+
+		switch t := p.next(); {
+		case t.kind == commaToken:
+		case t.kind == closeToken && t.value == closing:
+			return values, nil
+		default:
+			return nil, fmt.Errorf("expected ',' or '%s', got %s", closing, t)
+		}
+	}
+}
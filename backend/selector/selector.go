@@ -0,0 +1,133 @@
+// Package selector implements the label and field selectors accepted by the
+// list endpoints of the API.
+//
+// A selector is a comma (or &&) separated list of requirements, all of which
+// must be satisfied by a resource for it to be selected:
+//
+//	region == us-west-1, env != dev
+//	check.name in (check-cpu, check-mem) && entity.name notin [db-1]
+//	entity.subscriptions matches linux
+//
+// Values containing whitespace or reserved characters can be quoted with
+// single or double quotes.
+package selector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Operator represents the operator of a requirement
+type Operator string
+
+const (
+	// DoubleEqualSignOperator selects values equal to the given value
+	DoubleEqualSignOperator Operator = "=="
+	// NotEqualOperator selects values different from the given value
+	NotEqualOperator Operator = "!="
+	// InOperator selects values present in the given list
+	InOperator Operator = "in"
+	// NotInOperator selects values absent from the given list
+	NotInOperator Operator = "notin"
+	// MatchesOperator selects values containing the given substring
+	MatchesOperator Operator = "matches"
+)
+
+// Requirement is a single condition of a selector, applied to the value
+// associated with Key.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Matches returns whether the given set of key/values satisfies the
+// requirement. A missing key only satisfies the != and notin operators.
+func (r Requirement) Matches(set map[string]string) bool {
+	value, ok := set[r.Key]
+	switch r.Operator {
+	case DoubleEqualSignOperator:
+		return ok && value == r.Values[0]
+	case NotEqualOperator:
+		return !ok || value != r.Values[0]
+	case InOperator:
+		return ok && contains(r.Values, value)
+	case NotInOperator:
+		return !ok || !contains(r.Values, value)
+	case MatchesOperator:
+		return ok && strings.Contains(value, r.Values[0])
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Selector selects resources by their labels and fields. The zero value
+// selects everything.
+type Selector struct {
+	// Labels are the requirements applied to the labels of a resource
+	Labels []Requirement
+	// Fields are the requirements applied to the fields of a resource, as
+	// returned by its fields function
+	Fields []Requirement
+}
+
+// New parses the given label and field selectors. It returns a nil selector
+// if both are empty.
+func New(labelSelector, fieldSelector string) (*Selector, error) {
+	labels, err := Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector: %s", err)
+	}
+	fields, err := Parse(fieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field selector: %s", err)
+	}
+	if len(labels) == 0 && len(fields) == 0 {
+		return nil, nil
+	}
+	return &Selector{Labels: labels, Fields: fields}, nil
+}
+
+// Matches returns whether the given labels and fields satisfy every
+// requirement of the selector.
+func (s *Selector) Matches(labels, fields map[string]string) bool {
+	if s == nil {
+		return true
+	}
+	for _, r := range s.Labels {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	for _, r := range s.Fields {
+		if !r.Matches(fields) {
+			return false
+		}
+	}
+	return true
+}
+
+type selectorKey struct{}
+
+// ContextWithSelector returns a copy of ctx carrying the given selector.
+func ContextWithSelector(ctx context.Context, s *Selector) context.Context {
+	return context.WithValue(ctx, selectorKey{}, s)
+}
+
+// SelectorFromContext returns the selector stored in the given context, if
+// any.
+func SelectorFromContext(ctx context.Context) *Selector {
+	if s, ok := ctx.Value(selectorKey{}).(*Selector); ok {
+		return s
+	}
+	return nil
+}
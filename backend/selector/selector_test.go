@@ -0,0 +1,145 @@
+package selector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []Requirement
+		wantErr bool
+	}{
+		{
+			name:  "empty selector",
+			input: "  ",
+		},
+		{
+			name:  "equality",
+			input: "region == us-west-1",
+			want:  []Requirement{{Key: "region", Operator: DoubleEqualSignOperator, Values: []string{"us-west-1"}}},
+		},
+		{
+			name:  "inequality without spaces",
+			input: "region!=us-west-1",
+			want:  []Requirement{{Key: "region", Operator: NotEqualOperator, Values: []string{"us-west-1"}}},
+		},
+		{
+			name:  "set operators",
+			input: "check.name in (check-cpu, check-mem) && entity.name notin [db-1]",
+			want: []Requirement{
+				{Key: "check.name", Operator: InOperator, Values: []string{"check-cpu", "check-mem"}},
+				{Key: "entity.name", Operator: NotInOperator, Values: []string{"db-1"}},
+			},
+		},
+		{
+			name:  "matches and quoted values",
+			input: `entity.subscriptions matches linux, app.example.com/team == "site reliability"`,
+			want: []Requirement{
+				{Key: "entity.subscriptions", Operator: MatchesOperator, Values: []string{"linux"}},
+				{Key: "app.example.com/team", Operator: DoubleEqualSignOperator, Values: []string{"site reliability"}},
+			},
+		},
+		{
+			name:    "missing value",
+			input:   "region ==",
+			wantErr: true,
+		},
+		{
+			name:    "unknown operator",
+			input:   "region like us",
+			wantErr: true,
+		},
+		{
+			name:    "single equal sign",
+			input:   "region = us-west-1",
+			wantErr: true,
+		},
+		{
+			name:    "unbalanced list",
+			input:   "region in (us-west-1, eu-west-1]",
+			wantErr: true,
+		},
+		{
+			name:    "empty list",
+			input:   "region in ()",
+			wantErr: true,
+		},
+		{
+			name:    "missing separator",
+			input:   "region == us-west-1 env == dev",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated quote",
+			input:   `region == "us-west-1`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	labels := map[string]string{"region": "us-west-1", "env": "prod"}
+	fields := map[string]string{"check.name": "check-cpu", "check.subscriptions": "linux,web"}
+
+	tests := []struct {
+		labelSelector string
+		fieldSelector string
+		want          bool
+	}{
+		{"", "", true},
+		{"region == us-west-1", "", true},
+		{"region == eu-west-1", "", false},
+		{"region != eu-west-1, env == prod", "", true},
+		{"team != sre", "", true},
+		{"team == sre", "", false},
+		{"env in (dev, prod)", "", true},
+		{"env notin (dev, prod)", "", false},
+		{"team notin (sre)", "", true},
+		{"region matches west", "check.name == check-cpu", true},
+		{"", "check.subscriptions matches web", true},
+		{"", "check.subscriptions matches windows", false},
+		{"region == us-west-1", "check.name in [check-mem]", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.labelSelector+"|"+tt.fieldSelector, func(t *testing.T) {
+			sel, err := New(tt.labelSelector, tt.fieldSelector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, sel.Matches(labels, fields))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	sel, err := New("", "")
+	require.NoError(t, err)
+	assert.Nil(t, sel)
+
+	_, err = New("region ==", "")
+	assert.EqualError(t, err, "invalid label selector: expected a value after \"==\", got end of selector")
+
+	_, err = New("", "check.name in check-cpu")
+	assert.EqualError(t, err, "invalid field selector: expected '(' or '[', got \"check-cpu\" at position 14")
+}
+
+func TestSelectorContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, SelectorFromContext(ctx))
+
+	sel := &Selector{Labels: []Requirement{{Key: "region", Operator: DoubleEqualSignOperator, Values: []string{"us-west-1"}}}}
+	assert.Equal(t, sel, SelectorFromContext(ContextWithSelector(ctx, sel)))
+}
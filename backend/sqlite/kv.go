@@ -0,0 +1,523 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+)
+
+// kvColumns are the columns of the kv and kv_log tables, in the order
+// expected by scanKVs.
+const kvColumns = "key, create_revision, mod_revision, version, value, lease"
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// kvServer implements the etcd KV service.
+type kvServer struct {
+	pb.UnimplementedKVServer
+	s *Server
+}
+
+// writeTxn is a write transaction of the database. All its changes share the
+// same revision.
+type writeTxn struct {
+	s        *Server
+	tx       *sql.Tx
+	rev      int64
+	events   []*mvccpb.Event
+	onCommit []func()
+
+	// puts and deletes are the keys and ranges modified by the transaction,
+	// which etcd does not allow to be modified twice.
+	puts    map[string]struct{}
+	deletes [][2][]byte
+}
+
+// Range gets the keys in the range from the key-value store.
+func (k *kvServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	k.s.mu.RLock()
+	defer k.s.mu.RUnlock()
+	resp, err := k.s.rangeKeys(ctx, k.s.db, r)
+	if err != nil {
+		return nil, err
+	}
+	resp.Header = k.s.header(k.s.rev)
+	return resp, nil
+}
+
+// Put puts the given key into the key-value store.
+func (k *kvServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	var resp *pb.PutResponse
+	rev, err := k.s.write(ctx, func(t *writeTxn) (err error) {
+		resp, err = t.put(ctx, r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Header = k.s.header(rev)
+	return resp, nil
+}
+
+// DeleteRange deletes the given range from the key-value store.
+func (k *kvServer) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	var resp *pb.DeleteRangeResponse
+	rev, err := k.s.write(ctx, func(t *writeTxn) (err error) {
+		resp, err = t.deleteRange(ctx, r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Header = k.s.header(rev)
+	return resp, nil
+}
+
+// Txn processes multiple requests in a single transaction.
+func (k *kvServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	var resp *pb.TxnResponse
+	header := &pb.ResponseHeader{}
+	rev, err := k.s.write(ctx, func(t *writeTxn) error {
+		// Like etcd, all the comparisons, including the ones of the nested
+		// transactions, are evaluated before any change is made.
+		var path []bool
+		if err := t.evaluate(ctx, r, &path); err != nil {
+			return err
+		}
+		var err error
+		resp, err = t.txn(ctx, r, &path, header)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	*header = *k.s.header(rev)
+	return resp, nil
+}
+
+// Compact compacts the event history of the key-value store.
+func (k *kvServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	rev, err := k.s.compact(ctx, r.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompactionResponse{Header: k.s.header(rev)}, nil
+}
+
+// rangeKeys runs the range request against q. The caller must hold s.mu.
+func (s *Server) rangeKeys(ctx context.Context, q querier, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	if len(r.Key) == 0 {
+		return nil, rpctypes.ErrGRPCEmptyKey
+	}
+	if r.Revision > s.rev {
+		return nil, rpctypes.ErrGRPCFutureRev
+	}
+	if r.Revision > 0 && r.Revision < s.compactRev {
+		return nil, rpctypes.ErrGRPCCompacted
+	}
+
+	// The keys are read from their history when a past revision is requested
+	source := "kv"
+	var args []interface{}
+	if r.Revision > 0 && r.Revision < s.rev {
+		source = fmt.Sprintf(`(SELECT %s FROM kv_log AS l WHERE version > 0 AND mod_revision = (
+			SELECT MAX(mod_revision) FROM kv_log WHERE key = l.key AND mod_revision <= ?))`, kvColumns)
+		args = append(args, r.Revision)
+	}
+	cond, condArgs := keyRange(r.Key, r.RangeEnd)
+	args = append(args, condArgs...)
+
+	resp := &pb.RangeResponse{}
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", source, cond)
+	if err := q.QueryRowContext(ctx, query, args...).Scan(&resp.Count); err != nil {
+		return nil, err
+	}
+	if r.CountOnly {
+		return resp, nil
+	}
+
+	filters := []struct {
+		column string
+		op     string
+		value  int64
+	}{
+		{"mod_revision", ">=", r.MinModRevision},
+		{"mod_revision", "<=", r.MaxModRevision},
+		{"create_revision", ">=", r.MinCreateRevision},
+		{"create_revision", "<=", r.MaxCreateRevision},
+	}
+	for _, f := range filters {
+		if f.value != 0 {
+			cond += fmt.Sprintf(" AND %s %s ?", f.column, f.op)
+			args = append(args, f.value)
+		}
+	}
+
+	query = fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY %s", kvColumns, source, cond, orderBy(r))
+	if r.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, r.Limit+1)
+	}
+	kvs, err := scanKVs(q.QueryContext(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+	if r.Limit > 0 && int64(len(kvs)) > r.Limit {
+		kvs = kvs[:r.Limit]
+		resp.More = true
+	}
+	if r.KeysOnly {
+		for _, kv := range kvs {
+			kv.Value = nil
+		}
+	}
+	resp.Kvs = kvs
+	return resp, nil
+}
+
+// keyRange returns the condition selecting the keys in the range of an etcd
+// request: a single key when end is empty, all the keys from key onward when
+// end is "\x00", and the keys in [key, end) otherwise.
+func keyRange(key, end []byte) (string, []interface{}) {
+	switch {
+	case len(end) == 0:
+		return "key = ?", []interface{}{key}
+	case bytes.Equal(end, []byte{0}):
+		return "key >= ?", []interface{}{key}
+	default:
+		return "key >= ? AND key < ?", []interface{}{key, end}
+	}
+}
+
+// inRange tells if key is in the range of an etcd request.
+func inRange(key, start, end []byte) bool {
+	switch {
+	case len(end) == 0:
+		return bytes.Equal(key, start)
+	case bytes.Equal(end, []byte{0}):
+		return bytes.Compare(key, start) >= 0
+	default:
+		return bytes.Compare(key, start) >= 0 && bytes.Compare(key, end) < 0
+	}
+}
+
+// orderBy returns the ORDER BY clause of a range request.
+func orderBy(r *pb.RangeRequest) string {
+	order := r.SortOrder
+	// Like etcd, a sort target other than the key implies an ascending order
+	if order == pb.RangeRequest_NONE && r.SortTarget != pb.RangeRequest_KEY {
+		order = pb.RangeRequest_ASCEND
+	}
+	if order == pb.RangeRequest_NONE {
+		return "key ASC"
+	}
+	var column string
+	switch r.SortTarget {
+	case pb.RangeRequest_VERSION:
+		column = "version"
+	case pb.RangeRequest_CREATE:
+		column = "create_revision"
+	case pb.RangeRequest_MOD:
+		column = "mod_revision"
+	case pb.RangeRequest_VALUE:
+		column = "value"
+	default:
+		column = "key"
+	}
+	direction := "ASC"
+	if order == pb.RangeRequest_DESCEND {
+		direction = "DESC"
+	}
+	if column == "key" {
+		return "key " + direction
+	}
+	return fmt.Sprintf("%s %s, key ASC", column, direction)
+}
+
+// scanKVs reads the key-values of a query selecting kvColumns.
+func scanKVs(rows *sql.Rows, err error) ([]*mvccpb.KeyValue, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var kvs []*mvccpb.KeyValue
+	for rows.Next() {
+		kv := &mvccpb.KeyValue{}
+		if err := rows.Scan(&kv.Key, &kv.CreateRevision, &kv.ModRevision, &kv.Version, &kv.Value, &kv.Lease); err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, rows.Err()
+}
+
+// get returns the current value of key, or nil if it does not exist.
+func (t *writeTxn) get(ctx context.Context, key []byte) (*mvccpb.KeyValue, error) {
+	query := fmt.Sprintf("SELECT %s FROM kv WHERE key = ?", kvColumns)
+	kvs, err := scanKVs(t.tx.QueryContext(ctx, query, key))
+	if err != nil || len(kvs) == 0 {
+		return nil, err
+	}
+	return kvs[0], nil
+}
+
+func (t *writeTxn) put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	if len(r.Key) == 0 {
+		return nil, rpctypes.ErrGRPCEmptyKey
+	}
+	if r.IgnoreValue && len(r.Value) != 0 {
+		return nil, rpctypes.ErrGRPCValueProvided
+	}
+	if r.IgnoreLease && r.Lease != 0 {
+		return nil, rpctypes.ErrGRPCLeaseProvided
+	}
+	if _, ok := t.puts[string(r.Key)]; ok {
+		return nil, rpctypes.ErrGRPCDuplicateKey
+	}
+	for _, d := range t.deletes {
+		if inRange(r.Key, d[0], d[1]) {
+			return nil, rpctypes.ErrGRPCDuplicateKey
+		}
+	}
+
+	prev, err := t.get(ctx, r.Key)
+	if err != nil {
+		return nil, err
+	}
+	value, lease := r.Value, r.Lease
+	if r.IgnoreValue || r.IgnoreLease {
+		if prev == nil {
+			return nil, rpctypes.ErrGRPCKeyNotFound
+		}
+		if r.IgnoreValue {
+			value = prev.Value
+		}
+		if r.IgnoreLease {
+			lease = prev.Lease
+		}
+	}
+	if lease != 0 && !t.s.leases.exists(lease) {
+		return nil, rpctypes.ErrGRPCLeaseNotFound
+	}
+
+	kv := &mvccpb.KeyValue{
+		Key:            r.Key,
+		CreateRevision: t.rev,
+		ModRevision:    t.rev,
+		Version:        1,
+		Value:          value,
+		Lease:          lease,
+	}
+	if prev != nil {
+		kv.CreateRevision = prev.CreateRevision
+		kv.Version = prev.Version + 1
+	}
+	for _, table := range []string{"kv", "kv_log"} {
+		query := fmt.Sprintf("INSERT OR REPLACE INTO %s (%s) VALUES (?, ?, ?, ?, ?, ?)", table, kvColumns)
+		if _, err := t.tx.ExecContext(ctx, query, kv.Key, kv.CreateRevision, kv.ModRevision, kv.Version, kv.Value, kv.Lease); err != nil {
+			return nil, err
+		}
+	}
+	if t.puts == nil {
+		t.puts = make(map[string]struct{})
+	}
+	t.puts[string(r.Key)] = struct{}{}
+	t.events = append(t.events, &mvccpb.Event{Type: mvccpb.PUT, Kv: kv, PrevKv: prev})
+
+	resp := &pb.PutResponse{}
+	if r.PrevKv {
+		resp.PrevKv = prev
+	}
+	return resp, nil
+}
+
+func (t *writeTxn) deleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	if len(r.Key) == 0 {
+		return nil, rpctypes.ErrGRPCEmptyKey
+	}
+	for key := range t.puts {
+		if inRange([]byte(key), r.Key, r.RangeEnd) {
+			return nil, rpctypes.ErrGRPCDuplicateKey
+		}
+	}
+	cond, args := keyRange(r.Key, r.RangeEnd)
+	query := fmt.Sprintf("SELECT %s FROM kv WHERE %s ORDER BY key", kvColumns, cond)
+	prevs, err := scanKVs(t.tx.QueryContext(ctx, query, args...))
+	if err != nil {
+		return nil, err
+	}
+	if err := t.delete(ctx, prevs); err != nil {
+		return nil, err
+	}
+	t.deletes = append(t.deletes, [2][]byte{r.Key, r.RangeEnd})
+
+	resp := &pb.DeleteRangeResponse{Deleted: int64(len(prevs))}
+	if r.PrevKv {
+		resp.PrevKvs = prevs
+	}
+	return resp, nil
+}
+
+// delete deletes the given key-values, recording their tombstones in the
+// history of the keys.
+func (t *writeTxn) delete(ctx context.Context, prevs []*mvccpb.KeyValue) error {
+	for _, prev := range prevs {
+		if _, err := t.tx.ExecContext(ctx, "DELETE FROM kv WHERE key = ?", prev.Key); err != nil {
+			return err
+		}
+		query := fmt.Sprintf("INSERT OR REPLACE INTO kv_log (%s) VALUES (?, 0, ?, 0, NULL, 0)", kvColumns)
+		if _, err := t.tx.ExecContext(ctx, query, prev.Key, t.rev); err != nil {
+			return err
+		}
+		kv := &mvccpb.KeyValue{Key: prev.Key, ModRevision: t.rev}
+		t.events = append(t.events, &mvccpb.Event{Type: mvccpb.DELETE, Kv: kv, PrevKv: prev})
+	}
+	return nil
+}
+
+// evaluate evaluates the comparisons of r and of the transactions nested in
+// the branch it takes, appending their results to path in the order txn will
+// consume them.
+func (t *writeTxn) evaluate(ctx context.Context, r *pb.TxnRequest, path *[]bool) error {
+	succeeded, err := t.compare(ctx, r.Compare)
+	if err != nil {
+		return err
+	}
+	*path = append(*path, succeeded)
+	ops := r.Failure
+	if succeeded {
+		ops = r.Success
+	}
+	for _, op := range ops {
+		if nested := op.GetRequestTxn(); nested != nil {
+			if err := t.evaluate(ctx, nested, path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// txn runs the branch of r chosen by path. All the responses share header,
+// which is filled in once the transaction is committed.
+func (t *writeTxn) txn(ctx context.Context, r *pb.TxnRequest, path *[]bool, header *pb.ResponseHeader) (*pb.TxnResponse, error) {
+	succeeded := (*path)[0]
+	*path = (*path)[1:]
+	ops := r.Failure
+	if succeeded {
+		ops = r.Success
+	}
+	resp := &pb.TxnResponse{
+		Header:    header,
+		Succeeded: succeeded,
+		Responses: make([]*pb.ResponseOp, 0, len(ops)),
+	}
+	for _, op := range ops {
+		var result *pb.ResponseOp
+		switch req := op.Request.(type) {
+		case *pb.RequestOp_RequestRange:
+			rr, err := t.s.rangeKeys(ctx, t.tx, req.RequestRange)
+			if err != nil {
+				return nil, err
+			}
+			rr.Header = header
+			result = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseRange{ResponseRange: rr}}
+		case *pb.RequestOp_RequestPut:
+			pr, err := t.put(ctx, req.RequestPut)
+			if err != nil {
+				return nil, err
+			}
+			pr.Header = header
+			result = &pb.ResponseOp{Response: &pb.ResponseOp_ResponsePut{ResponsePut: pr}}
+		case *pb.RequestOp_RequestDeleteRange:
+			dr, err := t.deleteRange(ctx, req.RequestDeleteRange)
+			if err != nil {
+				return nil, err
+			}
+			dr.Header = header
+			result = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseDeleteRange{ResponseDeleteRange: dr}}
+		case *pb.RequestOp_RequestTxn:
+			tr, err := t.txn(ctx, req.RequestTxn, path, header)
+			if err != nil {
+				return nil, err
+			}
+			result = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: tr}}
+		default:
+			return nil, fmt.Errorf("unsupported txn operation: %T", op.Request)
+		}
+		resp.Responses = append(resp.Responses, result)
+	}
+	return resp, nil
+}
+
+// compare tells if all the comparisons hold. Like etcd, a comparison on a
+// range must hold for all its keys, and a missing key compares as a zero
+// key-value, except for its value.
+func (t *writeTxn) compare(ctx context.Context, cmps []*pb.Compare) (bool, error) {
+	for _, c := range cmps {
+		cond, args := keyRange(c.Key, c.RangeEnd)
+		query := fmt.Sprintf("SELECT %s FROM kv WHERE %s", kvColumns, cond)
+		kvs, err := scanKVs(t.tx.QueryContext(ctx, query, args...))
+		if err != nil {
+			return false, err
+		}
+		if len(kvs) == 0 {
+			if c.Target == pb.Compare_VALUE {
+				return false, nil
+			}
+			kvs = []*mvccpb.KeyValue{{}}
+		}
+		for _, kv := range kvs {
+			if !compareKV(c, kv) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func compareKV(c *pb.Compare, kv *mvccpb.KeyValue) bool {
+	var result int
+	switch c.Target {
+	case pb.Compare_VALUE:
+		result = bytes.Compare(kv.Value, c.GetValue())
+	case pb.Compare_CREATE:
+		result = compareInt64(kv.CreateRevision, c.GetCreateRevision())
+	case pb.Compare_MOD:
+		result = compareInt64(kv.ModRevision, c.GetModRevision())
+	case pb.Compare_VERSION:
+		result = compareInt64(kv.Version, c.GetVersion())
+	case pb.Compare_LEASE:
+		result = compareInt64(kv.Lease, c.GetLease())
+	}
+	switch c.Result {
+	case pb.Compare_EQUAL:
+		return result == 0
+	case pb.Compare_NOT_EQUAL:
+		return result != 0
+	case pb.Compare_GREATER:
+		return result > 0
+	case pb.Compare_LESS:
+		return result < 0
+	}
+	return false
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,263 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func TestPutGet(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx := context.Background()
+
+	put, err := client.Put(ctx, "/foo", "bar")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), put.Header.Revision)
+
+	put, err = client.Put(ctx, "/foo", "baz", clientv3.WithPrevKV())
+	require.NoError(t, err)
+	require.NotNil(t, put.PrevKv)
+	assert.Equal(t, "bar", string(put.PrevKv.Value))
+
+	get, err := client.Get(ctx, "/foo")
+	require.NoError(t, err)
+	require.Len(t, get.Kvs, 1)
+	kv := get.Kvs[0]
+	assert.Equal(t, "baz", string(kv.Value))
+	assert.Equal(t, int64(2), kv.CreateRevision)
+	assert.Equal(t, int64(3), kv.ModRevision)
+	assert.Equal(t, int64(2), kv.Version)
+	assert.Equal(t, int64(3), get.Header.Revision)
+
+	get, err = client.Get(ctx, "/foo", clientv3.WithRev(2))
+	require.NoError(t, err)
+	require.Len(t, get.Kvs, 1)
+	assert.Equal(t, "bar", string(get.Kvs[0].Value))
+
+	_, err = client.Get(ctx, "/foo", clientv3.WithRev(4))
+	assert.Equal(t, rpctypes.ErrFutureRev, err)
+
+	get, err = client.Get(ctx, "/missing")
+	require.NoError(t, err)
+	assert.Empty(t, get.Kvs)
+}
+
+func TestRange(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx := context.Background()
+
+	for _, key := range []string{"/a/3", "/a/1", "/a/2", "/b/1"} {
+		_, err := client.Put(ctx, key, key)
+		require.NoError(t, err)
+	}
+
+	get, err := client.Get(ctx, "/a/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/a/1", "/a/2", "/a/3"}, keys(get))
+	assert.Equal(t, int64(3), get.Count)
+
+	get, err = client.Get(ctx, "/a/", clientv3.WithPrefix(), clientv3.WithLimit(2))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/a/1", "/a/2"}, keys(get))
+	assert.True(t, get.More)
+	assert.Equal(t, int64(3), get.Count)
+
+	get, err = client.Get(ctx, "/a/", append(clientv3.WithLastCreate(), clientv3.WithPrefix())...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/a/2"}, keys(get))
+
+	get, err = client.Get(ctx, "/a/2", clientv3.WithFromKey(), clientv3.WithKeysOnly())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/a/2", "/a/3", "/b/1"}, keys(get))
+	assert.Empty(t, get.Kvs[0].Value)
+
+	get, err = client.Get(ctx, "/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	assert.Empty(t, get.Kvs)
+	assert.Equal(t, int64(4), get.Count)
+
+	del, err := client.Delete(ctx, "/a/", clientv3.WithPrefix(), clientv3.WithPrevKV())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), del.Deleted)
+	assert.Len(t, del.PrevKvs, 3)
+
+	get, err = client.Get(ctx, "/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/b/1"}, keys(get))
+
+	get, err = client.Get(ctx, "/", clientv3.WithPrefix(), clientv3.WithRev(del.Header.Revision-1))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/a/1", "/a/2", "/a/3", "/b/1"}, keys(get))
+}
+
+func TestTxn(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx := context.Background()
+
+	// Create the key only if it does not exist
+	create := func(value string) *clientv3.TxnResponse {
+		resp, err := client.Txn(ctx).
+			If(clientv3.Compare(clientv3.Version("/foo"), "=", 0)).
+			Then(clientv3.OpPut("/foo", value), clientv3.OpGet("/foo")).
+			Else(clientv3.OpGet("/foo")).
+			Commit()
+		require.NoError(t, err)
+		return resp
+	}
+	resp := create("bar")
+	assert.True(t, resp.Succeeded)
+	assert.Equal(t, "bar", string(resp.Responses[1].GetResponseRange().Kvs[0].Value))
+	rev := resp.Header.Revision
+
+	resp = create("baz")
+	assert.False(t, resp.Succeeded)
+	assert.Equal(t, "bar", string(resp.Responses[0].GetResponseRange().Kvs[0].Value))
+	assert.Equal(t, rev, resp.Header.Revision)
+
+	// All the changes of a transaction share the same revision
+	resp, err := client.Txn(ctx).
+		If(clientv3.Compare(clientv3.Value("/foo"), "=", "bar")).
+		Then(clientv3.OpPut("/a", "1"), clientv3.OpPut("/b", "2"), clientv3.OpDelete("/foo")).
+		Commit()
+	require.NoError(t, err)
+	assert.True(t, resp.Succeeded)
+	assert.Equal(t, rev+1, resp.Header.Revision)
+	get, err := client.Get(ctx, "/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Len(t, get.Kvs, 2)
+	for _, kv := range get.Kvs {
+		assert.Equal(t, rev+1, kv.ModRevision)
+	}
+
+	// A comparison on the value of a missing key never holds
+	resp, err = client.Txn(ctx).If(clientv3.Compare(clientv3.Value("/foo"), "!=", "bar")).Commit()
+	require.NoError(t, err)
+	assert.False(t, resp.Succeeded)
+
+	_, err = client.Txn(ctx).Then(clientv3.OpPut("/a", "1"), clientv3.OpPut("/a", "2")).Commit()
+	assert.Equal(t, rpctypes.ErrDuplicateKey, err)
+}
+
+func TestPutLease(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx := context.Background()
+
+	_, err := client.Put(ctx, "/foo", "bar", clientv3.WithLease(42))
+	assert.Equal(t, rpctypes.ErrLeaseNotFound, err)
+
+	_, err = client.Put(ctx, "/foo", "", clientv3.WithIgnoreValue())
+	assert.Equal(t, rpctypes.ErrKeyNotFound, err)
+}
+
+func TestCompact(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx := context.Background()
+
+	for _, value := range []string{"1", "2", "3"} {
+		_, err := client.Put(ctx, "/foo", value)
+		require.NoError(t, err)
+	}
+	_, err := client.Compact(ctx, 3)
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, "/foo", clientv3.WithRev(2))
+	assert.Equal(t, rpctypes.ErrCompacted, err)
+
+	get, err := client.Get(ctx, "/foo", clientv3.WithRev(3))
+	require.NoError(t, err)
+	require.Len(t, get.Kvs, 1)
+	assert.Equal(t, "2", string(get.Kvs[0].Value))
+
+	_, err = client.Compact(ctx, 3)
+	assert.Equal(t, rpctypes.ErrCompacted, err)
+}
+
+func TestPersistence(t *testing.T) {
+	tmpDir, remove := testutil.TempDir(t)
+	defer remove()
+	cfg := DefaultTestConfig(t)
+	cfg.DataDir = tmpDir
+
+	s, err := New(cfg)
+	require.NoError(t, err)
+	client := s.NewEmbeddedClient()
+	ctx := context.Background()
+
+	_, err = client.Put(ctx, "/foo", "bar")
+	require.NoError(t, err)
+	lease, err := client.Grant(ctx, 60)
+	require.NoError(t, err)
+	_ = client.Close()
+	require.NoError(t, s.Shutdown())
+
+	s, err = New(cfg)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Shutdown())
+	}()
+	client = s.NewEmbeddedClient()
+	defer client.Close()
+
+	get, err := client.Get(ctx, "/foo")
+	require.NoError(t, err)
+	require.Len(t, get.Kvs, 1)
+	assert.Equal(t, "bar", string(get.Kvs[0].Value))
+	assert.Equal(t, int64(2), get.Header.Revision)
+
+	ttl, err := client.TimeToLive(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), ttl.GrantedTTL)
+}
+
+func TestNetworkClient(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+
+	client, err := clientv3.New(clientv3.Config{Endpoints: s.GetClientURLs()})
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+
+	_, err = client.Put(ctx, "/foo", "bar")
+	require.NoError(t, err)
+	get, err := client.Get(ctx, "/foo")
+	require.NoError(t, err)
+	require.Len(t, get.Kvs, 1)
+
+	status, err := client.Status(ctx, s.GetClientURLs()[0])
+	require.NoError(t, err)
+	assert.Equal(t, status.Header.MemberId, status.Leader)
+
+	members, err := client.MemberList(ctx)
+	require.NoError(t, err)
+	require.Len(t, members.Members, 1)
+	assert.Equal(t, "default", members.Members[0].Name)
+}
+
+func keys(resp *clientv3.GetResponse) []string {
+	var keys []string
+	for _, kv := range resp.Kvs {
+		keys = append(keys, string(kv.Key))
+	}
+	return keys
+}
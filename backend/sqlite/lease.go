@@ -0,0 +1,264 @@
+package sqlite
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+)
+
+const (
+	// minLeaseTTL is the minimum TTL of a lease, in seconds, the same as the
+	// one of etcd with its default heartbeat interval and election timeout.
+	minLeaseTTL = 2
+
+	// maxLeaseTTL is the maximum TTL of a lease, in seconds.
+	maxLeaseTTL = 9000000000
+
+	// leaseExpiryInterval is the interval at which the expired leases are
+	// revoked.
+	leaseExpiryInterval = 500 * time.Millisecond
+)
+
+// leaseServer implements the etcd Lease service.
+type leaseServer struct {
+	pb.UnimplementedLeaseServer
+	s *Server
+}
+
+// lessor keeps track of the expiry of the leases. Like in etcd, the expiries
+// are not persisted: the leases are renewed for their full TTL on start.
+type lessor struct {
+	mu     sync.Mutex
+	leases map[int64]*lease
+}
+
+type lease struct {
+	ttl    int64
+	expiry time.Time
+}
+
+func newLessor() *lessor {
+	return &lessor{leases: make(map[int64]*lease)}
+}
+
+func (l *lessor) grant(id, ttl int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.leases[id] = &lease{ttl: ttl, expiry: time.Now().Add(time.Duration(ttl) * time.Second)}
+}
+
+func (l *lessor) revoke(id int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.leases, id)
+}
+
+func (l *lessor) exists(id int64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, ok := l.leases[id]
+	return ok
+}
+
+// renew renews the lease for its full TTL, and returns the TTL, or 0 if the
+// lease does not exist or has already expired.
+func (l *lessor) renew(id int64) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ls, ok := l.leases[id]
+	if !ok || time.Now().After(ls.expiry) {
+		return 0
+	}
+	ls.expiry = time.Now().Add(time.Duration(ls.ttl) * time.Second)
+	return ls.ttl
+}
+
+// timeToLive returns the remaining and granted TTLs of the lease.
+func (l *lessor) timeToLive(id int64) (remaining, granted int64, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ls, ok := l.leases[id]
+	if !ok {
+		return 0, 0, false
+	}
+	remaining = int64(math.Ceil(time.Until(ls.expiry).Seconds()))
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, ls.ttl, true
+}
+
+func (l *lessor) list() []int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ids := make([]int64, 0, len(l.leases))
+	for id := range l.leases {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (l *lessor) expired() []int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	var ids []int64
+	for id, ls := range l.leases {
+		if now.After(ls.expiry) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// LeaseGrant creates a lease which expires if the server does not receive a
+// keepAlive within a given time to live period.
+func (ls *leaseServer) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	ttl := r.TTL
+	if ttl > maxLeaseTTL {
+		return nil, rpctypes.ErrGRPCLeaseTTLTooLarge
+	}
+	if ttl < minLeaseTTL {
+		ttl = minLeaseTTL
+	}
+	id := r.ID
+	rev, err := ls.s.write(ctx, func(t *writeTxn) error {
+		if id == 0 {
+			id = int64(randomID())
+			for ls.s.leases.exists(id) {
+				id = int64(randomID())
+			}
+		} else if ls.s.leases.exists(id) {
+			return rpctypes.ErrGRPCLeaseExist
+		}
+		if _, err := t.tx.ExecContext(ctx, "INSERT INTO leases (id, ttl) VALUES (?, ?)", id, ttl); err != nil {
+			return err
+		}
+		t.onCommit = append(t.onCommit, func() { ls.s.leases.grant(id, ttl) })
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LeaseGrantResponse{Header: ls.s.header(rev), ID: id, TTL: ttl}, nil
+}
+
+// LeaseRevoke revokes a lease, deleting all the keys attached to it.
+func (ls *leaseServer) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	rev, err := ls.s.write(ctx, func(t *writeTxn) error {
+		return t.revoke(ctx, r.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LeaseRevokeResponse{Header: ls.s.header(rev)}, nil
+}
+
+// revoke deletes the lease and the keys attached to it.
+func (t *writeTxn) revoke(ctx context.Context, id int64) error {
+	if !t.s.leases.exists(id) {
+		return rpctypes.ErrGRPCLeaseNotFound
+	}
+	query := "SELECT " + kvColumns + " FROM kv WHERE lease = ? ORDER BY key"
+	kvs, err := scanKVs(t.tx.QueryContext(ctx, query, id))
+	if err != nil {
+		return err
+	}
+	if err := t.delete(ctx, kvs); err != nil {
+		return err
+	}
+	if _, err := t.tx.ExecContext(ctx, "DELETE FROM leases WHERE id = ?", id); err != nil {
+		return err
+	}
+	t.onCommit = append(t.onCommit, func() { t.s.leases.revoke(id) })
+	return nil
+}
+
+// LeaseKeepAlive keeps the leases alive by streaming keep alive requests from
+// the client to the server and streaming keep alive responses from the server
+// to the client.
+func (ls *leaseServer) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		resp := &pb.LeaseKeepAliveResponse{
+			Header: ls.s.header(ls.s.currentRevision()),
+			ID:     req.ID,
+			TTL:    ls.s.leases.renew(req.ID),
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// LeaseTimeToLive retrieves lease information.
+func (ls *leaseServer) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
+	ls.s.mu.RLock()
+	defer ls.s.mu.RUnlock()
+	resp := &pb.LeaseTimeToLiveResponse{Header: ls.s.header(ls.s.rev), ID: r.ID, TTL: -1}
+	remaining, granted, ok := ls.s.leases.timeToLive(r.ID)
+	if !ok {
+		return resp, nil
+	}
+	resp.TTL, resp.GrantedTTL = remaining, granted
+	if r.Keys {
+		rows, err := ls.s.db.QueryContext(ctx, "SELECT key FROM kv WHERE lease = ? ORDER BY key", r.ID)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var key []byte
+			if err := rows.Scan(&key); err != nil {
+				return nil, err
+			}
+			resp.Keys = append(resp.Keys, key)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// LeaseLeases lists all existing leases.
+func (ls *leaseServer) LeaseLeases(ctx context.Context, r *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
+	resp := &pb.LeaseLeasesResponse{Header: ls.s.header(ls.s.currentRevision())}
+	for _, id := range ls.s.leases.list() {
+		resp.Leases = append(resp.Leases, &pb.LeaseStatus{ID: id})
+	}
+	return resp, nil
+}
+
+// expireLeases revokes the expired leases every leaseExpiryInterval.
+func (s *Server) expireLeases() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(leaseExpiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopc:
+			return
+		case <-ticker.C:
+		}
+		for _, id := range s.leases.expired() {
+			_, err := s.write(context.Background(), func(t *writeTxn) error {
+				return t.revoke(context.Background(), id)
+			})
+			if err != nil && err != rpctypes.ErrGRPCLeaseNotFound {
+				logger.WithError(err).WithField("lease", id).Error("error revoking an expired lease")
+			}
+		}
+	}
+}
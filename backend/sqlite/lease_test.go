@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func TestLeaseRevoke(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lease, err := client.Grant(ctx, 60)
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/a", "1", clientv3.WithLease(lease.ID))
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/b", "2", clientv3.WithLease(lease.ID))
+	require.NoError(t, err)
+
+	ttl, err := client.TimeToLive(ctx, lease.ID, clientv3.WithAttachedKeys())
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), ttl.GrantedTTL)
+	assert.Len(t, ttl.Keys, 2)
+
+	wc := client.Watch(ctx, "/", clientv3.WithPrefix())
+	_, err = client.Revoke(ctx, lease.ID)
+	require.NoError(t, err)
+
+	// The keys of the lease are deleted in a single revision
+	resp := receive(t, wc)
+	require.Len(t, resp.Events, 2)
+	for _, event := range resp.Events {
+		assert.Equal(t, mvccpb.DELETE, event.Type)
+	}
+
+	_, err = client.Revoke(ctx, lease.ID)
+	assert.Equal(t, rpctypes.ErrLeaseNotFound, err)
+	ttl, err = client.TimeToLive(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), ttl.TTL)
+}
+
+func TestLeaseExpiry(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	expiring, err := client.Grant(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(minLeaseTTL), expiring.TTL)
+	kept, err := client.Grant(ctx, minLeaseTTL)
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/expiring", "1", clientv3.WithLease(expiring.ID))
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/kept", "1", clientv3.WithLease(kept.ID))
+	require.NoError(t, err)
+
+	_, err = client.KeepAlive(ctx, kept.ID)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		get, err := client.Get(ctx, "/expiring")
+		return err == nil && len(get.Kvs) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	get, err := client.Get(ctx, "/kept")
+	require.NoError(t, err)
+	assert.Len(t, get.Kvs, 1)
+}
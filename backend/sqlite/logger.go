@@ -0,0 +1,7 @@
+package sqlite
+
+import "github.com/sirupsen/logrus"
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "sensu-sqlite",
+})
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/version"
+)
+
+// maintenanceServer implements the parts of the etcd Maintenance service that
+// make sense for a single SQLite database.
+type maintenanceServer struct {
+	pb.UnimplementedMaintenanceServer
+	s *Server
+}
+
+// clusterServer implements the etcd Cluster service for a cluster of a single
+// member, which cannot be reconfigured.
+type clusterServer struct {
+	pb.UnimplementedClusterServer
+	s *Server
+}
+
+// Alarm lists the alarms of the cluster. The SQLite database never raises
+// any.
+func (m *maintenanceServer) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmResponse, error) {
+	return &pb.AlarmResponse{Header: m.s.header(m.s.currentRevision())}, nil
+}
+
+// Status gets the status of the member.
+func (m *maintenanceServer) Status(ctx context.Context, r *pb.StatusRequest) (*pb.StatusResponse, error) {
+	rev := m.s.currentRevision()
+	size := m.s.dbSize()
+	return &pb.StatusResponse{
+		Header:           m.s.header(rev),
+		Version:          version.Version,
+		DbSize:           size,
+		DbSizeInUse:      size,
+		Leader:           m.s.memberID,
+		RaftIndex:        uint64(rev),
+		RaftTerm:         1,
+		RaftAppliedIndex: uint64(rev),
+	}, nil
+}
+
+// Defragment reclaims the space left unused in the database.
+func (m *maintenanceServer) Defragment(ctx context.Context, r *pb.DefragmentRequest) (*pb.DefragmentResponse, error) {
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	if _, err := m.s.db.ExecContext(ctx, "VACUUM"); err != nil {
+		return nil, err
+	}
+	return &pb.DefragmentResponse{Header: m.s.header(m.s.rev)}, nil
+}
+
+// MemberList lists the single member of the cluster.
+func (c *clusterServer) MemberList(ctx context.Context, r *pb.MemberListRequest) (*pb.MemberListResponse, error) {
+	return &pb.MemberListResponse{
+		Header: c.s.header(c.s.currentRevision()),
+		Members: []*pb.Member{
+			{
+				ID:         c.s.memberID,
+				Name:       c.s.cfg.Name,
+				ClientURLs: c.s.cfg.AdvertiseClientURLs,
+			},
+		},
+	}, nil
+}
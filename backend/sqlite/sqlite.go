@@ -0,0 +1,493 @@
+// Package sqlite manages the SQLite database that a single sensu-backend can
+// use to store its state instead of the embedded etcd.
+//
+// The database is served through the etcd v3 API, so that the etcd client,
+// and the stores, watchers, rings and queues built on top of it, work
+// unchanged. To use it, call New(). This will open the database and start
+// serving it on the configured client URLs. The channel returned by Err()
+// should be monitored--these are terminal errors for the server.
+package sqlite
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/util/path"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/client/pkg/v3/transport"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/proxy/grpcproxy/adapter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+
+	// Register the pure Go SQLite driver
+	_ "modernc.org/sqlite"
+)
+
+const (
+	// DefaultMaxRequestBytes is the default maximum request size (1.5 MB), the
+	// same as the one of etcd.
+	DefaultMaxRequestBytes = 1.5 * (1 << 20)
+
+	// dbFile is the name of the database file, in the data directory.
+	dbFile = "sensu.db"
+
+	// grpcOverheadBytes is the room left to the gRPC framing on top of the
+	// maximum request size.
+	grpcOverheadBytes = 512 * 1024
+
+	// compactionInterval is the interval at which the history of the keys is
+	// compacted. Like the embedded etcd, only the last compactionRetention
+	// revisions are kept.
+	compactionInterval  = 5 * time.Minute
+	compactionRetention = 2
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS kv (
+	key BLOB PRIMARY KEY,
+	create_revision INTEGER NOT NULL,
+	mod_revision INTEGER NOT NULL,
+	version INTEGER NOT NULL,
+	value BLOB,
+	lease INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS kv_lease ON kv (lease) WHERE lease != 0;
+CREATE TABLE IF NOT EXISTS kv_log (
+	key BLOB NOT NULL,
+	create_revision INTEGER NOT NULL,
+	mod_revision INTEGER NOT NULL,
+	version INTEGER NOT NULL,
+	value BLOB,
+	lease INTEGER NOT NULL,
+	PRIMARY KEY (key, mod_revision)
+);
+CREATE INDEX IF NOT EXISTS kv_log_mod_revision ON kv_log (mod_revision);
+CREATE TABLE IF NOT EXISTS leases (
+	id INTEGER PRIMARY KEY,
+	ttl INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS meta (
+	name TEXT PRIMARY KEY,
+	value INTEGER NOT NULL
+);
+`
+
+// Config is a configuration for the SQLite server
+type Config struct {
+	DataDir string
+
+	// Cluster Member Name
+	Name string
+
+	AdvertiseClientURLs []string
+	ListenClientURLs    []string
+
+	ClientTLSInfo etcd.TLSInfo
+
+	MaxRequestBytes uint
+}
+
+// NewConfig returns a pointer to an initialized Config object with defaults.
+func NewConfig() *Config {
+	return &Config{
+		DataDir:         path.SystemCacheDir("sensu-backend"),
+		MaxRequestBytes: DefaultMaxRequestBytes,
+	}
+}
+
+// Server serves a SQLite database through the etcd v3 API.
+type Server struct {
+	cfg *Config
+	db  *sql.DB
+
+	// mu is held for writing by the write transactions, the compactions and
+	// the registration of the watchers, and for reading by the reads, so that
+	// the revision always matches the content of the database.
+	mu         sync.RWMutex
+	rev        int64
+	compactRev int64
+
+	clusterID uint64
+	memberID  uint64
+
+	leases   *lessor
+	watchers *watchGroup
+
+	grpc      *grpc.Server
+	listeners []net.Listener
+	errc      chan error
+	stopc     chan struct{}
+	wg        sync.WaitGroup
+}
+
+// New returns a new, configured, and running Server. Callers must ensure that
+// the running Server is cleanly shutdown before the process terminates.
+//
+// Callers should monitor the Err() channel for the running server--these are
+// terminal errors.
+func New(config *Config) (*Server, error) {
+	if err := ensureDir(config.DataDir); err != nil {
+		return nil, err
+	}
+	dsn := fmt.Sprintf("file:%s?_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)", filepath.Join(config.DataDir, dbFile))
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error opening the sqlite database: %s", err)
+	}
+	s := &Server{
+		cfg:      config,
+		db:       db,
+		leases:   newLessor(),
+		watchers: newWatchGroup(),
+		errc:     make(chan error, 1),
+		stopc:    make(chan struct{}),
+	}
+	if err := s.load(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if err := s.listen(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	s.wg.Add(2)
+	go s.expireLeases()
+	go s.autoCompact()
+
+	logger.Info("sqlite ready to serve client connections")
+
+	return s, nil
+}
+
+// load creates the schema of the database, and loads its metadata and leases.
+func (s *Server) load() error {
+	if _, err := s.db.Exec(schema); err != nil {
+		return fmt.Errorf("error creating the sqlite schema: %s", err)
+	}
+	defaults := map[string]int64{
+		"revision":         1,
+		"compact_revision": 0,
+		"cluster_id":       int64(randomID()),
+		"member_id":        int64(randomID()),
+	}
+	meta := make(map[string]int64, len(defaults))
+	for name, value := range defaults {
+		if _, err := s.db.Exec("INSERT OR IGNORE INTO meta (name, value) VALUES (?, ?)", name, value); err != nil {
+			return err
+		}
+		if err := s.db.QueryRow("SELECT value FROM meta WHERE name = ?", name).Scan(&value); err != nil {
+			return err
+		}
+		meta[name] = value
+	}
+	s.rev = meta["revision"]
+	s.compactRev = meta["compact_revision"]
+	s.clusterID = uint64(meta["cluster_id"])
+	s.memberID = uint64(meta["member_id"])
+
+	// Like etcd, the leases are renewed for their full TTL on start
+	rows, err := s.db.Query("SELECT id, ttl FROM leases")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, ttl int64
+		if err := rows.Scan(&id, &ttl); err != nil {
+			return err
+		}
+		s.leases.grant(id, ttl)
+	}
+	return rows.Err()
+}
+
+// listen serves the etcd v3 API on the listen client URLs.
+func (s *Server) listen() error {
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(int(s.cfg.MaxRequestBytes) + grpcOverheadBytes),
+		grpc.MaxSendMsgSize(math.MaxInt32),
+		grpc.MaxConcurrentStreams(math.MaxUint32),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime: 5 * time.Second,
+		}),
+	}
+	tlsInfo := (transport.TLSInfo)(s.cfg.ClientTLSInfo)
+	if !tlsInfo.Empty() {
+		tlsConfig, err := tlsInfo.ServerConfig()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s.grpc = grpc.NewServer(opts...)
+	pb.RegisterKVServer(s.grpc, &kvServer{s: s})
+	pb.RegisterWatchServer(s.grpc, &watchServer{s: s})
+	pb.RegisterLeaseServer(s.grpc, &leaseServer{s: s})
+	pb.RegisterMaintenanceServer(s.grpc, &maintenanceServer{s: s})
+	pb.RegisterClusterServer(s.grpc, &clusterServer{s: s})
+
+	for _, u := range s.cfg.ListenClientURLs {
+		lu, err := url.Parse(u)
+		if err != nil {
+			s.closeListeners()
+			return fmt.Errorf("invalid listen client url: %s", err)
+		}
+		l, err := net.Listen("tcp", lu.Host)
+		if err != nil {
+			s.closeListeners()
+			return err
+		}
+		s.listeners = append(s.listeners, l)
+	}
+	for _, l := range s.listeners {
+		go func(l net.Listener) {
+			if err := s.grpc.Serve(l); err != nil {
+				select {
+				case <-s.stopc:
+				case s.errc <- err:
+				default:
+				}
+			}
+		}(l)
+	}
+	return nil
+}
+
+func (s *Server) closeListeners() {
+	for _, l := range s.listeners {
+		_ = l.Close()
+	}
+}
+
+// Name returns the configured name for the server.
+func (s *Server) Name() string {
+	return s.cfg.Name
+}
+
+// Err returns the error channel for the server.
+func (s *Server) Err() <-chan error {
+	return s.errc
+}
+
+// Shutdown will cleanly shutdown the running server.
+func (s *Server) Shutdown() error {
+	close(s.stopc)
+	s.grpc.Stop()
+	s.wg.Wait()
+	s.watchers.close()
+	return s.db.Close()
+}
+
+// GetClientURLs gets the addresses the server is listening on.
+func (s *Server) GetClientURLs() []string {
+	results := make([]string, 0, len(s.listeners))
+	for _, l := range s.listeners {
+		results = append(results, l.Addr().String())
+	}
+	return results
+}
+
+// NewClient returns a new etcd v3 client.
+func (s *Server) NewClient() (*clientv3.Client, error) {
+	return s.NewClientContext(context.Background())
+}
+
+// NewClientContext is like NewClient, but sets the provided context on the
+// client.
+func (s *Server) NewClientContext(ctx context.Context) (*clientv3.Client, error) {
+	tlsConfig, err := ((transport.TLSInfo)(s.cfg.ClientTLSInfo)).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return clientv3.New(clientv3.Config{
+		Endpoints:   s.cfg.AdvertiseClientURLs,
+		DialTimeout: 60 * time.Second,
+		TLS:         tlsConfig,
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+		},
+		Context: ctx,
+	})
+}
+
+// NewEmbeddedClient delivers a new etcd v3 client that calls the server
+// directly, without going through the network.
+func (s *Server) NewEmbeddedClient() *clientv3.Client {
+	return s.NewEmbeddedClientWithContext(context.Background())
+}
+
+// NewEmbeddedClientWithContext is like NewEmbeddedClient, but sets the
+// provided context on the client.
+func (s *Server) NewEmbeddedClientWithContext(ctx context.Context) *clientv3.Client {
+	c := clientv3.NewCtxClient(ctx)
+
+	kvc := adapter.KvServerToKvClient(&kvServer{s: s})
+	c.KV = clientv3.NewKVFromKVClient(kvc, c)
+
+	lc := adapter.LeaseServerToLeaseClient(&leaseServer{s: s})
+	c.Lease = clientv3.NewLeaseFromLeaseClient(lc, c, time.Second)
+
+	wc := adapter.WatchServerToWatchClient(&watchServer{s: s})
+	c.Watcher = clientv3.NewWatchFromWatchClient(wc, c)
+
+	mc := adapter.MaintenanceServerToMaintenanceClient(&maintenanceServer{s: s})
+	c.Maintenance = clientv3.NewMaintenanceFromMaintenanceClient(mc, c)
+
+	clc := adapter.ClusterServerToClusterClient(&clusterServer{s: s})
+	c.Cluster = clientv3.NewClusterFromClusterClient(clc, c)
+
+	return c
+}
+
+// header returns the response header for the given revision.
+func (s *Server) header(rev int64) *pb.ResponseHeader {
+	return &pb.ResponseHeader{
+		ClusterId: s.clusterID,
+		MemberId:  s.memberID,
+		Revision:  rev,
+		RaftTerm:  1,
+	}
+}
+
+// currentRevision returns the revision of the database.
+func (s *Server) currentRevision() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rev
+}
+
+// write runs fn in a write transaction, and notifies the watchers of its
+// events once committed. It returns the revision of the database after the
+// transaction.
+func (s *Server) write(ctx context.Context, fn func(*writeTxn) error) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	t := &writeTxn{s: s, tx: tx, rev: s.rev + 1}
+	if err := fn(t); err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if len(t.events) > 0 {
+		if _, err := tx.ExecContext(ctx, "UPDATE meta SET value = ? WHERE name = 'revision'", t.rev); err != nil {
+			_ = tx.Rollback()
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	if len(t.events) > 0 {
+		s.rev = t.rev
+		s.watchers.notify(s, t.events)
+	}
+	for _, fn := range t.onCommit {
+		fn()
+	}
+	return s.rev, nil
+}
+
+// compact removes the history of the keys before rev, except for their last
+// value before it.
+func (s *Server) compact(ctx context.Context, rev int64) (int64, error) {
+	return s.write(ctx, func(t *writeTxn) error {
+		if rev <= s.compactRev {
+			return rpctypes.ErrGRPCCompacted
+		}
+		if rev > s.rev {
+			return rpctypes.ErrGRPCFutureRev
+		}
+		const query = `DELETE FROM kv_log WHERE mod_revision < ? AND (version = 0 OR EXISTS (
+			SELECT 1 FROM kv_log AS later WHERE later.key = kv_log.key AND later.mod_revision > kv_log.mod_revision AND later.mod_revision <= ?))`
+		if _, err := t.tx.ExecContext(ctx, query, rev, rev); err != nil {
+			return err
+		}
+		if _, err := t.tx.ExecContext(ctx, "UPDATE meta SET value = ? WHERE name = 'compact_revision'", rev); err != nil {
+			return err
+		}
+		t.onCommit = append(t.onCommit, func() { s.compactRev = rev })
+		return nil
+	})
+}
+
+// autoCompact compacts the history of the keys every compactionInterval.
+func (s *Server) autoCompact() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(compactionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopc:
+			return
+		case <-ticker.C:
+		}
+		rev := s.currentRevision() - compactionRetention
+		if _, err := s.compact(context.Background(), rev); err != nil && !errors.Is(err, rpctypes.ErrGRPCCompacted) {
+			logger.WithError(err).Error("error compacting the sqlite database")
+		}
+	}
+}
+
+// dbSize returns the size of the database files.
+func (s *Server) dbSize() int64 {
+	var size int64
+	for _, suffix := range []string{"", "-wal"} {
+		if fi, err := os.Stat(filepath.Join(s.cfg.DataDir, dbFile+suffix)); err == nil {
+			size += fi.Size()
+		}
+	}
+	return size
+}
+
+func ensureDir(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			if mkdirErr := os.MkdirAll(path, 0700); mkdirErr != nil {
+				return mkdirErr
+			}
+		} else {
+			return err
+		}
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("path exists and is not directory - %s", path)
+	}
+	return nil
+}
+
+// randomID returns a random, positive, non-zero ID.
+func randomID() uint64 {
+	var b [8]byte
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			panic(err)
+		}
+		if id := binary.BigEndian.Uint64(b[:]) >> 1; id != 0 {
+			return id
+		}
+	}
+}
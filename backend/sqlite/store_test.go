@@ -0,0 +1,114 @@
+// +build integration,!race
+
+package sqlite_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/backend/ringv2"
+	"github.com/sensu/sensu-go/backend/sqlite"
+	"github.com/sensu/sensu-go/backend/store"
+	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	etcdstorev2 "github.com/sensu/sensu-go/backend/store/v2/etcdstore"
+	"github.com/sensu/sensu-go/backend/store/v2/storetest"
+	"github.com/sensu/sensu-go/types"
+)
+
+// The stores, watchers, rings and queues built on the etcd client must work
+// unchanged against the SQLite server.
+
+func TestConformance(t *testing.T) {
+	logrus.SetOutput(ioutil.Discard)
+	storetest.RunConformance(t, func(t *testing.T, f func(storev2.Interface)) {
+		s, cleanup := sqlite.NewTestServer(t)
+		defer cleanup()
+		client := s.NewEmbeddedClient()
+		defer client.Close()
+		f(etcdstorev2.NewStore(client))
+	})
+}
+
+func TestStoreWatcher(t *testing.T) {
+	s, cleanup := sqlite.NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+
+	st := etcdstore.NewStore(client, s.Name())
+	require.NoError(t, st.CreateNamespace(context.Background(), types.FixtureNamespace("default")))
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), corev2.NamespaceKey, "default"))
+	defer cancel()
+	watcher := st.GetCheckConfigWatcher(ctx)
+
+	check := corev2.FixtureCheckConfig("check1")
+	require.NoError(t, st.UpdateCheckConfig(ctx, check))
+
+	select {
+	case event := <-watcher:
+		assert.Equal(t, store.WatchCreate, event.Action)
+		assert.Equal(t, "check1", event.CheckConfig.Name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no watch event")
+	}
+
+	got, err := st.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	assert.Equal(t, check.Interval, got.Interval)
+}
+
+func TestRing(t *testing.T) {
+	s, cleanup := sqlite.NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ring := ringv2.New(client, t.Name())
+	wc := ring.Watch(ctx, "test", 1, 5, "")
+
+	require.NoError(t, ring.Add(ctx, "foo", 600))
+	assert.Equal(t, ringv2.Event{Type: ringv2.EventAdd, Values: []string{"foo"}}, <-wc)
+
+	require.NoError(t, ring.Remove(ctx, "foo"))
+	assert.Equal(t, ringv2.Event{Type: ringv2.EventRemove, Values: []string{"foo"}}, <-wc)
+
+	empty, err := ring.IsEmpty(ctx)
+	require.NoError(t, err)
+	assert.True(t, empty)
+}
+
+func TestQueue(t *testing.T) {
+	s, cleanup := sqlite.NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	backendID := etcd.NewBackendIDGetter(ctx, client)
+	q := queue.New("testqueue", client, backendID)
+	for _, value := range []string{"first", "second"} {
+		require.NoError(t, q.Enqueue(ctx, value))
+	}
+	for _, value := range []string{"first", "second"} {
+		item, err := q.Dequeue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, value, item.Value())
+		require.NoError(t, item.Ack(ctx))
+	}
+}
@@ -0,0 +1,46 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// NewTestServer creates a new Server for testing purposes.
+func NewTestServer(t testing.TB) (*Server, func()) {
+	t.Helper()
+	return NewTestServerWithConfig(t, DefaultTestConfig(t))
+}
+
+// NewTestServerWithConfig creates a new Server with given config for testing
+// purposes.
+func NewTestServerWithConfig(t testing.TB, cfg *Config) (*Server, func()) {
+	t.Helper()
+	tmpDir, remove := testutil.TempDir(t)
+	cfg.DataDir = tmpDir
+
+	s, err := New(cfg)
+	require.NoError(t, err)
+	return s, func() {
+		defer remove()
+		defer func() {
+			require.NoError(t, s.Shutdown())
+		}()
+	}
+}
+
+// DefaultTestConfig creates a new Config with default values for testing
+// purposes.
+func DefaultTestConfig(t testing.TB) *Config {
+	t.Helper()
+
+	clURL := "http://127.0.0.1:0"
+
+	cfg := NewConfig()
+	cfg.AdvertiseClientURLs = []string{clURL}
+	cfg.ListenClientURLs = []string{clURL}
+	cfg.Name = "default"
+
+	return cfg
+}
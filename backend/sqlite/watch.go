@@ -0,0 +1,375 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+)
+
+const (
+	// autoWatchID asks the server to assign the ID of a new watcher.
+	autoWatchID = 0
+
+	// progressWatchID is the watch ID of the progress notifications, which
+	// concern all the watchers of a stream.
+	progressWatchID = -1
+)
+
+// watchServer implements the etcd Watch service.
+type watchServer struct {
+	pb.UnimplementedWatchServer
+	s *Server
+}
+
+// watchGroup holds the watchers notified of the changes to the database.
+type watchGroup struct {
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+}
+
+// watchStream is a Watch stream of a client, multiplexing its watchers.
+type watchStream struct {
+	s      *Server
+	stream pb.Watch_WatchServer
+
+	// sendMu serializes the responses sent by the watchers of the stream.
+	sendMu sync.Mutex
+
+	mu       sync.Mutex
+	nextID   int64
+	watchers map[int64]*watcher
+}
+
+// watcher sends the events of a key range to a watch stream. Its responses
+// are queued without bound, so that a slow client never blocks the writes.
+type watcher struct {
+	id       int64
+	key      []byte
+	end      []byte
+	startRev int64
+	prevKV   bool
+	noPut    bool
+	noDelete bool
+	stream   *watchStream
+
+	mu    sync.Mutex
+	queue []*pb.WatchResponse
+	ready chan struct{}
+	done  chan struct{}
+}
+
+func newWatchGroup() *watchGroup {
+	return &watchGroup{watchers: make(map[*watcher]struct{})}
+}
+
+func (g *watchGroup) add(w *watcher) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.watchers[w] = struct{}{}
+}
+
+func (g *watchGroup) remove(w *watcher) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.watchers, w)
+}
+
+// notify queues the events of a write transaction to the watchers of their
+// keys. The caller must hold s.mu, so that the events are queued in revision
+// order.
+func (g *watchGroup) notify(s *Server, events []*mvccpb.Event) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for w := range g.watchers {
+		if resp := w.response(s, events); resp != nil {
+			w.push(resp)
+		}
+	}
+}
+
+// close stops all the watchers.
+func (g *watchGroup) close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for w := range g.watchers {
+		w.stop()
+		delete(g.watchers, w)
+	}
+}
+
+// Watch watches for the events happening or that have happened.
+func (ws *watchServer) Watch(stream pb.Watch_WatchServer) error {
+	st := &watchStream{
+		s:        ws.s,
+		stream:   stream,
+		watchers: make(map[int64]*watcher),
+	}
+	defer st.close()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- st.recvLoop()
+	}()
+
+	select {
+	case err := <-errc:
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	case <-ws.s.stopc:
+		return rpctypes.ErrGRPCStopped
+	}
+}
+
+func (st *watchStream) recvLoop() error {
+	for {
+		req, err := st.stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch r := req.RequestUnion.(type) {
+		case *pb.WatchRequest_CreateRequest:
+			if err := st.create(r.CreateRequest); err != nil {
+				return err
+			}
+		case *pb.WatchRequest_CancelRequest:
+			st.cancel(r.CancelRequest.WatchId)
+		case *pb.WatchRequest_ProgressRequest:
+			resp := &pb.WatchResponse{
+				Header:  st.s.header(st.s.currentRevision()),
+				WatchId: progressWatchID,
+			}
+			if err := st.send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// create registers a new watcher. Its past events, if any, are queued before
+// it is added to the watch group, while holding s.mu so that no event is
+// either missed or queued twice.
+func (st *watchStream) create(r *pb.WatchCreateRequest) error {
+	s := st.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st.mu.Lock()
+	id := r.WatchId
+	if id == autoWatchID {
+		for st.watchers[st.nextID] != nil {
+			st.nextID++
+		}
+		id = st.nextID
+		st.nextID++
+	}
+	if st.watchers[id] != nil {
+		st.mu.Unlock()
+		return st.send(&pb.WatchResponse{
+			Header:       s.header(s.rev),
+			WatchId:      id,
+			Created:      true,
+			Canceled:     true,
+			CancelReason: fmt.Sprintf("watch ID %d is already in use", id),
+		})
+	}
+
+	key, end := r.Key, r.RangeEnd
+	if len(key) == 0 {
+		key = []byte{0}
+	}
+	w := &watcher{
+		id:       id,
+		key:      key,
+		end:      end,
+		startRev: r.StartRevision,
+		prevKV:   r.PrevKv,
+		stream:   st,
+		ready:    make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	for _, f := range r.Filters {
+		switch f {
+		case pb.WatchCreateRequest_NOPUT:
+			w.noPut = true
+		case pb.WatchCreateRequest_NODELETE:
+			w.noDelete = true
+		}
+	}
+	if w.startRev == 0 {
+		w.startRev = s.rev + 1
+	}
+	st.watchers[id] = w
+	st.mu.Unlock()
+
+	go w.run()
+	w.push(&pb.WatchResponse{Header: s.header(s.rev), WatchId: id, Created: true})
+
+	if w.startRev < s.compactRev {
+		w.push(&pb.WatchResponse{
+			Header:          s.header(s.rev),
+			WatchId:         id,
+			CompactRevision: s.compactRev,
+			Canceled:        true,
+		})
+		st.mu.Lock()
+		delete(st.watchers, id)
+		st.mu.Unlock()
+		return nil
+	}
+	if w.startRev <= s.rev {
+		if err := w.replay(s); err != nil {
+			return err
+		}
+	}
+	s.watchers.add(w)
+	return nil
+}
+
+func (st *watchStream) cancel(id int64) {
+	st.mu.Lock()
+	w := st.watchers[id]
+	delete(st.watchers, id)
+	st.mu.Unlock()
+	if w == nil {
+		return
+	}
+	st.s.watchers.remove(w)
+	w.push(&pb.WatchResponse{
+		Header:   st.s.header(st.s.currentRevision()),
+		WatchId:  id,
+		Canceled: true,
+	})
+}
+
+func (st *watchStream) send(resp *pb.WatchResponse) error {
+	st.sendMu.Lock()
+	defer st.sendMu.Unlock()
+	return st.stream.Send(resp)
+}
+
+// close stops all the watchers of the stream.
+func (st *watchStream) close() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	for id, w := range st.watchers {
+		st.s.watchers.remove(w)
+		w.stop()
+		delete(st.watchers, id)
+	}
+}
+
+// replay queues the events from the start revision of the watcher up to the
+// current revision, read from the history of the keys. The caller must hold
+// s.mu.
+func (w *watcher) replay(s *Server) error {
+	ctx := context.Background()
+	cond, args := keyRange(w.key, w.end)
+	query := fmt.Sprintf("SELECT %s FROM kv_log WHERE mod_revision >= ? AND %s ORDER BY mod_revision, rowid", kvColumns, cond)
+	kvs, err := scanKVs(s.db.QueryContext(ctx, query, append([]interface{}{w.startRev}, args...)...))
+	if err != nil {
+		return err
+	}
+	var events []*mvccpb.Event
+	for i, kv := range kvs {
+		event := &mvccpb.Event{Type: mvccpb.PUT, Kv: kv}
+		if kv.Version == 0 {
+			event.Type = mvccpb.DELETE
+		}
+		if w.prevKV {
+			query := fmt.Sprintf("SELECT %s FROM kv_log WHERE key = ? AND mod_revision < ? ORDER BY mod_revision DESC LIMIT 1", kvColumns)
+			prevs, err := scanKVs(s.db.QueryContext(ctx, query, kv.Key, kv.ModRevision))
+			if err != nil {
+				return err
+			}
+			if len(prevs) > 0 && prevs[0].Version > 0 {
+				event.PrevKv = prevs[0]
+			}
+		}
+		events = append(events, event)
+		// The events are sent grouped by revision
+		if i == len(kvs)-1 || kvs[i+1].ModRevision != kv.ModRevision {
+			if resp := w.response(s, events); resp != nil {
+				w.push(resp)
+			}
+			events = nil
+		}
+	}
+	return nil
+}
+
+// response returns the response notifying the watcher of the events of a
+// revision, or nil if none concerns it.
+func (w *watcher) response(s *Server, events []*mvccpb.Event) *pb.WatchResponse {
+	var selected []*mvccpb.Event
+	for _, event := range events {
+		if event.Kv.ModRevision < w.startRev || !inRange(event.Kv.Key, w.key, w.end) {
+			continue
+		}
+		if (event.Type == mvccpb.PUT && w.noPut) || (event.Type == mvccpb.DELETE && w.noDelete) {
+			continue
+		}
+		if !w.prevKV && event.PrevKv != nil {
+			event = &mvccpb.Event{Type: event.Type, Kv: event.Kv}
+		}
+		selected = append(selected, event)
+	}
+	if len(selected) == 0 {
+		return nil
+	}
+	return &pb.WatchResponse{
+		Header:  s.header(selected[0].Kv.ModRevision),
+		WatchId: w.id,
+		Events:  selected,
+	}
+}
+
+// push queues a response of the watcher.
+func (w *watcher) push(resp *pb.WatchResponse) {
+	w.mu.Lock()
+	w.queue = append(w.queue, resp)
+	w.mu.Unlock()
+	select {
+	case w.ready <- struct{}{}:
+	default:
+	}
+}
+
+// run sends the queued responses of the watcher, until it is stopped or
+// canceled.
+func (w *watcher) run() {
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-w.ready:
+		}
+		w.mu.Lock()
+		queue := w.queue
+		w.queue = nil
+		w.mu.Unlock()
+		for _, resp := range queue {
+			if err := w.stream.send(resp); err != nil || resp.Canceled {
+				return
+			}
+		}
+	}
+}
+
+func (w *watcher) stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	select {
+	case <-w.done:
+	default:
+		close(w.done)
+	}
+}
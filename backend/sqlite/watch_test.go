@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func receive(t *testing.T, wc clientv3.WatchChan) clientv3.WatchResponse {
+	t.Helper()
+	select {
+	case resp, ok := <-wc:
+		require.True(t, ok, "watch channel closed")
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("no watch response")
+	}
+	return clientv3.WatchResponse{}
+}
+
+func TestWatch(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wc := client.Watch(ctx, "/a/", clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithCreatedNotify())
+	assert.True(t, receive(t, wc).Created)
+
+	_, err := client.Put(ctx, "/b/1", "ignored")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/a/1", "foo")
+	require.NoError(t, err)
+	_, err = client.Txn(ctx).Then(clientv3.OpPut("/a/1", "bar"), clientv3.OpPut("/a/2", "baz")).Commit()
+	require.NoError(t, err)
+	_, err = client.Delete(ctx, "/a/1")
+	require.NoError(t, err)
+
+	resp := receive(t, wc)
+	require.Len(t, resp.Events, 1)
+	assert.True(t, resp.Events[0].IsCreate())
+	assert.Nil(t, resp.Events[0].PrevKv)
+
+	// The events of a transaction are received together
+	resp = receive(t, wc)
+	require.Len(t, resp.Events, 2)
+	assert.True(t, resp.Events[0].IsModify())
+	assert.Equal(t, "foo", string(resp.Events[0].PrevKv.Value))
+	assert.Equal(t, "/a/2", string(resp.Events[1].Kv.Key))
+
+	resp = receive(t, wc)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, mvccpb.DELETE, resp.Events[0].Type)
+	assert.Equal(t, "bar", string(resp.Events[0].PrevKv.Value))
+}
+
+func TestWatchHistory(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	put, err := client.Put(ctx, "/foo", "1")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/foo", "2")
+	require.NoError(t, err)
+
+	wc := client.Watch(ctx, "/foo", clientv3.WithRev(put.Header.Revision))
+	_, err = client.Put(ctx, "/foo", "3")
+	require.NoError(t, err)
+
+	var values []string
+	for len(values) < 3 {
+		for _, event := range receive(t, wc).Events {
+			values = append(values, string(event.Kv.Value))
+		}
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, values)
+}
+
+func TestWatchCompacted(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, value := range []string{"1", "2", "3"} {
+		_, err := client.Put(ctx, "/foo", value)
+		require.NoError(t, err)
+	}
+	_, err := client.Compact(ctx, 4)
+	require.NoError(t, err)
+
+	resp := receive(t, client.Watch(ctx, "/foo", clientv3.WithRev(2)))
+	assert.Equal(t, rpctypes.ErrCompacted, resp.Err())
+	assert.Equal(t, int64(4), resp.CompactRevision)
+}
+
+func TestWatchCancel(t *testing.T) {
+	s, cleanup := NewTestServer(t)
+	defer cleanup()
+	client := s.NewEmbeddedClient()
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wc := client.Watch(ctx, "/foo", clientv3.WithCreatedNotify())
+	assert.True(t, receive(t, wc).Created)
+	cancel()
+
+	select {
+	case _, ok := <-wc:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch channel not closed")
+	}
+}
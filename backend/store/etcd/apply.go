@@ -0,0 +1,201 @@
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/etcd/kvc"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// applyEntry is a resource of a batch being applied.
+type applyEntry struct {
+	resource corev2.Resource
+	key      string
+	value    []byte
+	action   store.ApplyAction
+
+	// prev is the stored value, and revision its modification revision, 0
+	// when the resource does not exist
+	prev     []byte
+	revision int64
+}
+
+// ApplyResources creates or updates the given resources in a transaction per
+// namespace, which only succeeds if none of its resources were modified since
+// they were read. If a transaction fails, the namespaces already written are
+// restored to their previous state, so that all or none of the resources are
+// applied.
+func (s *Store) ApplyResources(ctx context.Context, resources []corev2.Resource, dryRun bool) ([]store.ApplyAction, error) {
+	var namespaces []string
+	batches := map[string][]*applyEntry{}
+	entries := make([]*applyEntry, 0, len(resources))
+	for _, resource := range resources {
+		if err := resource.Validate(); err != nil {
+			return nil, &store.ErrNotValid{Err: err}
+		}
+		key := store.KeyFromResource(resource)
+		value, err := marshal(resource)
+		if err != nil {
+			return nil, &store.ErrEncode{Key: key, Err: err}
+		}
+		namespace := resource.GetObjectMeta().Namespace
+		for _, entry := range batches[namespace] {
+			if entry.key == key {
+				return nil, &store.ErrNotValid{Err: fmt.Errorf("%s is given more than once", key)}
+			}
+		}
+		if _, ok := batches[namespace]; !ok {
+			namespaces = append(namespaces, namespace)
+		}
+		entry := &applyEntry{resource: resource, key: key, value: value}
+		batches[namespace] = append(batches[namespace], entry)
+		entries = append(entries, entry)
+	}
+
+	for _, namespace := range namespaces {
+		if len(batches[namespace]) > maxTxnOps {
+			return nil, &store.ErrNotValid{
+				Err: fmt.Errorf("at most %d resources per namespace can be applied at once", maxTxnOps),
+			}
+		}
+		if err := s.readApplyEntries(ctx, namespace, batches[namespace]); err != nil {
+			return nil, err
+		}
+		if err := s.enforceApplyQuotas(ctx, namespace, batches[namespace]); err != nil {
+			return nil, err
+		}
+	}
+
+	actions := make([]store.ApplyAction, 0, len(entries))
+	for _, entry := range entries {
+		actions = append(actions, entry.action)
+	}
+	if dryRun {
+		return actions, nil
+	}
+
+	revisions := make([]int64, 0, len(namespaces))
+	for i, namespace := range namespaces {
+		revision, err := s.writeApplyEntries(ctx, namespace, batches[namespace])
+		if err == nil {
+			revisions = append(revisions, revision)
+			continue
+		}
+		for j := i - 1; j >= 0; j-- {
+			if rerr := s.restoreApplyEntries(ctx, batches[namespaces[j]], revisions[j]); rerr != nil {
+				return nil, fmt.Errorf("%s, and the resources of the namespace %q could not be restored: %s", err, namespaces[j], rerr)
+			}
+		}
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// applyTxn commits a transaction made of the operations if the comparisons
+// hold, and returns the cause of the failure otherwise.
+func (s *Store) applyTxn(ctx context.Context, comparator *kvc.Comparator, ops []clientv3.Op) (*clientv3.TxnResponse, error) {
+	var resp *clientv3.TxnResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = s.client.Txn(ctx).If(
+			comparator.Cmp()...,
+		).Then(
+			ops...,
+		).Else(
+			comparator.Failure()...,
+		).Commit()
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Succeeded {
+		return nil, comparator.Error(resp)
+	}
+	return resp, nil
+}
+
+// readApplyEntries reads the stored values of the entries of a namespace,
+// at the same revision, and determines their actions.
+func (s *Store) readApplyEntries(ctx context.Context, namespace string, entries []*applyEntry) error {
+	ops := make([]clientv3.Op, 0, len(entries))
+	for _, entry := range entries {
+		ops = append(ops, clientv3.OpGet(entry.key))
+	}
+	resp, err := s.applyTxn(ctx, kvc.Comparisons(kvc.NamespaceExists(namespace)), ops)
+	if err != nil {
+		return err
+	}
+
+	for i, entry := range entries {
+		kvs := resp.Responses[i].GetResponseRange().Kvs
+		if len(kvs) == 0 {
+			entry.action = store.ApplyCreated
+			continue
+		}
+		entry.prev, entry.revision = kvs[0].Value, kvs[0].ModRevision
+
+		// Encoded values can't be compared, since maps are encoded in any
+		// order
+		stored := reflect.New(reflect.TypeOf(entry.resource).Elem()).Interface()
+		if err := unmarshal(entry.prev, stored); err != nil {
+			return &store.ErrDecode{Key: entry.key, Err: err}
+		}
+		if proto.Equal(stored.(proto.Message), entry.resource.(proto.Message)) {
+			entry.action = store.ApplyUnchanged
+		} else {
+			entry.action = store.ApplyUpdated
+		}
+	}
+	return nil
+}
+
+// writeApplyEntries writes the changed entries of a namespace, provided none
+// of the entries were modified since they were read, and returns the revision
+// of the write.
+func (s *Store) writeApplyEntries(ctx context.Context, namespace string, entries []*applyEntry) (int64, error) {
+	predicates := []kvc.Predicate{kvc.NamespaceExists(namespace)}
+	var ops []clientv3.Op
+	for _, entry := range entries {
+		predicates = append(predicates, kvc.KeyModRevision(entry.key, entry.revision))
+		if entry.action != store.ApplyUnchanged {
+			ops = append(ops, clientv3.OpPut(entry.key, string(entry.value)))
+		}
+	}
+	if len(ops) == 0 {
+		return 0, nil
+	}
+	resp, err := s.applyTxn(ctx, kvc.Comparisons(predicates...), ops)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Header.Revision, nil
+}
+
+// restoreApplyEntries restores the entries of a namespace written at the given
+// revision to their previous values, provided they were not modified since.
+func (s *Store) restoreApplyEntries(ctx context.Context, entries []*applyEntry, revision int64) error {
+	var predicates []kvc.Predicate
+	var ops []clientv3.Op
+	for _, entry := range entries {
+		switch entry.action {
+		case store.ApplyCreated:
+			ops = append(ops, clientv3.OpDelete(entry.key))
+		case store.ApplyUpdated:
+			ops = append(ops, clientv3.OpPut(entry.key, string(entry.prev)))
+		default:
+			continue
+		}
+		predicates = append(predicates, kvc.KeyModRevision(entry.key, revision))
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+	_, err := s.applyTxn(ctx, kvc.Comparisons(predicates...), ops)
+	return err
+}
@@ -0,0 +1,105 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyResources(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := context.WithValue(context.Background(), corev2.NamespaceKey, "default")
+		check := corev2.FixtureCheckConfig("check")
+		role := corev2.FixtureClusterRole("role")
+
+		actions, err := s.ApplyResources(ctx, []corev2.Resource{check, role}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []store.ApplyAction{store.ApplyCreated, store.ApplyCreated}, actions)
+		_, err = s.GetCheckConfigByName(ctx, "check")
+		require.NoError(t, err)
+
+		updated := corev2.FixtureCheckConfig("check")
+		updated.Interval = 120
+		added := corev2.FixtureCheckConfig("added")
+		actions, err = s.ApplyResources(ctx, []corev2.Resource{updated, role, added}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []store.ApplyAction{store.ApplyUpdated, store.ApplyUnchanged, store.ApplyCreated}, actions)
+		stored, err := s.GetCheckConfigByName(ctx, "check")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(120), stored.Interval)
+	})
+}
+
+func TestApplyResourcesDryRun(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := context.WithValue(context.Background(), corev2.NamespaceKey, "default")
+		actions, err := s.ApplyResources(ctx, []corev2.Resource{corev2.FixtureCheckConfig("check")}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []store.ApplyAction{store.ApplyCreated}, actions)
+
+		stored, err := s.GetCheckConfigByName(ctx, "check")
+		require.NoError(t, err)
+		assert.Nil(t, stored)
+	})
+}
+
+func TestApplyResourcesAllOrNothing(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := context.WithValue(context.Background(), corev2.NamespaceKey, "default")
+		check := corev2.FixtureCheckConfig("check")
+
+		invalid := corev2.FixtureCheckConfig("invalid")
+		invalid.Interval = 0
+		_, err := s.ApplyResources(ctx, []corev2.Resource{check, invalid}, false)
+		assert.IsType(t, &store.ErrNotValid{}, err)
+
+		_, err = s.ApplyResources(ctx, []corev2.Resource{check, corev2.FixtureCheckConfig("check")}, false)
+		assert.IsType(t, &store.ErrNotValid{}, err)
+
+		missing := corev2.FixtureCheckConfig("missing")
+		missing.Namespace = "missing"
+		_, err = s.ApplyResources(ctx, []corev2.Resource{check, missing}, false)
+		assert.IsType(t, &store.ErrNamespaceMissing{}, err)
+
+		stored, err := s.GetCheckConfigByName(ctx, "check")
+		require.NoError(t, err)
+		assert.Nil(t, stored)
+	})
+}
+
+func TestApplyResourcesRestore(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := context.WithValue(context.Background(), corev2.NamespaceKey, "default")
+		require.NoError(t, s.UpdateCheckConfig(ctx, corev2.FixtureCheckConfig("updated")))
+
+		updated := corev2.FixtureCheckConfig("updated")
+		updated.Interval = 120
+		entries := []*applyEntry{}
+		for _, check := range []*corev2.CheckConfig{updated, corev2.FixtureCheckConfig("created")} {
+			value, err := marshal(check)
+			require.NoError(t, err)
+			entries = append(entries, &applyEntry{resource: check, key: store.KeyFromResource(check), value: value})
+		}
+		require.NoError(t, s.readApplyEntries(ctx, "default", entries))
+		revision, err := s.writeApplyEntries(ctx, "default", entries)
+		require.NoError(t, err)
+
+		// Entries that were not read again can't be written
+		_, err = s.writeApplyEntries(ctx, "default", entries)
+		assert.IsType(t, &store.ErrPreconditionFailed{}, err)
+
+		require.NoError(t, s.restoreApplyEntries(ctx, entries, revision))
+		stored, err := s.GetCheckConfigByName(ctx, "updated")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(60), stored.Interval)
+		stored, err = s.GetCheckConfigByName(ctx, "created")
+		require.NoError(t, err)
+		assert.Nil(t, stored)
+	})
+}
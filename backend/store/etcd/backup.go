@@ -0,0 +1,458 @@
+package etcd
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store/etcd/kvc"
+	"github.com/sensu/sensu-go/version"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// BackupFormatVersion is the version of the format of the backups produced by
+// Backup. Restore accepts backups of this version or older.
+const BackupFormatVersion = 1
+
+const (
+	// backupPageSize is the number of keys read at once while taking a backup
+	backupPageSize = 500
+
+	// restoreBatchSize is the number of keys written per transaction while
+	// restoring a backup; etcd limits transactions to 128 operations by
+	// default.
+	restoreBatchSize = 100
+
+	// restorePutOverhead is a bound on the size that a put operation adds to
+	// a transaction request, besides its key and value.
+	restorePutOverhead = 64
+
+	// restoreMaxRequestBytes is the default etcd request size limit, 1.5 MiB.
+	restoreMaxRequestBytes = 3 << 19
+)
+
+// ErrBackupCorrupted is returned when a backup fails its integrity check.
+var ErrBackupCorrupted = errors.New("backup is corrupted")
+
+// ErrStoreNotEmpty is returned when restoring a backup over existing Sensu
+// data, unless asked to replace it.
+var ErrStoreNotEmpty = errors.New("the store already contains Sensu data")
+
+// ErrRestoreIncomplete is returned when restoring a backup fails after the
+// store was modified. The store then holds part of the backup, and the
+// restoration must be run again, replacing the existing data.
+var ErrRestoreIncomplete = errors.New("the backup was only partially restored")
+
+// BackupHeader describes a backup. It is the first record of the backup.
+type BackupHeader struct {
+	// FormatVersion is the version of the backup format
+	FormatVersion int `json:"format_version"`
+
+	// SensuVersion is the version of the Sensu backend that took the backup
+	SensuVersion string `json:"sensu_version"`
+
+	// DatabaseVersion is the version of the database when the backup was
+	// taken, as set by the migrations
+	DatabaseVersion int `json:"database_version"`
+
+	// EnterpriseDatabaseVersion is the version of the enterprise database
+	// when the backup was taken
+	EnterpriseDatabaseVersion int `json:"enterprise_database_version"`
+
+	// Revision is the etcd revision of the snapshot
+	Revision int64 `json:"revision"`
+
+	// CreatedAt is the time the backup was taken at
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// backupEntry is a key of the snapshot.
+type backupEntry struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+
+	// TTL is the number of seconds the key had left to live when the backup
+	// was taken, if it was attached to a lease
+	TTL int64 `json:"ttl,omitempty"`
+}
+
+// backupTrailer closes a backup, allowing its integrity to be verified.
+type backupTrailer struct {
+	// Entries is the number of entries of the backup
+	Entries int `json:"entries"`
+
+	// SHA256 is the checksum of the header and entries records
+	SHA256 string `json:"sha256"`
+}
+
+// backupRecord is a line of a backup, holding exactly one of its fields.
+type backupRecord struct {
+	Header  *BackupHeader  `json:"header,omitempty"`
+	Entry   *backupEntry   `json:"entry,omitempty"`
+	Trailer *backupTrailer `json:"trailer,omitempty"`
+}
+
+// Backup writes a consistent snapshot of all the Sensu keys to w, as a gzipped
+// stream of JSON records: a header, the keys and a trailer holding their
+// checksum. The keys attached to a lease are saved with the time they had
+// left to live.
+func Backup(ctx context.Context, client *clientv3.Client, w io.Writer) (*BackupHeader, error) {
+	// Read the database versions first, the revision of this read is the one
+	// of the whole snapshot
+	var resp *clientv3.GetResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = client.Get(ctx, path.Join(EtcdRoot, DatabaseVersionKey))
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	header := &BackupHeader{
+		FormatVersion: BackupFormatVersion,
+		SensuVersion:  version.Semver(),
+		Revision:      resp.Header.Revision,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if header.DatabaseVersion, err = parseVersion(resp); err != nil {
+		return nil, err
+	}
+	err = kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = client.Get(ctx, path.Join(EtcdRoot, EnterpriseDatabaseVersionKey), clientv3.WithRev(header.Revision))
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if header.EnterpriseDatabaseVersion, err = parseVersion(resp); err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	enc := newRecordEncoder(gz)
+	if err := enc.encode(backupRecord{Header: header}); err != nil {
+		return nil, err
+	}
+
+	ttls := map[clientv3.LeaseID]int64{}
+	key := EtcdRoot + "/"
+	rangeEnd := clientv3.GetPrefixRangeEnd(key)
+	entries := 0
+	for {
+		opts := []clientv3.OpOption{
+			clientv3.WithRange(rangeEnd),
+			clientv3.WithRev(header.Revision),
+			clientv3.WithLimit(backupPageSize),
+		}
+		err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+			resp, err = client.Get(ctx, key, opts...)
+			return kvc.RetryRequest(n, err)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, kv := range resp.Kvs {
+			entry := &backupEntry{Key: string(kv.Key), Value: kv.Value}
+			if kv.Lease != 0 {
+				id := clientv3.LeaseID(kv.Lease)
+				ttl, ok := ttls[id]
+				if !ok {
+					lease, err := client.TimeToLive(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+					ttl = lease.TTL
+					ttls[id] = ttl
+				}
+				if ttl <= 0 {
+					// The lease expired since the snapshot revision
+					continue
+				}
+				entry.TTL = ttl
+			}
+			if err := enc.encode(backupRecord{Entry: entry}); err != nil {
+				return nil, err
+			}
+			entries++
+		}
+
+		if !resp.More || len(resp.Kvs) == 0 {
+			break
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+
+	trailer := &backupTrailer{Entries: entries, SHA256: enc.checksum()}
+	if err := enc.encode(backupRecord{Trailer: trailer}); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func parseVersion(resp *clientv3.GetResponse) (int, error) {
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	var v int
+	if _, err := fmt.Sscanf(string(resp.Kvs[0].Value), "%d", &v); err != nil {
+		return 0, fmt.Errorf("error getting database version: %s", err)
+	}
+	return v, nil
+}
+
+// VerifyBackup reads the backup from r and checks its integrity and that it
+// can be restored by this version of Sensu. It returns the header of the
+// backup.
+func VerifyBackup(r io.Reader) (*BackupHeader, error) {
+	return readBackup(r, func(*BackupHeader) {}, func(*backupEntry) error { return nil })
+}
+
+// RestoreOptions configure the restoration of a backup.
+type RestoreOptions struct {
+	// Replace allows the existing Sensu data to be deleted before the backup
+	// is restored
+	Replace bool
+
+	// MaxRequestBytes is the etcd request size limit, which bounds the size
+	// of the transactions writing the backup. It defaults to the etcd
+	// default.
+	MaxRequestBytes int
+}
+
+// Restore restores the backup read from r, which is verified before the store
+// is modified. The keys that were attached to a lease get a new lease with the
+// time they had left to live, minus the time elapsed since the backup; the ones
+// that would have expired since are not restored. The migrations are then run
+// to bring backups of older versions up to date.
+//
+// Restoring is not atomic: the existing data is deleted, when replaced, and
+// the backup is then written in several transactions. The backup is checked
+// before the store is modified, including that each of its entries fits in a
+// transaction, but if writing fails anyway, the error wraps
+// ErrRestoreIncomplete and the restoration must be run again with Replace.
+func Restore(ctx context.Context, client *clientv3.Client, r io.ReadSeeker, opts RestoreOptions) (*BackupHeader, error) {
+	maxBytes := opts.MaxRequestBytes
+	if maxBytes <= 0 {
+		maxBytes = restoreMaxRequestBytes
+	}
+	_, err := readBackup(r, func(*BackupHeader) {}, func(entry *backupEntry) error {
+		if size := restorePutSize(entry); size > maxBytes {
+			return fmt.Errorf("the %s key (%d bytes) exceeds the %d bytes etcd request size limit", entry.Key, size, maxBytes)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var resp *clientv3.GetResponse
+	err = kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = client.Get(ctx, EtcdRoot+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Count > 0 {
+		if !opts.Replace {
+			return nil, ErrStoreNotEmpty
+		}
+		if _, err := client.Delete(ctx, EtcdRoot+"/", clientv3.WithPrefix()); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		header   *BackupHeader
+		elapsed  int64
+		leases   = map[int64]clientv3.LeaseID{}
+		ops      []clientv3.Op
+		opsBytes int
+		written  int
+	)
+	flush := func() error {
+		if len(ops) == 0 {
+			return nil
+		}
+		if _, err := client.Txn(ctx).Then(ops...).Commit(); err != nil {
+			return err
+		}
+		written += len(ops)
+		ops = ops[:0]
+		opsBytes = 0
+		return nil
+	}
+	incomplete := func(err error) error {
+		if resp.Count == 0 && written == 0 {
+			return err
+		}
+		return fmt.Errorf("%w (%d keys written): %s", ErrRestoreIncomplete, written, err)
+	}
+	header, err = readBackup(r, func(h *BackupHeader) {
+		elapsed = int64(time.Since(h.CreatedAt) / time.Second)
+	}, func(entry *backupEntry) error {
+		var putOpts []clientv3.OpOption
+		if entry.TTL > 0 {
+			ttl := entry.TTL - elapsed
+			if ttl <= 0 {
+				return nil
+			}
+			lease, ok := leases[ttl]
+			if !ok {
+				resp, err := client.Grant(ctx, ttl)
+				if err != nil {
+					return err
+				}
+				lease = resp.ID
+				leases[ttl] = lease
+			}
+			putOpts = append(putOpts, clientv3.WithLease(lease))
+		}
+		size := restorePutSize(entry)
+		if opsBytes+size > maxBytes {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		ops = append(ops, clientv3.OpPut(entry.Key, string(entry.Value), putOpts...))
+		opsBytes += size
+		if len(ops) == restoreBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, incomplete(err)
+	}
+	if err := flush(); err != nil {
+		return nil, incomplete(err)
+	}
+
+	if err := MigrateDB(ctx, client, Migrations); err != nil {
+		return nil, incomplete(err)
+	}
+	if len(EnterpriseMigrations) > 0 {
+		if err := MigrateEnterpriseDB(ctx, client, EnterpriseMigrations); err != nil {
+			return nil, incomplete(err)
+		}
+	}
+	return header, nil
+}
+
+// restorePutSize returns the size that writing entry adds to a transaction.
+func restorePutSize(entry *backupEntry) int {
+	return len(entry.Key) + len(entry.Value) + restorePutOverhead
+}
+
+// readBackup reads the records of a backup, calling onHeader with its header
+// and onEntry for each of its entries, and returns the header once the trailer
+// has been checked.
+func readBackup(r io.Reader, onHeader func(*BackupHeader), onEntry func(*backupEntry) error) (*BackupHeader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", ErrBackupCorrupted, err)
+	}
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	checksum := sha256.New()
+	var header *BackupHeader
+	entries := 0
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var record backupRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, fmt.Errorf("%s: %s", ErrBackupCorrupted, err)
+		}
+		switch {
+		case record.Header != nil:
+			if header != nil {
+				return nil, fmt.Errorf("%s: duplicate header", ErrBackupCorrupted)
+			}
+			header = record.Header
+			if err := checkBackupHeader(header); err != nil {
+				return nil, err
+			}
+			onHeader(header)
+		case record.Entry != nil:
+			if header == nil {
+				return nil, fmt.Errorf("%s: missing header", ErrBackupCorrupted)
+			}
+			if err := onEntry(record.Entry); err != nil {
+				return nil, err
+			}
+			entries++
+		case record.Trailer != nil:
+			if header == nil {
+				return nil, fmt.Errorf("%s: missing header", ErrBackupCorrupted)
+			}
+			if record.Trailer.Entries != entries || record.Trailer.SHA256 != hex.EncodeToString(checksum.Sum(nil)) {
+				return nil, fmt.Errorf("%s: checksum mismatch", ErrBackupCorrupted)
+			}
+			return header, nil
+		default:
+			return nil, fmt.Errorf("%s: unknown record", ErrBackupCorrupted)
+		}
+		_, _ = checksum.Write(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %s", ErrBackupCorrupted, err)
+	}
+	return nil, fmt.Errorf("%s: missing trailer", ErrBackupCorrupted)
+}
+
+func checkBackupHeader(header *BackupHeader) error {
+	if header.FormatVersion > BackupFormatVersion {
+		return fmt.Errorf("backup format version %d is not supported by this version of Sensu", header.FormatVersion)
+	}
+	if header.DatabaseVersion >= len(Migrations) {
+		return fmt.Errorf("backup of database version %d is newer than this version of Sensu", header.DatabaseVersion)
+	}
+	if header.EnterpriseDatabaseVersion >= len(EnterpriseMigrations) {
+		return fmt.Errorf("backup of enterprise database version %d is newer than this version of Sensu", header.EnterpriseDatabaseVersion)
+	}
+	return nil
+}
+
+// recordEncoder writes backup records as lines of JSON, hashing all of them but
+// the trailer.
+type recordEncoder struct {
+	w    io.Writer
+	hash hash.Hash
+}
+
+func newRecordEncoder(w io.Writer) *recordEncoder {
+	return &recordEncoder{w: w, hash: sha256.New()}
+}
+
+func (e *recordEncoder) encode(record backupRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if record.Trailer == nil {
+		_, _ = e.hash.Write(line)
+	}
+	_, err = e.w.Write(append(line, '\n'))
+	return err
+}
+
+func (e *recordEncoder) checksum() string {
+	return hex.EncodeToString(e.hash.Sum(nil))
+}
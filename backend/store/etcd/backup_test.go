@@ -0,0 +1,185 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func testWithBackupClients(t *testing.T, f func(src, dst *clientv3.Client)) {
+	srcEtcd, srcCleanup := etcd.NewTestEtcd(t)
+	defer srcCleanup()
+	dstEtcd, dstCleanup := etcd.NewTestEtcd(t)
+	defer dstCleanup()
+
+	src := srcEtcd.NewEmbeddedClient()
+	defer src.Close()
+	dst := dstEtcd.NewEmbeddedClient()
+	defer dst.Close()
+
+	f(src, dst)
+}
+
+func TestBackupRestore(t *testing.T) {
+	testWithBackupClients(t, func(src, dst *clientv3.Client) {
+		ctx := context.Background()
+		require.NoError(t, MigrateDB(ctx, src, Migrations))
+		_, err := src.Put(ctx, path.Join(EtcdRoot, "checks", "default", "check-cpu"), "cpu")
+		require.NoError(t, err)
+		lease, err := src.Grant(ctx, 3600)
+		require.NoError(t, err)
+		_, err = src.Put(ctx, path.Join(EtcdRoot, "silenced", "default", "foo"), "silenced", clientv3.WithLease(lease.ID))
+		require.NoError(t, err)
+		_, err = src.Put(ctx, "/not-sensu/key", "value")
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		header, err := Backup(ctx, src, &buf)
+		require.NoError(t, err)
+		assert.Equal(t, BackupFormatVersion, header.FormatVersion)
+		assert.Equal(t, len(Migrations)-1, header.DatabaseVersion)
+
+		verified, err := VerifyBackup(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, header.Revision, verified.Revision)
+
+		_, err = Restore(ctx, dst, bytes.NewReader(buf.Bytes()), RestoreOptions{})
+		require.NoError(t, err)
+
+		resp, err := dst.Get(ctx, path.Join(EtcdRoot, "checks", "default", "check-cpu"))
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.Equal(t, "cpu", string(resp.Kvs[0].Value))
+
+		resp, err = dst.Get(ctx, path.Join(EtcdRoot, "silenced", "default", "foo"))
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.NotZero(t, resp.Kvs[0].Lease)
+		ttl, err := dst.TimeToLive(ctx, clientv3.LeaseID(resp.Kvs[0].Lease))
+		require.NoError(t, err)
+		assert.InDelta(t, 3600, ttl.TTL, 10)
+
+		resp, err = dst.Get(ctx, "/not-sensu/key")
+		require.NoError(t, err)
+		assert.Empty(t, resp.Kvs)
+
+		version, err := GetDatabaseVersion(ctx, dst)
+		require.NoError(t, err)
+		assert.Equal(t, len(Migrations)-1, version)
+
+		// Restoring over existing data requires the replace option
+		_, err = Restore(ctx, dst, bytes.NewReader(buf.Bytes()), RestoreOptions{})
+		assert.Equal(t, ErrStoreNotEmpty, err)
+
+		_, err = dst.Put(ctx, path.Join(EtcdRoot, "checks", "default", "check-mem"), "mem")
+		require.NoError(t, err)
+		_, err = Restore(ctx, dst, bytes.NewReader(buf.Bytes()), RestoreOptions{Replace: true})
+		require.NoError(t, err)
+		resp, err = dst.Get(ctx, path.Join(EtcdRoot, "checks", "default", "check-mem"))
+		require.NoError(t, err)
+		assert.Empty(t, resp.Kvs)
+	})
+}
+
+func TestRestoreCorruptedBackup(t *testing.T) {
+	testWithBackupClients(t, func(src, dst *clientv3.Client) {
+		ctx := context.Background()
+		require.NoError(t, MigrateDB(ctx, src, Migrations))
+		_, err := src.Put(ctx, path.Join(EtcdRoot, "checks", "default", "check-cpu"), "cpu")
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		_, err = Backup(ctx, src, &buf)
+		require.NoError(t, err)
+
+		// Alter the value of an entry without updating the checksum
+		gz, err := gzip.NewReader(&buf)
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(gz)
+		require.NoError(t, err)
+		content = bytes.Replace(content, []byte(`"Y3B1"`), []byte(`"bWVt"`), 1)
+		var corrupted bytes.Buffer
+		w := gzip.NewWriter(&corrupted)
+		_, err = w.Write(content)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		_, err = Restore(ctx, dst, bytes.NewReader(corrupted.Bytes()), RestoreOptions{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), ErrBackupCorrupted.Error())
+
+		// Nothing was written
+		resp, err := dst.Get(ctx, EtcdRoot+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+		require.NoError(t, err)
+		assert.Zero(t, resp.Count)
+
+		// A truncated backup is rejected too
+		_, err = VerifyBackup(bytes.NewReader(buf.Bytes()[:buf.Len()/2]))
+		assert.Error(t, err)
+	})
+}
+
+func TestRestoreRequestSize(t *testing.T) {
+	testWithBackupClients(t, func(src, dst *clientv3.Client) {
+		ctx := context.Background()
+		require.NoError(t, MigrateDB(ctx, src, Migrations))
+		for i := 0; i < 20; i++ {
+			key := path.Join(EtcdRoot, "checks", "default", fmt.Sprintf("check-%d", i))
+			_, err := src.Put(ctx, key, strings.Repeat("x", 1000))
+			require.NoError(t, err)
+		}
+
+		var buf bytes.Buffer
+		_, err := Backup(ctx, src, &buf)
+		require.NoError(t, err)
+
+		// An entry larger than a request is rejected before the existing data
+		// is replaced
+		_, err = dst.Put(ctx, path.Join(EtcdRoot, "checks", "default", "check-mem"), "mem")
+		require.NoError(t, err)
+		_, err = Restore(ctx, dst, bytes.NewReader(buf.Bytes()), RestoreOptions{Replace: true, MaxRequestBytes: 1000})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "request size limit")
+		assert.NotErrorIs(t, err, ErrRestoreIncomplete)
+		resp, err := dst.Get(ctx, EtcdRoot+"/", clientv3.WithPrefix())
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.Equal(t, "mem", string(resp.Kvs[0].Value))
+
+		// The entries are written in transactions within the limit
+		_, err = Restore(ctx, dst, bytes.NewReader(buf.Bytes()), RestoreOptions{Replace: true, MaxRequestBytes: 4096})
+		require.NoError(t, err)
+		resp, err = dst.Get(ctx, path.Join(EtcdRoot, "checks", "default")+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+		require.NoError(t, err)
+		assert.Equal(t, int64(20), resp.Count)
+	})
+}
+
+func TestRestoreNewerBackup(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	enc := newRecordEncoder(w)
+	require.NoError(t, enc.encode(backupRecord{Header: &BackupHeader{
+		FormatVersion:   BackupFormatVersion,
+		DatabaseVersion: len(Migrations),
+	}}))
+	require.NoError(t, enc.encode(backupRecord{Trailer: &backupTrailer{SHA256: enc.checksum()}}))
+	require.NoError(t, w.Close())
+
+	_, err := VerifyBackup(&buf)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), ErrBackupCorrupted.Error())
+	assert.Contains(t, err.Error(), "newer than this version of Sensu")
+}
@@ -92,5 +92,9 @@ func (s *Store) UpdateCheckConfig(ctx context.Context, check *corev2.CheckConfig
 		return &store.ErrNotValid{Err: err}
 	}
 
+	if err := s.enforceQuota(ctx, check.Namespace, check, getCheckConfigPath(check)); err != nil {
+		return err
+	}
+
 	return CreateOrUpdate(ctx, s.client, getCheckConfigPath(check), check.Namespace, check)
 }
@@ -283,6 +283,9 @@ func (s *Store) UpdateEntity(ctx context.Context, e *corev2.Entity) error {
 	configReq := storev2.NewResourceRequestFromResource(ctx, cfg)
 	stateKey := etcdstore.StoreKey(stateReq)
 	configKey := etcdstore.StoreKey(configReq)
+	if err := s.enforceQuota(ctx, namespace, e, configKey); err != nil {
+		return err
+	}
 	wrappedState, err := wrap.Resource(state)
 	if err != nil {
 		return &store.ErrEncode{Err: err}
@@ -266,6 +266,12 @@ func (s *Store) UpdateEvent(ctx context.Context, event *corev2.Event) (*corev2.E
 		return nil, nil, err
 	}
 
+	if prevEvent == nil {
+		if err := s.enforceQuota(ctx, event.Entity.Namespace, event, getEventPath(event)); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if err := updateEventHistory(event, prevEvent); err != nil {
 		return nil, nil, &store.ErrNotValid{Err: err}
 	}
@@ -0,0 +1,202 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/etcd/kvc"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	handlerExecutionsPathPrefix = "handler_executions"
+
+	// maxHandlerExecutions is the number of executions kept for each handler
+	// of a check, per entity. The oldest execution is overwritten by the next
+	// one.
+	maxHandlerExecutions = 10
+
+	// handlerExecutionLeaseBuckets is the number of leases granted per TTL
+	// period: executions recorded in the same bucket share a lease, and live
+	// up to a bucket longer than their TTL.
+	handlerExecutionLeaseBuckets = 10
+)
+
+var (
+	handlerExecutionKeyBuilder = store.NewKeyBuilder(handlerExecutionsPathPrefix)
+)
+
+// handlerExecutionLease is a lease shared by the executions recorded with a
+// given TTL, until it is renewed.
+type handlerExecutionLease struct {
+	id      clientv3.LeaseID
+	renewAt time.Time
+}
+
+// handlerExecutionLeases caches the shared leases of handler executions, by
+// TTL.
+type handlerExecutionLeases struct {
+	mu     sync.Mutex
+	leases map[int64]handlerExecutionLease
+}
+
+func getHandlerExecutionsPrefix(execution *corev2.HandlerExecution) string {
+	return handlerExecutionKeyBuilder.WithNamespace(execution.Namespace).Build(
+		execution.Entity,
+		execution.Check,
+		execution.Pipeline,
+		execution.Workflow,
+		execution.Name,
+	) + "/"
+}
+
+// GetHandlerExecutionsPath gets the path of the handler executions for the
+// given entity and check.
+func GetHandlerExecutionsPath(ctx context.Context, entityName, checkName string) string {
+	return handlerExecutionKeyBuilder.WithContext(ctx).WithExactMatch().Build(entityName, checkName)
+}
+
+// CreateHandlerExecution records the given handler execution, which expires
+// after the given TTL, in seconds. Only the last executions of each handler of
+// a check are kept, per entity, so the number of records is bounded.
+func (s *Store) CreateHandlerExecution(ctx context.Context, execution *corev2.HandlerExecution, ttl int64) error {
+	if execution.Namespace == "" || execution.Entity == "" || execution.Check == "" {
+		return &store.ErrNotValid{Err: errors.New("must specify namespace, entity and check name")}
+	}
+	if ttl <= 0 {
+		return &store.ErrNotValid{Err: errors.New("ttl must be greater than 0")}
+	}
+
+	key, err := s.handlerExecutionSlot(ctx, execution)
+	if err != nil {
+		return err
+	}
+	bytes, err := marshal(execution)
+	if err != nil {
+		return &store.ErrEncode{Key: key, Err: err}
+	}
+
+	lease, err := s.handlerExecutionLease(ctx, ttl)
+	if err != nil {
+		return err
+	}
+
+	comparator := kvc.Comparisons(
+		kvc.NamespaceExists(execution.Namespace),
+	)
+	op := clientv3.OpPut(key, string(bytes), clientv3.WithLease(lease))
+
+	return kvc.Txn(ctx, s.client, comparator, op)
+}
+
+// handlerExecutionSlot returns the key the given execution is stored at: a
+// free slot of its handler, or the slot of its oldest execution.
+func (s *Store) handlerExecutionSlot(ctx context.Context, execution *corev2.HandlerExecution) (string, error) {
+	prefix := getHandlerExecutionsPrefix(execution)
+
+	var resp *clientv3.GetResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = s.client.Get(ctx, prefix, clientv3.WithPrefix())
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	used := make(map[string]bool, len(resp.Kvs))
+	oldestKey := ""
+	var oldest int64
+	for _, kv := range resp.Kvs {
+		key := string(kv.Key)
+		used[key] = true
+		stored := &corev2.HandlerExecution{}
+		if err := unmarshal(kv.Value, stored); err != nil {
+			// Overwrite records that can't be decoded first
+			oldestKey, oldest = key, math.MinInt64
+			continue
+		}
+		if oldestKey == "" || stored.Executed < oldest {
+			oldestKey, oldest = key, stored.Executed
+		}
+	}
+	for i := 0; i < maxHandlerExecutions; i++ {
+		key := prefix + strconv.Itoa(i)
+		if !used[key] {
+			return key, nil
+		}
+	}
+	return oldestKey, nil
+}
+
+// handlerExecutionLease returns a lease expiring after at least the given TTL,
+// in seconds, shared with the other executions recorded shortly before.
+func (s *Store) handlerExecutionLease(ctx context.Context, ttl int64) (clientv3.LeaseID, error) {
+	s.executionLeases.mu.Lock()
+	defer s.executionLeases.mu.Unlock()
+
+	now := time.Now()
+	if lease, ok := s.executionLeases.leases[ttl]; ok && now.Before(lease.renewAt) {
+		return lease.id, nil
+	}
+
+	bucket := ttl / handlerExecutionLeaseBuckets
+	if bucket < 1 {
+		bucket = 1
+	}
+	var resp *clientv3.LeaseGrantResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = s.client.Grant(ctx, ttl+bucket)
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if s.executionLeases.leases == nil {
+		s.executionLeases.leases = make(map[int64]handlerExecutionLease)
+	}
+	s.executionLeases.leases[ttl] = handlerExecutionLease{
+		id:      resp.ID,
+		renewAt: now.Add(time.Duration(bucket) * time.Second),
+	}
+	return resp.ID, nil
+}
+
+// GetHandlerExecutions returns the recorded handler executions for the given
+// entity and check, in the namespace stored in ctx, most recent first.
+func (s *Store) GetHandlerExecutions(ctx context.Context, entityName, checkName string) ([]*corev2.HandlerExecution, error) {
+	if entityName == "" || checkName == "" {
+		return nil, &store.ErrNotValid{Err: errors.New("must specify entity and check name")}
+	}
+
+	var resp *clientv3.GetResponse
+	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = s.client.Get(ctx, GetHandlerExecutionsPath(ctx, entityName, checkName), clientv3.WithPrefix())
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	executions := make([]*corev2.HandlerExecution, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		execution := &corev2.HandlerExecution{}
+		if err := unmarshal(kv.Value, execution); err != nil {
+			return nil, &store.ErrDecode{Key: string(kv.Key), Err: err}
+		}
+		executions = append(executions, execution)
+	}
+
+	sort.SliceStable(executions, func(i, j int) bool {
+		return executions[i].Executed > executions[j].Executed
+	})
+
+	return executions, nil
+}
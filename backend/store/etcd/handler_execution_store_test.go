@@ -0,0 +1,88 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+func TestHandlerExecutionStorage(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		event := corev2.FixtureEvent("entity1", "check1")
+		ctx := context.WithValue(context.Background(), corev2.NamespaceKey, event.Entity.Namespace)
+
+		executions, err := s.GetHandlerExecutions(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		assert.Empty(t, executions)
+
+		first := corev2.NewHandlerExecution(event, "slack")
+		first.Executed = 1
+		require.NoError(t, s.CreateHandlerExecution(ctx, first, 60))
+
+		second := corev2.NewHandlerExecution(event, "pagerduty")
+		second.Executed = 2
+		second.Status = 1
+		require.NoError(t, s.CreateHandlerExecution(ctx, second, 60))
+
+		// Executions of another check are not returned
+		other := corev2.NewHandlerExecution(corev2.FixtureEvent("entity1", "check10"), "slack")
+		require.NoError(t, s.CreateHandlerExecution(ctx, other, 60))
+
+		executions, err = s.GetHandlerExecutions(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		require.Len(t, executions, 2)
+		assert.Equal(t, "pagerduty", executions[0].Name)
+		assert.Equal(t, int32(1), executions[0].Status)
+		assert.Equal(t, "slack", executions[1].Name)
+
+		// The namespace must exist
+		missing := corev2.NewHandlerExecution(event, "slack")
+		missing.Namespace = "missing"
+		err = s.CreateHandlerExecution(ctx, missing, 60)
+		assert.IsType(t, &store.ErrNamespaceMissing{}, err)
+
+		// A TTL is required
+		assert.Error(t, s.CreateHandlerExecution(ctx, first, 0))
+	})
+}
+
+func TestHandlerExecutionStorageIsBounded(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		event := corev2.FixtureEvent("entity1", "check1")
+		ctx := context.WithValue(context.Background(), corev2.NamespaceKey, event.Entity.Namespace)
+
+		for i := 1; i <= maxHandlerExecutions+2; i++ {
+			execution := corev2.NewHandlerExecution(event, "slack")
+			execution.Executed = int64(i)
+			require.NoError(t, s.CreateHandlerExecution(ctx, execution, 60))
+		}
+
+		// Only the last executions are kept
+		executions, err := s.GetHandlerExecutions(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		require.Len(t, executions, maxHandlerExecutions)
+		assert.Equal(t, int64(maxHandlerExecutions+2), executions[0].Executed)
+		assert.Equal(t, int64(3), executions[maxHandlerExecutions-1].Executed)
+	})
+}
+
+func TestHandlerExecutionLeaseIsShared(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		first, err := s.handlerExecutionLease(context.Background(), 60)
+		require.NoError(t, err)
+		second, err := s.handlerExecutionLease(context.Background(), 60)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+
+		other, err := s.handlerExecutionLease(context.Background(), 120)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, other)
+	})
+}
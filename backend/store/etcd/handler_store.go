@@ -64,5 +64,9 @@ func (s *Store) UpdateHandler(ctx context.Context, handler *corev2.Handler) erro
 		return &store.ErrNotValid{Err: err}
 	}
 
+	if err := s.enforceQuota(ctx, handler.Namespace, handler, getHandlerPath(handler)); err != nil {
+		return err
+	}
+
 	return CreateOrUpdate(ctx, s.client, getHandlerPath(handler), handler.Namespace, handler)
 }
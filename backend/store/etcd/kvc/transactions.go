@@ -233,3 +233,44 @@ func (k *keyIsNotFound) Error(resp *etcdserverpb.ResponseOp) error {
 func (k *keyIsNotFound) IsNil() bool {
 	return k == nil
 }
+
+//
+// keyModRevision ensures the provided key was last modified at the given
+// revision, or does not exist if the revision is 0
+//
+type keyModRevision struct {
+	name     string
+	revision int64
+}
+
+func KeyModRevision(name string, revision int64) *keyModRevision {
+	if name == "" {
+		return nil
+	}
+	return &keyModRevision{name: name, revision: revision}
+}
+
+func (k *keyModRevision) Cmp() clientv3.Cmp {
+	return clientv3.Compare(
+		clientv3.ModRevision(k.name), "=", k.revision,
+	)
+}
+
+func (k *keyModRevision) Failure() clientv3.Op {
+	return clientv3.OpGet(k.name)
+}
+
+func (k *keyModRevision) Error(resp *etcdserverpb.ResponseOp) error {
+	var revision int64
+	if kvs := resp.GetResponseRange().Kvs; len(kvs) > 0 {
+		revision = kvs[0].ModRevision
+	}
+	if revision != k.revision {
+		return &store.ErrPreconditionFailed{Key: k.name}
+	}
+	return nil
+}
+
+func (k *keyModRevision) IsNil() bool {
+	return k == nil
+}
@@ -0,0 +1,163 @@
+package etcd
+
+import (
+	"context"
+	"fmt"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/etcd/kvc"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+var (
+	quotaKeyBuilder = store.NewKeyBuilder(corev2.QuotasResource)
+
+	// quotaResourceKeyBuilders build the keys of the resources limited by
+	// quotas, by RBAC name
+	quotaResourceKeyBuilders = map[string]store.KeyBuilder{
+		"entities": entityConfigKeyBuilder,
+		"checks":   checkKeyBuilder,
+		"handlers": handlerKeyBuilder,
+		"silenced": silencedKeyBuilder,
+		"events":   eventKeyBuilder,
+	}
+)
+
+// CheckQuota returns an ErrQuotaExceeded error if creating a resource, given
+// by its RBAC name, in the namespace would exceed one of its quotas. Like
+// enforceQuota, the check is best-effort under concurrent creations.
+func (s *Store) CheckQuota(ctx context.Context, namespace, resource string) error {
+	usage, err := s.getQuotaLimits(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	return s.checkQuotaCount(ctx, usage, resource, 1)
+}
+
+// GetQuotaUsage returns the limits of the quotas of the namespace, and the
+// number of resources they limit.
+func (s *Store) GetQuotaUsage(ctx context.Context, namespace string) (*corev2.QuotaUsage, error) {
+	usage, err := s.getQuotaLimits(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for i := range usage.Resources {
+		keyBuilder := quotaResourceKeyBuilders[usage.Resources[i].Resource]
+		count, err := Count(ctx, s.client, keyBuilder.WithNamespace(namespace).Build(""))
+		if err != nil {
+			return nil, err
+		}
+		usage.Resources[i].Used = count
+	}
+	return usage, nil
+}
+
+// getQuotaLimits returns the limits of the quotas of the namespace, without
+// counting the resources.
+func (s *Store) getQuotaLimits(ctx context.Context, namespace string) (*corev2.QuotaUsage, error) {
+	quotas := []*corev2.Quota{}
+	if namespace == "" {
+		// Cluster-wide resources have no quotas
+		return corev2.NewQuotaUsage(namespace, quotas), nil
+	}
+	keyBuilderFunc := func(ctx context.Context, name string) string {
+		return quotaKeyBuilder.WithNamespace(namespace).Build("")
+	}
+	if err := List(ctx, s.client, keyBuilderFunc, &quotas, &store.SelectionPredicate{}); err != nil {
+		return nil, err
+	}
+	return corev2.NewQuotaUsage(namespace, quotas), nil
+}
+
+// checkQuotaCount returns an ErrQuotaExceeded error if creating n resources of
+// the given RBAC name would exceed the limits.
+func (s *Store) checkQuotaCount(ctx context.Context, usage *corev2.QuotaUsage, resource string, n int64) error {
+	limit := usage.Limit(resource)
+	if limit == 0 || n == 0 {
+		return nil
+	}
+	keyBuilder := quotaResourceKeyBuilders[resource]
+	count, err := Count(ctx, s.client, keyBuilder.WithNamespace(usage.Namespace).Build(""))
+	if err != nil {
+		return err
+	}
+	if count+n > int64(limit) {
+		return &store.ErrQuotaExceeded{
+			Namespace: usage.Namespace,
+			Reason:    fmt.Sprintf("at most %d %s are allowed", limit, resource),
+		}
+	}
+	return nil
+}
+
+// checkQuotaInterval returns an ErrQuotaExceeded error if the resource is a
+// check scheduled more frequently than allowed.
+func checkQuotaInterval(usage *corev2.QuotaUsage, resource corev2.Resource) error {
+	check, ok := resource.(*corev2.CheckConfig)
+	if !ok {
+		return nil
+	}
+	if err := usage.CheckInterval(check); err != nil {
+		return &store.ErrQuotaExceeded{Namespace: usage.Namespace, Reason: err.Error()}
+	}
+	return nil
+}
+
+// enforceQuota returns an ErrQuotaExceeded error if writing the resource at
+// the given key would exceed a quota of the namespace. Updating a resource
+// never exceeds the limits on the number of resources.
+//
+// The limits on the number of resources are best-effort: the resources are
+// counted before, and outside of, the transaction writing the resource, so
+// concurrent creations can each pass the check and together exceed a limit by
+// the number of concurrent writers.
+func (s *Store) enforceQuota(ctx context.Context, namespace string, resource corev2.Resource, key string) error {
+	usage, err := s.getQuotaLimits(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if err := checkQuotaInterval(usage, resource); err != nil {
+		return err
+	}
+	if usage.Limit(resource.RBACName()) == 0 {
+		return nil
+	}
+
+	var resp *clientv3.GetResponse
+	err = kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = s.client.Get(ctx, key, clientv3.WithCountOnly())
+		return kvc.RetryRequest(n, err)
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Count > 0 {
+		return nil
+	}
+	return s.checkQuotaCount(ctx, usage, resource.RBACName(), 1)
+}
+
+// enforceApplyQuotas returns an ErrQuotaExceeded error if applying the entries
+// of a namespace would exceed one of its quotas.
+func (s *Store) enforceApplyQuotas(ctx context.Context, namespace string, entries []*applyEntry) error {
+	usage, err := s.getQuotaLimits(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	created := map[string]int64{}
+	for _, entry := range entries {
+		if err := checkQuotaInterval(usage, entry.resource); err != nil {
+			return err
+		}
+		if entry.action == store.ApplyCreated {
+			created[entry.resource.RBACName()]++
+		}
+	}
+	for _, resource := range corev2.QuotaResources {
+		if err := s.checkQuotaCount(ctx, usage, resource, created[resource]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
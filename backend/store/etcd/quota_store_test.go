@@ -0,0 +1,68 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaStore(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := context.WithValue(context.Background(), corev2.NamespaceKey, "default")
+
+		// Without quotas, nothing is limited
+		require.NoError(t, s.CheckQuota(ctx, "default", "handlers"))
+
+		quota := &corev2.Quota{
+			ObjectMeta:       corev2.NewObjectMeta("quota", "default"),
+			Handlers:         1,
+			MinCheckInterval: 60,
+		}
+		require.NoError(t, s.CreateOrUpdateResource(ctx, quota))
+
+		handler := corev2.FixtureHandler("handler1")
+		require.NoError(t, s.UpdateHandler(ctx, handler))
+
+		// Updating an existing handler doesn't count as a new one
+		require.NoError(t, s.UpdateHandler(ctx, handler))
+
+		err := s.UpdateHandler(ctx, corev2.FixtureHandler("handler2"))
+		if _, ok := err.(*store.ErrQuotaExceeded); !ok {
+			t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+		}
+		err = s.CreateResource(ctx, corev2.FixtureHandler("handler2"))
+		if _, ok := err.(*store.ErrQuotaExceeded); !ok {
+			t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+		}
+
+		check := corev2.FixtureCheckConfig("check")
+		check.Interval = 10
+		err = s.UpdateCheckConfig(ctx, check)
+		if _, ok := err.(*store.ErrQuotaExceeded); !ok {
+			t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+		}
+		check.Interval = 60
+		require.NoError(t, s.UpdateCheckConfig(ctx, check))
+
+		usage, err := s.GetQuotaUsage(ctx, "default")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"quota"}, usage.Quotas)
+		assert.Equal(t, uint32(60), usage.MinCheckInterval)
+		for _, resource := range usage.Resources {
+			switch resource.Resource {
+			case "handlers":
+				assert.Equal(t, int64(1), resource.Used)
+				assert.Equal(t, uint32(1), resource.Limit)
+			case "checks":
+				assert.Equal(t, int64(1), resource.Used)
+				assert.Equal(t, uint32(0), resource.Limit)
+			}
+		}
+	})
+}
@@ -20,6 +20,9 @@ func (s *Store) CreateResource(ctx context.Context, resource corev2.Resource) er
 
 	key := store.KeyFromResource(resource)
 	namespace := resource.GetObjectMeta().Namespace
+	if err := s.enforceQuota(ctx, namespace, resource, key); err != nil {
+		return err
+	}
 
 	msg, ok := resource.(proto.Message)
 	if !ok {
@@ -38,6 +41,9 @@ func (s *Store) CreateOrUpdateResource(ctx context.Context, resource corev2.Reso
 
 	key := store.KeyFromResource(resource)
 	namespace := resource.GetObjectMeta().Namespace
+	if err := s.enforceQuota(ctx, namespace, resource, key); err != nil {
+		return err
+	}
 	return CreateOrUpdate(ctx, s.client, key, namespace, resource)
 }
 
@@ -111,6 +117,9 @@ func (s *Store) PatchResource(ctx context.Context, resource corev2.Resource, nam
 	if err := resource.Validate(); err != nil {
 		return err
 	}
+	if err := s.enforceQuota(ctx, resource.GetObjectMeta().Namespace, resource, key); err != nil {
+		return err
+	}
 
 	valueComparison := kvc.KeyHasValue(key, value)
 	return UpdateWithComparisons(ctx, s.client, key, resource, valueComparison)
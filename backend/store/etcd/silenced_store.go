@@ -215,6 +215,10 @@ func (s *Store) UpdateSilencedEntry(ctx context.Context, silenced *corev2.Silenc
 		silenced.ExpireAt = start.Add(time.Duration(silenced.Expire) * time.Second).Unix()
 	}
 
+	if err := s.enforceQuota(ctx, silenced.Namespace, silenced, GetSilencedPath(ctx, silenced.Name)); err != nil {
+		return err
+	}
+
 	silencedBytes, err := proto.Marshal(silenced)
 	if err != nil {
 		return &store.ErrEncode{Err: err}
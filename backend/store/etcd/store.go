@@ -24,8 +24,9 @@ const (
 
 // Store is an implementation of the sensu-go/backend/store.Store iface.
 type Store struct {
-	client         *clientv3.Client
-	keepalivesPath string
+	client          *clientv3.Client
+	keepalivesPath  string
+	executionLeases handlerExecutionLeases
 }
 
 // NewStore creates a new Store.
@@ -135,14 +136,8 @@ func List(ctx context.Context, client *clientv3.Client, keyBuilder KeyBuilderFn,
 	}
 	v = v.Elem()
 
-	opts := []clientv3.OpOption{
-		clientv3.WithLimit(pred.Limit),
-		clientv3.WithSerializable(),
-	}
-
 	keyPrefix := keyBuilder(ctx, "")
 	rangeEnd := clientv3.GetPrefixRangeEnd(keyPrefix)
-	opts = append(opts, clientv3.WithRange(rangeEnd))
 
 	key := keyPrefix
 	if pred.Continue != "" {
@@ -153,53 +148,83 @@ func List(ctx context.Context, client *clientv3.Client, keyBuilder KeyBuilderFn,
 		}
 	}
 
-	var resp *clientv3.GetResponse
-	err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
-		resp, err = client.Get(ctx, key, opts...)
-		return kvc.RetryRequest(n, err)
-	})
+	// When a selector is given, resources are filtered as they are read and
+	// the range is read until the page is full, so that the limit applies to
+	// the selected resources.
+	var lastObject corev2.Resource
+	for {
+		limit := pred.Limit
+		if limit != 0 {
+			limit -= int64(v.Len())
+		}
+		opts := []clientv3.OpOption{
+			clientv3.WithLimit(limit),
+			clientv3.WithSerializable(),
+			clientv3.WithRange(rangeEnd),
+		}
 
-	if err != nil {
-		return err
-	}
+		var resp *clientv3.GetResponse
+		err := kvc.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+			resp, err = client.Get(ctx, key, opts...)
+			return kvc.RetryRequest(n, err)
+		})
 
-	for _, kv := range resp.Kvs {
-		var obj interface{}
-		if len(kv.Value) > 0 && kv.Value[0] == '{' {
-			obj = reflect.New(v.Type().Elem().Elem()).Interface()
-			if err := json.Unmarshal(kv.Value, obj); err != nil {
-				return &store.ErrDecode{Key: key, Err: err}
-			}
-		} else {
-			msg := reflect.New(v.Type().Elem().Elem()).Interface().(proto.Message)
-			if err := proto.Unmarshal(kv.Value, msg); err != nil {
-				return &store.ErrDecode{Key: key, Err: err}
-			}
-			obj = msg
+		if err != nil {
+			return err
 		}
 
-		// Initialize the annotations and labels if they are nil
-		objValue := reflect.ValueOf(obj)
-		if objValue.Kind() == reflect.Ptr {
-			meta := objValue.Elem().FieldByName("ObjectMeta")
-			if meta.CanSet() {
-				if meta.FieldByName("Labels").Len() == 0 && meta.FieldByName("Labels").CanSet() {
-					meta.FieldByName("Labels").Set(reflect.MakeMap(reflect.TypeOf(make(map[string]string))))
+		for _, kv := range resp.Kvs {
+			var obj interface{}
+			if len(kv.Value) > 0 && kv.Value[0] == '{' {
+				obj = reflect.New(v.Type().Elem().Elem()).Interface()
+				if err := json.Unmarshal(kv.Value, obj); err != nil {
+					return &store.ErrDecode{Key: key, Err: err}
 				}
-				if meta.FieldByName("Annotations").Len() == 0 && meta.FieldByName("Annotations").CanSet() {
-					meta.FieldByName("Annotations").Set(reflect.MakeMap(reflect.TypeOf(make(map[string]string))))
+			} else {
+				msg := reflect.New(v.Type().Elem().Elem()).Interface().(proto.Message)
+				if err := proto.Unmarshal(kv.Value, msg); err != nil {
+					return &store.ErrDecode{Key: key, Err: err}
 				}
+				obj = msg
 			}
+
+			// Initialize the annotations and labels if they are nil
+			objValue := reflect.ValueOf(obj)
+			if objValue.Kind() == reflect.Ptr {
+				meta := objValue.Elem().FieldByName("ObjectMeta")
+				if meta.CanSet() {
+					if meta.FieldByName("Labels").Len() == 0 && meta.FieldByName("Labels").CanSet() {
+						meta.FieldByName("Labels").Set(reflect.MakeMap(reflect.TypeOf(make(map[string]string))))
+					}
+					if meta.FieldByName("Annotations").Len() == 0 && meta.FieldByName("Annotations").CanSet() {
+						meta.FieldByName("Annotations").Set(reflect.MakeMap(reflect.TypeOf(make(map[string]string))))
+					}
+				}
+			}
+
+			if resource, ok := obj.(corev2.Resource); ok {
+				lastObject = resource
+				if !pred.Matches(resource) {
+					continue
+				}
+			}
+
+			v.Set(reflect.Append(v, reflect.ValueOf(obj)))
 		}
 
-		v.Set(reflect.Append(v, reflect.ValueOf(obj)))
+		if pred.Limit == 0 || resp.Count <= int64(len(resp.Kvs)) || lastObject == nil {
+			pred.Continue = ""
+			break
+		}
+		if int64(v.Len()) >= pred.Limit {
+			pred.Continue = ComputeContinueToken(ctx, lastObject)
+			break
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
 	}
 
-	if pred.Limit != 0 && resp.Count > pred.Limit {
-		lastObject := v.Index(v.Len() - 1).Interface().(corev2.Resource)
-		pred.Continue = ComputeContinueToken(ctx, lastObject)
-	} else {
-		pred.Continue = ""
+	if pred.Selector != nil {
+		pred.SelectorApplied = true
 	}
 
 	return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/backend/selector"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/backend/store/etcd/kvc"
 	"github.com/sensu/sensu-go/types"
@@ -377,6 +378,41 @@ func testListPagination(t *testing.T, ctx context.Context, s *Store, limit, setS
 	}
 }
 
+func TestListSelector(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := context.WithValue(context.Background(), types.NamespaceKey, "default")
+		for i := 1; i <= 21; i++ {
+			parity := "odd"
+			if i%2 == 0 {
+				parity = "even"
+			}
+			object := &GenericObject{ObjectMeta: corev2.ObjectMeta{
+				Name:      fmt.Sprintf("%.2d", i),
+				Namespace: "default",
+				Labels:    map[string]string{"parity": parity},
+			}}
+			require.NoError(t, Create(ctx, s.client, getGenericObjectPath(object), "default", object))
+		}
+
+		sel, err := selector.New("parity == even", "")
+		require.NoError(t, err)
+		pred := &store.SelectionPredicate{Limit: 4, Selector: sel}
+
+		var names []string
+		for _, expectedLen := range []int{4, 4, 2} {
+			objects := []*GenericObject{}
+			require.NoError(t, List(ctx, s.client, getGenericObjectsPath, &objects, pred))
+			assert.True(t, pred.SelectorApplied)
+			require.Len(t, objects, expectedLen)
+			for _, object := range objects {
+				names = append(names, object.Name)
+			}
+		}
+		assert.Empty(t, pred.Continue)
+		assert.Equal(t, []string{"02", "04", "06", "08", "10", "12", "14", "16", "18", "20"}, names)
+	})
+}
+
 func TestUpdate(t *testing.T) {
 	testWithEtcdStore(t, func(store *Store) {
 		// Updating a non-existent object should fail
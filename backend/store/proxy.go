@@ -293,6 +293,18 @@ func (s *StoreProxy) GetHandlerByName(ctx context.Context, name string) (*types.
 func (s *StoreProxy) UpdateHandler(ctx context.Context, handler *types.Handler) error {
 	return s.do().UpdateHandler(ctx, handler)
 }
+
+// CreateHandlerExecution records the given handler execution, which expires
+// after the given TTL, in seconds.
+func (s *StoreProxy) CreateHandlerExecution(ctx context.Context, execution *corev2.HandlerExecution, ttl int64) error {
+	return s.do().CreateHandlerExecution(ctx, execution, ttl)
+}
+
+// GetHandlerExecutions returns the recorded handler executions for the given
+// entity and check, in the namespace stored in ctx, most recent first.
+func (s *StoreProxy) GetHandlerExecutions(ctx context.Context, entityName, checkName string) ([]*corev2.HandlerExecution, error) {
+	return s.do().GetHandlerExecutions(ctx, entityName, checkName)
+}
 func (s *StoreProxy) GetClusterHealth(ctx context.Context, cluster clientv3.Cluster, etcdClientTLSConfig *tls.Config) *types.HealthResponse {
 	return s.do().GetClusterHealth(ctx, cluster, etcdClientTLSConfig)
 }
@@ -363,6 +375,10 @@ func (s *StoreProxy) GetNamespace(ctx context.Context, name string) (*types.Name
 func (s *StoreProxy) UpdateNamespace(ctx context.Context, org *types.Namespace) error {
 	return s.do().UpdateNamespace(ctx, org)
 }
+func (s *StoreProxy) ApplyResources(ctx context.Context, resources []corev2.Resource, dryRun bool) ([]ApplyAction, error) {
+	return s.do().ApplyResources(ctx, resources, dryRun)
+}
+
 func (s *StoreProxy) CreateResource(ctx context.Context, resource corev2.Resource) error {
 	return s.do().CreateResource(ctx, resource)
 }
@@ -547,6 +563,18 @@ func (s *StoreProxy) GetPipelineByName(ctx context.Context, name string) (*corev
 	return s.do().GetPipelineByName(ctx, name)
 }
 
+// CheckQuota returns an ErrQuotaExceeded error if creating a resource, given
+// by its RBAC name, in the namespace would exceed one of its quotas.
+func (s *StoreProxy) CheckQuota(ctx context.Context, namespace, resource string) error {
+	return s.do().CheckQuota(ctx, namespace, resource)
+}
+
+// GetQuotaUsage returns the limits of the quotas of the namespace, and the
+// number of resources they limit.
+func (s *StoreProxy) GetQuotaUsage(ctx context.Context, namespace string) (*corev2.QuotaUsage, error) {
+	return s.do().GetQuotaUsage(ctx, namespace)
+}
+
 // NewInitializer returns the Initializer interfaces, which provides the
 // required mechanism to verify if a store is initialized
 func (s *StoreProxy) NewInitializer(ctx context.Context) (Initializer, error) {
@@ -7,6 +7,7 @@ import (
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	corev3 "github.com/sensu/sensu-go/api/core/v3"
+	"github.com/sensu/sensu-go/backend/selector"
 	"github.com/sensu/sensu-go/backend/store/patch"
 	"github.com/sensu/sensu-go/types"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -78,6 +79,17 @@ func (e *ErrPreconditionFailed) Error() string {
 	return fmt.Sprintf("at least one condition failed for the key %s", e.Key)
 }
 
+// ErrQuotaExceeded is returned when writing a resource would exceed a quota
+// of its namespace
+type ErrQuotaExceeded struct {
+	Namespace string
+	Reason    string
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("quota of namespace %s exceeded: %s", e.Namespace, e.Reason)
+}
+
 // ErrInternal is returned when something generally bad happened while
 // interacting with the store. Other, more specific errors should be
 // returned when appropriate.
@@ -103,6 +115,28 @@ type SelectionPredicate struct {
 	Limit int64
 	// Subcollection represents a sub-collection of the primary collection
 	Subcollection string
+	// Selector restricts the selection to the resources it matches, if any
+	Selector *selector.Selector
+	// Fields returns the fields of a resource the field requirements of
+	// Selector are matched against
+	Fields func(corev2.Resource) map[string]string
+	// SelectorApplied is set by the stores able to evaluate Selector while
+	// reading resources, so callers know the selection needs no further
+	// filtering
+	SelectorApplied bool
+}
+
+// Matches returns whether the given resource is matched by the selector of
+// the predicate.
+func (p *SelectionPredicate) Matches(resource corev2.Resource) bool {
+	if p.Selector == nil {
+		return true
+	}
+	var fields map[string]string
+	if len(p.Selector.Fields) > 0 && p.Fields != nil {
+		fields = p.Fields(resource)
+	}
+	return p.Selector.Matches(resource.GetObjectMeta().Labels, fields)
 }
 
 // A WatchEventCheckConfig contains the modified store object and the action
@@ -178,6 +212,10 @@ type Store interface {
 	// HandlerStore provides an interface for managing events handlers
 	HandlerStore
 
+	// HandlerExecutionStore provides an interface for recording handler
+	// executions
+	HandlerExecutionStore
+
 	// HealthStore provides an interface for getting cluster health information
 	HealthStore
 
@@ -202,6 +240,9 @@ type Store interface {
 	// PipelineStore provides an interface for managing pipelines
 	PipelineStore
 
+	// QuotaStore provides an interface for enforcing namespace quotas
+	QuotaStore
+
 	// RoleStore provides an interface for managing roles
 	RoleStore
 
@@ -440,6 +481,18 @@ type HandlerStore interface {
 	UpdateHandler(ctx context.Context, handler *types.Handler) error
 }
 
+// HandlerExecutionStore provides methods for recording handler executions
+type HandlerExecutionStore interface {
+	// CreateHandlerExecution records the given handler execution, which
+	// expires after the given TTL, in seconds.
+	CreateHandlerExecution(ctx context.Context, execution *corev2.HandlerExecution, ttl int64) error
+
+	// GetHandlerExecutions returns the recorded handler executions for the
+	// given entity and check, in the namespace stored in ctx, most recent
+	// first.
+	GetHandlerExecutions(ctx context.Context, entityName, checkName string) ([]*corev2.HandlerExecution, error)
+}
+
 // HealthStore provides methods for cluster health
 type HealthStore interface {
 	GetClusterHealth(ctx context.Context, cluster clientv3.Cluster, etcdClientTLSConfig *tls.Config) *types.HealthResponse
@@ -504,8 +557,40 @@ type PipelineStore interface {
 	GetPipelineByName(ctx context.Context, name string) (*corev2.Pipeline, error)
 }
 
+// QuotaStore provides methods for enforcing the quotas of namespaces. The
+// quotas themselves are managed with the ResourceStore. The limits on the
+// number of resources are best-effort: concurrent creations can exceed them.
+type QuotaStore interface {
+	// CheckQuota returns an ErrQuotaExceeded error if creating a resource,
+	// given by its RBAC name, in the namespace would exceed one of its quotas.
+	CheckQuota(ctx context.Context, namespace, resource string) error
+
+	// GetQuotaUsage returns the limits of the quotas of the namespace, and the
+	// number of resources they limit.
+	GetQuotaUsage(ctx context.Context, namespace string) (*corev2.QuotaUsage, error)
+}
+
+// ApplyAction is what applying a resource did, or would do in dry-run mode.
+type ApplyAction string
+
+const (
+	// ApplyCreated means the resource did not exist
+	ApplyCreated ApplyAction = "created"
+
+	// ApplyUpdated means the resource existed with another value
+	ApplyUpdated ApplyAction = "updated"
+
+	// ApplyUnchanged means the resource already had the given value
+	ApplyUnchanged ApplyAction = "unchanged"
+)
+
 // ResourceStore ...
 type ResourceStore interface {
+	// ApplyResources creates or updates the given resources, all of them or
+	// none, and returns what applying each of them did. Nothing is written
+	// when dryRun is true.
+	ApplyResources(ctx context.Context, resources []corev2.Resource, dryRun bool) ([]ApplyAction, error)
+
 	CreateResource(ctx context.Context, resource corev2.Resource) error
 
 	CreateOrUpdateResource(ctx context.Context, resource corev2.Resource) error
@@ -12,6 +12,7 @@ import (
 	"github.com/sensu/sensu-go/backend/store"
 	storev2 "github.com/sensu/sensu-go/backend/store/v2"
 	"github.com/sensu/sensu-go/backend/store/v2/etcdstore"
+	"github.com/sensu/sensu-go/backend/store/v2/storetest"
 	"github.com/sensu/sensu-go/backend/store/v2/wrap"
 )
 
@@ -377,3 +378,11 @@ func TestExists(t *testing.T) {
 	})
 
 }
+
+func TestConformance(t *testing.T) {
+	storetest.RunConformance(t, func(t *testing.T, f func(storev2.Interface)) {
+		testWithEtcdStore(t, func(s *etcdstore.Store) {
+			f(s)
+		})
+	})
+}
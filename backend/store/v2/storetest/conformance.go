@@ -0,0 +1,380 @@
+package storetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/patch"
+	storev2 "github.com/sensu/sensu-go/backend/store/v2"
+	"github.com/sensu/sensu-go/backend/store/v2/wrap"
+	"github.com/sensu/sensu-go/types"
+)
+
+// conformanceResource is the resource stored by the conformance suite.
+type conformanceResource struct {
+	Metadata *corev2.ObjectMeta `json:"metadata"`
+}
+
+func (r *conformanceResource) GetMetadata() *corev2.ObjectMeta {
+	return r.Metadata
+}
+
+func (r *conformanceResource) SetMetadata(m *corev2.ObjectMeta) {
+	r.Metadata = m
+}
+
+func (r *conformanceResource) StoreName() string {
+	return "conformanceresource"
+}
+
+func (r *conformanceResource) RBACName() string {
+	return "conformanceresource"
+}
+
+func (r *conformanceResource) URIPath() string {
+	return "api/backend/store/namespaces/default/conformanceresource/" + r.Metadata.Name
+}
+
+func (r *conformanceResource) Validate() error {
+	return nil
+}
+
+func (r *conformanceResource) GetTypeMeta() corev2.TypeMeta {
+	return corev2.TypeMeta{
+		Type:       "conformanceResource",
+		APIVersion: "store/storetest",
+	}
+}
+
+func init() {
+	types.RegisterResolver("store/storetest", func(name string) (interface{}, error) {
+		if name == "conformanceResource" {
+			return &conformanceResource{}, nil
+		}
+		return nil, errors.New("type does not exist")
+	})
+}
+
+func fixtureResource(name string) *conformanceResource {
+	return &conformanceResource{
+		Metadata: &corev2.ObjectMeta{
+			Namespace:   "default",
+			Name:        name,
+			Labels:      make(map[string]string),
+			Annotations: make(map[string]string),
+		},
+	}
+}
+
+// RunConformance runs the conformance suite of the storev2.Interface. For each
+// test, withStore must call the given function with an empty store, and
+// release the store once it returns.
+func RunConformance(t *testing.T, withStore func(*testing.T, func(storev2.Interface))) {
+	tests := []struct {
+		name string
+		test func(*testing.T, storev2.Interface)
+	}{
+		{"CreateOrUpdate", testCreateOrUpdate},
+		{"UpdateIfExists", testUpdateIfExists},
+		{"CreateIfNotExists", testCreateIfNotExists},
+		{"Get", testGet},
+		{"Delete", testDelete},
+		{"List", testList},
+		{"Exists", testExists},
+		{"Patch", testPatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withStore(t, func(s storev2.Interface) {
+				createNamespace(t, s, "default")
+				tt.test(t, s)
+			})
+		})
+	}
+}
+
+func createNamespace(t *testing.T, s storev2.Interface, name string) {
+	t.Helper()
+	ns := &corev2.Namespace{Name: name}
+	req := storev2.NewResourceRequestFromV2Resource(context.Background(), ns)
+	wrapper, err := wrap.V2Resource(ns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateOrUpdate(req, wrapper); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func createResource(t *testing.T, s storev2.Interface, name string) (storev2.ResourceRequest, *wrap.Wrapper) {
+	t.Helper()
+	fixture := fixtureResource(name)
+	req := storev2.NewResourceRequestFromResource(context.Background(), fixture)
+	wrapper, err := wrap.Resource(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateIfNotExists(req, wrapper); err != nil {
+		t.Fatal(err)
+	}
+	return req, wrapper
+}
+
+func testCreateOrUpdate(t *testing.T, s storev2.Interface) {
+	fixture := fixtureResource("foo")
+	req := storev2.NewResourceRequestFromResource(context.Background(), fixture)
+	wrapper, err := wrap.Resource(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateOrUpdate(req, wrapper); err != nil {
+		t.Error(err)
+	}
+	// Repeating the call to the store should succeed
+	if err := s.CreateOrUpdate(req, wrapper); err != nil {
+		t.Error(err)
+	}
+	// A resource under an uncreated namespace should fail to create
+	fixture.Metadata.Namespace = "notdefault"
+	req = storev2.NewResourceRequestFromResource(context.Background(), fixture)
+	wrapper, err = wrap.Resource(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateOrUpdate(req, wrapper); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrNamespaceMissing); !ok {
+		t.Errorf("wrong error: %s", err)
+	}
+}
+
+func testUpdateIfExists(t *testing.T, s storev2.Interface) {
+	fixture := fixtureResource("foo")
+	req := storev2.NewResourceRequestFromResource(context.Background(), fixture)
+	wrapper, err := wrap.Resource(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// UpdateIfExists should fail
+	if err := s.UpdateIfExists(req, wrapper); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrNotFound); !ok {
+		t.Errorf("wrong error: %s", err)
+	}
+	if err := s.CreateOrUpdate(req, wrapper); err != nil {
+		t.Fatal(err)
+	}
+	// UpdateIfExists should succeed
+	if err := s.UpdateIfExists(req, wrapper); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCreateIfNotExists(t *testing.T, s storev2.Interface) {
+	req, wrapper := createResource(t, s, "foo")
+	// CreateIfNotExists should fail
+	if err := s.CreateIfNotExists(req, wrapper); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrAlreadyExists); !ok {
+		t.Errorf("wrong error: %s", err)
+	}
+	req.Namespace = "notexists"
+	if err := s.CreateIfNotExists(req, wrapper); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrNamespaceMissing); !ok {
+		t.Errorf("expected ErrNamespaceMissing, got %T", err)
+	}
+}
+
+func testGet(t *testing.T, s storev2.Interface) {
+	req, wrapper := createResource(t, s, "foo")
+	got, err := s.Get(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got.(proto.Message), wrapper) {
+		t.Errorf("bad resource; got %v, want %v", got, wrapper)
+	}
+	req.Name = "bar"
+	if _, err := s.Get(req); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrNotFound); !ok {
+		t.Errorf("expected ErrNotFound: got %s", err)
+	}
+}
+
+func testDelete(t *testing.T, s storev2.Interface) {
+	req, _ := createResource(t, s, "foo")
+	if err := s.Delete(req); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(req); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrNotFound); !ok {
+		t.Errorf("expected ErrNotFound: got %s", err)
+	}
+	if _, err := s.Get(req); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrNotFound); !ok {
+		t.Errorf("expected ErrNotFound: got %s", err)
+	}
+}
+
+func testList(t *testing.T, s storev2.Interface) {
+	for i := 0; i < 10; i++ {
+		createResource(t, s, fmt.Sprintf("foo-%d", i))
+	}
+	// Resources of a namespace whose name has "default" as prefix must not
+	// be listed along with the ones of the default namespace
+	createNamespace(t, s, "default-devel")
+	fixture := fixtureResource("bar")
+	fixture.Metadata.Namespace = "default-devel"
+	wrapper, err := wrap.Resource(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateIfNotExists(storev2.NewResourceRequestFromResource(context.Background(), fixture), wrapper); err != nil {
+		t.Fatal(err)
+	}
+
+	req := storev2.NewResourceRequest(context.Background(), "default", "anything", new(conformanceResource).StoreName())
+	pred := &store.SelectionPredicate{Limit: 5}
+	list, err := s.List(req, pred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := list.Len(), 5; got != want {
+		t.Errorf("wrong number of items: got %d, want %d", got, want)
+	}
+	if got, want := pred.Continue, "foo-4\x00"; got != want {
+		t.Errorf("bad continue token: got %q, want %q", got, want)
+	}
+	// get the rest of the list
+	list, err = s.List(req, pred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := list.Len(), 5; got != want {
+		t.Errorf("wrong number of items: got %d, want %d", got, want)
+	}
+	if pred.Continue != "" {
+		t.Error("expected empty continue token")
+	}
+	// Test listing from all namespaces
+	req.Namespace = ""
+	pred = &store.SelectionPredicate{Limit: 10}
+	list, err = s.List(req, pred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := list.Len(), 10; got != want {
+		t.Errorf("wrong number of items: got %d, want %d", got, want)
+	}
+	if got, want := pred.Continue, "default/foo-8\x00"; got != want {
+		t.Errorf("bad continue token: got %q, want %q", got, want)
+	}
+	list, err = s.List(req, pred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := list.Len(), 1; got != want {
+		t.Errorf("wrong number of items: got %d, want %d", got, want)
+	}
+	if pred.Continue != "" {
+		t.Error("expected empty continue token")
+	}
+	// Test listing in descending order
+	req.Namespace = "default"
+	pred = &store.SelectionPredicate{}
+	req.SortOrder = storev2.SortDescend
+	list, err = s.List(req, pred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := list.Len(), 10; got != want {
+		t.Fatalf("wrong number of items: got %d, want %d", got, want)
+	}
+	firstObj, err := list.(wrap.List)[0].Unwrap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := firstObj.GetMetadata().Name, "foo-9"; got != want {
+		t.Errorf("unexpected first item in list: got %s, want %s", got, want)
+	}
+	// Test listing in ascending order
+	req.SortOrder = storev2.SortAscend
+	list, err = s.List(req, pred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := list.Len(), 10; got != want {
+		t.Fatalf("wrong number of items: got %d, want %d", got, want)
+	}
+	firstObj, err = list.(wrap.List)[0].Unwrap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := firstObj.GetMetadata().Name, "foo-0"; got != want {
+		t.Errorf("unexpected first item in list: got %s, want %s", got, want)
+	}
+}
+
+func testExists(t *testing.T, s storev2.Interface) {
+	req := storev2.NewResourceRequestFromResource(context.Background(), fixtureResource("foo"))
+	got, err := s.Exists(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got {
+		t.Errorf("got true, want false")
+	}
+	createResource(t, s, "foo")
+	got, err = s.Exists(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got {
+		t.Errorf("got false, want true")
+	}
+}
+
+func testPatch(t *testing.T, s storev2.Interface) {
+	req, _ := createResource(t, s, "foo")
+	patcher := &patch.Merge{MergePatch: []byte(`{"metadata":{"labels":{"region":"us-west-1"}}}`)}
+
+	// A patch whose precondition isn't fulfilled should fail
+	conditions := &store.ETagCondition{IfMatch: `"not-the-etag"`}
+	if err := s.Patch(req, &wrap.Wrapper{}, patcher, conditions); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrPreconditionFailed); !ok {
+		t.Errorf("expected ErrPreconditionFailed: got %s", err)
+	}
+
+	if err := s.Patch(req, &wrap.Wrapper{}, patcher, nil); err != nil {
+		t.Fatal(err)
+	}
+	wrapper, err := s.Get(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource, err := wrapper.Unwrap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := resource.GetMetadata().Labels["region"], "us-west-1"; got != want {
+		t.Errorf("bad label: got %q, want %q", got, want)
+	}
+
+	// Patching a missing resource should fail
+	req.Name = "bar"
+	if err := s.Patch(req, &wrap.Wrapper{}, patcher, nil); err == nil {
+		t.Error("expected non-nil error")
+	} else if _, ok := err.(*store.ErrNotFound); !ok {
+		t.Errorf("expected ErrNotFound: got %s", err)
+	}
+}
@@ -0,0 +1,286 @@
+package bonsai
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/types"
+)
+
+// IndexAnnotation represents the location of the private index of an asset
+const IndexAnnotation = "io.sensu.bonsai.index"
+
+// maxIndexSize is the maximum size of an index, in bytes
+const maxIndexSize = 16 << 20
+
+// ErrAssetNotFound is returned when an asset does not exist in an index.
+var ErrAssetNotFound = errors.New("asset not found")
+
+// Index is a private asset index, listing the versions of assets and their
+// builds. It is a static JSON or YAML document.
+type Index struct {
+	// Assets are the assets of the index
+	Assets []*IndexAsset `json:"assets"`
+}
+
+// IndexAsset is an asset of a private index
+type IndexAsset struct {
+	// Name is the full name (including namespace) of the asset
+	Name string `json:"name"`
+	// Description is the description of the asset
+	Description string `json:"description,omitempty"`
+	// Versions are the versions of the asset
+	Versions []*IndexAssetVersion `json:"versions"`
+}
+
+// IndexAssetVersion is a version of an asset of a private index
+type IndexAssetVersion struct {
+	// Version is the version of the asset
+	Version string `json:"version"`
+	// Annotations are added to the asset definition
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Builds are the builds of the asset version
+	Builds []*corev2.AssetBuild `json:"builds"`
+}
+
+// IndexConfig is the configuration of a private index client.
+type IndexConfig struct {
+	// Location is the URL of the index, or its path on the filesystem.
+	Location string
+
+	// TLSConfig allows overriding client TLS configuration. Should only be
+	// needed for testing.
+	TLSConfig *tls.Config
+}
+
+// IndexClient is a client of a private index, served from an HTTP endpoint or
+// the filesystem.
+type IndexClient struct {
+	httpClient http.Client
+	config     IndexConfig
+
+	mu    sync.Mutex
+	index *Index
+}
+
+// NewIndexClient builds a new private index client
+func NewIndexClient(config IndexConfig) *IndexClient {
+	client := &IndexClient{config: config}
+
+	// set http client timeout
+	client.httpClient.Timeout = 15 * time.Second
+
+	if config.TLSConfig != nil {
+		transport := new(http.Transport)
+		transport.TLSClientConfig = config.TLSConfig
+		client.httpClient.Transport = transport
+	}
+
+	return client
+}
+
+// FetchAsset fetches an asset (list of versions) from the index
+func (c *IndexClient) FetchAsset(namespace, name string) (*Asset, error) {
+	indexAsset, err := c.fetchIndexAsset(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	asset := &Asset{
+		Name:        indexAsset.Name,
+		Description: indexAsset.Description,
+		URL:         c.config.Location,
+		Versions:    []*AssetVersionGrouping{},
+	}
+	for _, version := range indexAsset.Versions {
+		asset.Versions = append(asset.Versions, &AssetVersionGrouping{Version: version.Version})
+	}
+	return asset, nil
+}
+
+// FetchAssetVersion fetches an asset definition for a the specified asset
+// version from the index
+func (c *IndexClient) FetchAssetVersion(namespace, name, version string) (string, error) {
+	indexAsset, err := c.fetchIndexAsset(namespace, name)
+	if err != nil {
+		return "", err
+	}
+
+	for _, indexVersion := range indexAsset.Versions {
+		if indexVersion.Version != version {
+			continue
+		}
+
+		asset := &corev2.Asset{
+			ObjectMeta: corev2.ObjectMeta{
+				Name:        indexAsset.Name,
+				Annotations: map[string]string{},
+			},
+			Builds: indexVersion.Builds,
+		}
+		for key, value := range indexVersion.Annotations {
+			asset.Annotations[key] = value
+		}
+		asset.Annotations[NameAnnotation] = name
+		asset.Annotations[NamespaceAnnotation] = namespace
+		asset.Annotations[VersionAnnotation] = version
+		asset.Annotations[URLAnnotation] = c.config.Location
+		asset.Annotations[IndexAnnotation] = c.config.Location
+
+		definition, err := json.Marshal(types.WrapResource(asset))
+		if err != nil {
+			return "", err
+		}
+		return string(definition), nil
+	}
+
+	return "", fmt.Errorf("version %q of asset %s/%s does not exist in index %s", version, namespace, name, c.config.Location)
+}
+
+func (c *IndexClient) fetchIndexAsset(namespace, name string) (*IndexAsset, error) {
+	index, err := c.fetchIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	fullName := path.Join(namespace, name)
+	for _, asset := range index.Assets {
+		if asset.Name == fullName {
+			return asset, nil
+		}
+	}
+	return nil, ErrAssetNotFound
+}
+
+// fetchIndex fetches the index, once
+func (c *IndexClient) fetchIndex() (*Index, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.index != nil {
+		return c.index, nil
+	}
+
+	body, err := c.openIndex()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(body, maxIndexSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var index Index
+	// YAML is a superset of JSON
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("invalid index %s: %s", c.config.Location, err)
+	}
+	c.index = &index
+	return c.index, nil
+}
+
+func (c *IndexClient) openIndex() (io.ReadCloser, error) {
+	location, err := url.Parse(c.config.Location)
+	if err != nil || location.Scheme == "" || len(location.Scheme) == 1 {
+		// The location is a path, possibly a Windows path with a drive letter
+		return os.Open(c.config.Location)
+	}
+
+	switch location.Scheme {
+	case "file":
+		return os.Open(location.Path)
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("unsupported index location %s", c.config.Location)
+	}
+
+	req, err := http.NewRequest("GET", c.config.Location, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json, application/yaml")
+
+	logger.WithField("request", req.URL.String()).Debug("fetching asset index")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if code := resp.StatusCode; code >= 400 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("asset index returned status code: %d", code)
+	}
+	return resp.Body, nil
+}
+
+// ChainClient looks up the assets in a list of clients, in order, and uses
+// the first one which has the asset.
+type ChainClient struct {
+	clients []Client
+}
+
+// NewChainClient builds a new client looking up the assets in the clients, in
+// order.
+func NewChainClient(clients ...Client) *ChainClient {
+	return &ChainClient{clients: clients}
+}
+
+// FetchAsset fetches an asset (list of versions) from the first client which
+// has the asset
+func (c *ChainClient) FetchAsset(namespace, name string) (*Asset, error) {
+	client, err := c.client(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchAsset(namespace, name)
+}
+
+// FetchAssetVersion fetches an asset definition for a the specified asset
+// version from the first client which has the asset
+func (c *ChainClient) FetchAssetVersion(namespace, name, version string) (string, error) {
+	client, err := c.client(namespace, name)
+	if err != nil {
+		return "", err
+	}
+	return client.FetchAssetVersion(namespace, name, version)
+}
+
+func (c *ChainClient) client(namespace, name string) (Client, error) {
+	for i, client := range c.clients {
+		// The last client is used if the asset can't be found in the others
+		if i == len(c.clients)-1 {
+			return client, nil
+		}
+		if _, err := client.FetchAsset(namespace, name); err == ErrAssetNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+	return nil, ErrAssetNotFound
+}
+
+// NewClientWithIndexes builds a new client looking up the assets in the
+// private indexes at the given locations, in order, and then in Bonsai.
+func NewClientWithIndexes(config Config, locations []string) Client {
+	clients := []Client{}
+	for _, location := range locations {
+		clients = append(clients, NewIndexClient(IndexConfig{Location: location, TLSConfig: config.TLSConfig}))
+	}
+	clients = append(clients, New(config))
+	return NewChainClient(clients...)
+}
@@ -0,0 +1,126 @@
+package bonsai_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/bonsai"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIndex = `
+assets:
+- name: internal/foo
+  description: internal asset
+  versions:
+  - version: 1.1.0
+    builds:
+    - url: https://assets.example.com/foo-1.1.0.tar.gz
+      sha512: abc
+  - version: 1.2.0
+    annotations:
+      owner: ops
+    builds:
+    - url: https://assets.example.com/foo-1.2.0-linux.tar.gz
+      sha512: def
+      filters:
+      - entity.system.os == 'linux'
+`
+
+func TestIndexClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/index.yml" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testIndex))
+	}))
+	defer server.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	tlsConfig := &tls.Config{
+		RootCAs: pool,
+	}
+
+	dir, err := ioutil.TempDir("", "bonsai-index")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "index.yml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testIndex), 0644))
+
+	locations := map[string]string{
+		"http": server.URL + "/index.yml",
+		"path": path,
+		"file": "file://" + filepath.ToSlash(path),
+	}
+	for name, location := range locations {
+		t.Run(name, func(t *testing.T) {
+			client := bonsai.NewIndexClient(bonsai.IndexConfig{Location: location, TLSConfig: tlsConfig})
+
+			asset, err := client.FetchAsset("internal", "foo")
+			require.NoError(t, err)
+			assert.Equal(t, "internal/foo", asset.Name)
+			assert.Equal(t, "1.2.0", asset.LatestVersion().Original())
+
+			_, err = client.FetchAsset("internal", "bar")
+			assert.Equal(t, bonsai.ErrAssetNotFound, err)
+
+			definition, err := client.FetchAssetVersion("internal", "foo", "1.2.0")
+			require.NoError(t, err)
+			var wrapper types.Wrapper
+			require.NoError(t, json.Unmarshal([]byte(definition), &wrapper))
+			fetched, ok := wrapper.Value.(*corev2.Asset)
+			require.True(t, ok)
+			require.Len(t, fetched.Builds, 1)
+			assert.Equal(t, "https://assets.example.com/foo-1.2.0-linux.tar.gz", fetched.Builds[0].URL)
+			assert.Equal(t, []string{"entity.system.os == 'linux'"}, fetched.Builds[0].Filters)
+			assert.Equal(t, "ops", fetched.Annotations["owner"])
+			assert.Equal(t, "1.2.0", fetched.Annotations[bonsai.VersionAnnotation])
+			assert.Equal(t, "internal", fetched.Annotations[bonsai.NamespaceAnnotation])
+			assert.Equal(t, "foo", fetched.Annotations[bonsai.NameAnnotation])
+			assert.Equal(t, location, fetched.Annotations[bonsai.IndexAnnotation])
+
+			_, err = client.FetchAssetVersion("internal", "foo", "2.0.0")
+			assert.Error(t, err)
+		})
+	}
+
+	client := bonsai.NewIndexClient(bonsai.IndexConfig{Location: server.URL + "/notexists.yml", TLSConfig: tlsConfig})
+	_, err = client.FetchAsset("internal", "foo")
+	assert.Error(t, err)
+}
+
+func TestChainClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bonsai-index")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "index.yml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testIndex), 0644))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_ = json.NewEncoder(w).Encode(bonsai.Asset{Name: "sensu/bar"})
+	}))
+	defer server.Close()
+
+	client := bonsai.NewClientWithIndexes(bonsai.Config{EndpointURL: server.URL}, []string{path})
+
+	// The asset is found in the index
+	asset, err := client.FetchAsset("internal", "foo")
+	require.NoError(t, err)
+	assert.Equal(t, path, asset.URL)
+
+	// The asset is looked up in Bonsai
+	asset, err = client.FetchAsset("sensu", "bar")
+	require.NoError(t, err)
+	assert.Equal(t, "sensu/bar", asset.Name)
+}
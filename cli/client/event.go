@@ -28,6 +28,25 @@ func (client *RestClient) FetchEvent(entity, check string) (*corev2.Event, error
 	return event, err
 }
 
+// FetchHandlerExecutions fetches the recorded handler executions of an event,
+// most recent first.
+func (client *RestClient) FetchHandlerExecutions(entity, check string) ([]*corev2.HandlerExecution, error) {
+	var executions []*corev2.HandlerExecution
+
+	path := EventsPath(client.config.Namespace(), entity, check, "handlers")
+	res, err := client.R().Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &executions)
+	return executions, err
+}
+
 // DeleteEvent deletes an event.
 func (client *RestClient) DeleteEvent(namespace, entity, check string) error {
 	return client.Delete(EventsPath(namespace, entity, check))
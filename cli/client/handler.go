@@ -70,3 +70,48 @@ func (client *RestClient) UpdateHandler(handler *corev2.Handler) (err error) {
 
 	return nil
 }
+
+// HandlerFailuresPath is the api path for the failed handler executions.
+var HandlerFailuresPath = createNSBasePath(coreAPIGroup, coreAPIVersion, "handler-failures")
+
+// ListHandlerFailures lists the failed handler executions that exhausted
+// their retries, oldest first.
+func (client *RestClient) ListHandlerFailures(namespace string) ([]*corev2.HandlerFailure, error) {
+	var failures []*corev2.HandlerFailure
+
+	res, err := client.R().Get(HandlerFailuresPath(namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &failures)
+	return failures, err
+}
+
+// RetryHandlerFailure queues the given failed handler execution for another
+// retry.
+func (client *RestClient) RetryHandlerFailure(namespace, id string) error {
+	res, err := client.R().Post(HandlerFailuresPath(namespace, id, "retry"))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return UnmarshalError(res)
+	}
+
+	return nil
+}
+
+// PurgeHandlerFailures deletes the given failed handler execution, or all of
+// them if id is empty.
+func (client *RestClient) PurgeHandlerFailures(namespace, id string) error {
+	if id == "" {
+		return client.Delete(HandlerFailuresPath(namespace))
+	}
+	return client.Delete(HandlerFailuresPath(namespace, id))
+}
@@ -136,6 +136,10 @@ type FilterAPIClient interface {
 type EventAPIClient interface {
 	FetchEvent(string, string) (*corev2.Event, error)
 
+	// FetchHandlerExecutions fetches the recorded handler executions of the
+	// event identified by entity, check.
+	FetchHandlerExecutions(entity, check string) ([]*corev2.HandlerExecution, error)
+
 	// DeleteEvent deletes the event identified by entity, check.
 	DeleteEvent(namespace, entity, check string) error
 	UpdateEvent(*corev2.Event) error
@@ -148,6 +152,15 @@ type HandlerAPIClient interface {
 	DeleteHandler(string, string) error
 	FetchHandler(string) (*corev2.Handler, error)
 	UpdateHandler(*corev2.Handler) error
+
+	// ListHandlerFailures lists the failed handler executions that exhausted
+	// their retries.
+	ListHandlerFailures(namespace string) ([]*corev2.HandlerFailure, error)
+	// RetryHandlerFailure queues a failed handler execution for another retry.
+	RetryHandlerFailure(namespace, id string) error
+	// PurgeHandlerFailures deletes a failed handler execution, or all of them
+	// if id is empty.
+	PurgeHandlerFailures(namespace, id string) error
 }
 
 // HealthAPIClient client methods for health api
@@ -177,6 +190,8 @@ type NamespaceAPIClient interface {
 	UpdateNamespace(*corev2.Namespace) error
 	DeleteNamespace(string) error
 	FetchNamespace(string) (*corev2.Namespace, error)
+	FetchQuotaUsage(string) (*corev2.QuotaUsage, error)
+	CloneNamespace(string, string, CloneOptions) (*CloneReport, error)
 }
 
 // PipelineAPIClient client methods for pipelines
@@ -2,6 +2,8 @@ package client
 
 import (
 	"encoding/json"
+	"errors"
+	"strconv"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 )
@@ -9,6 +11,40 @@ import (
 // NamespacesPath is the api path for namespaces.
 var NamespacesPath = CreateBasePath(coreAPIGroup, coreAPIVersion, "namespaces")
 
+// QuotasPath is the api path for quotas.
+var QuotasPath = createNSBasePath(coreAPIGroup, coreAPIVersion, "quotas")
+
+// CloneOptions selects the resources copied by a namespace clone.
+type CloneOptions struct {
+	// Types are the kinds of resources copied, by RBAC name, all of those that
+	// can be cloned when empty
+	Types []string
+
+	// LabelSelector restricts the copy to the resources it matches, if any
+	LabelSelector string
+
+	// DryRun only reports what would be copied
+	DryRun bool
+}
+
+// CloneResult is what cloning a namespace did to one of the copied resources.
+type CloneResult struct {
+	APIVersion string `json:"api_version" yaml:"api_version"`
+	Type       string `json:"type" yaml:"type"`
+	Namespace  string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Name       string `json:"name" yaml:"name"`
+	Action     string `json:"action,omitempty" yaml:"action,omitempty"`
+	Error      string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// CloneReport is the outcome of a namespace clone.
+type CloneReport struct {
+	DryRun  bool          `json:"dry_run" yaml:"dry_run"`
+	Applied bool          `json:"applied" yaml:"applied"`
+	Message string        `json:"message,omitempty" yaml:"message,omitempty"`
+	Results []CloneResult `json:"results" yaml:"results"`
+}
+
 // CreateNamespace creates new namespace on configured Sensu instance
 func (client *RestClient) CreateNamespace(namespace *corev2.Namespace) error {
 	bytes, err := json.Marshal(namespace)
@@ -72,3 +108,59 @@ func (client *RestClient) FetchNamespace(namespaceName string) (*corev2.Namespac
 	err = json.Unmarshal(res.Body(), &namespace)
 	return namespace, err
 }
+
+// FetchQuotaUsage fetches the usage of the quotas of a namespace
+func (client *RestClient) FetchQuotaUsage(namespaceName string) (*corev2.QuotaUsage, error) {
+	var usage *corev2.QuotaUsage
+
+	path := QuotasPath(namespaceName, corev2.QuotaUsagePath)
+	res, err := client.R().Get(path)
+	if err != nil {
+		return usage, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return usage, UnmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &usage)
+	return usage, err
+}
+
+// CloneNamespace copies resources of the src namespace to the dst namespace,
+// all of them or none. The report is returned along with the error when none
+// could be copied.
+func (client *RestClient) CloneNamespace(src, dst string, opts CloneOptions) (*CloneReport, error) {
+	bytes, err := json.Marshal(map[string]interface{}{
+		"destination": dst,
+		"types":       opts.Types,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req := client.R().SetBody(bytes)
+	if opts.LabelSelector != "" {
+		req.SetQueryParam("labelSelector", opts.LabelSelector)
+	}
+	if opts.DryRun {
+		req.SetQueryParam("dryRun", strconv.FormatBool(opts.DryRun))
+	}
+	res, err := req.Post(NamespacesPath(src, "clone"))
+	if err != nil {
+		return nil, err
+	}
+
+	var report CloneReport
+	if res.StatusCode() >= 400 {
+		// The report explains why the resources couldn't be copied, when the
+		// request itself was valid
+		if err := json.Unmarshal(res.Body(), &report); err == nil && report.Message != "" {
+			return &report, errors.New(report.Message)
+		}
+		return nil, UnmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &report)
+	return &report, err
+}
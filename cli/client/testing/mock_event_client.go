@@ -10,6 +10,12 @@ func (c *MockClient) FetchEvent(entity, check string) (*corev2.Event, error) {
 	return args.Get(0).(*corev2.Event), args.Error(1)
 }
 
+// FetchHandlerExecutions for use with mock lib
+func (c *MockClient) FetchHandlerExecutions(entity, check string) ([]*corev2.HandlerExecution, error) {
+	args := c.Called(entity, check)
+	return args.Get(0).([]*corev2.HandlerExecution), args.Error(1)
+}
+
 // DeleteEvent for use with mock lib
 func (c *MockClient) DeleteEvent(namespace, entity, check string) error {
 	args := c.Called(namespace, entity, check)
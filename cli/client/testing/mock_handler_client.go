@@ -27,3 +27,21 @@ func (c *MockClient) UpdateHandler(h *corev2.Handler) error {
 	args := c.Called(h)
 	return args.Error(0)
 }
+
+// ListHandlerFailures for use with mock lib
+func (c *MockClient) ListHandlerFailures(namespace string) ([]*corev2.HandlerFailure, error) {
+	args := c.Called(namespace)
+	return args.Get(0).([]*corev2.HandlerFailure), args.Error(1)
+}
+
+// RetryHandlerFailure for use with mock lib
+func (c *MockClient) RetryHandlerFailure(namespace, id string) error {
+	args := c.Called(namespace, id)
+	return args.Error(0)
+}
+
+// PurgeHandlerFailures for use with mock lib
+func (c *MockClient) PurgeHandlerFailures(namespace, id string) error {
+	args := c.Called(namespace, id)
+	return args.Error(0)
+}
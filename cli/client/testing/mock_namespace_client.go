@@ -2,6 +2,7 @@ package testing
 
 import (
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/client"
 )
 
 // CreateNamespace for use with mock lib
@@ -27,3 +28,15 @@ func (c *MockClient) FetchNamespace(namespace string) (*corev2.Namespace, error)
 	args := c.Called(namespace)
 	return args.Get(0).(*corev2.Namespace), args.Error(1)
 }
+
+// FetchQuotaUsage for use with mock lib
+func (c *MockClient) FetchQuotaUsage(namespace string) (*corev2.QuotaUsage, error) {
+	args := c.Called(namespace)
+	return args.Get(0).(*corev2.QuotaUsage), args.Error(1)
+}
+
+// CloneNamespace for use with mock lib
+func (c *MockClient) CloneNamespace(src, dst string, opts client.CloneOptions) (*client.CloneReport, error) {
+	args := c.Called(src, dst, opts)
+	return args.Get(0).(*client.CloneReport), args.Error(1)
+}
@@ -3,6 +3,7 @@ package apikey
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli"
@@ -24,6 +25,9 @@ func GrantCommand(cli *cli.SensuCli) *cobra.Command {
 			apikey := &corev2.APIKey{
 				Username: args[0],
 			}
+			if err := scopeFromFlags(cmd, apikey); err != nil {
+				return err
+			}
 
 			location, err := cli.Client.PostAPIKey(apikey.URIPath(), apikey)
 			if err != nil {
@@ -35,5 +39,63 @@ func GrantCommand(cli *cli.SensuCli) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Duration("expires-in", 0, "duration after which the api-key expires (e.g. 720h), never expires if unset")
+	cmd.Flags().StringSlice("namespaces", nil, "comma separated list of namespaces the api-key is restricted to")
+	cmd.Flags().StringSlice("verbs", nil, "comma separated list of verbs the api-key is restricted to")
+	cmd.Flags().StringSlice("resources", nil, "comma separated list of resources the api-key is restricted to")
+	cmd.Flags().StringSlice("resource-names", nil, "comma separated list of resource names the api-key is restricted to")
+	cmd.Flags().StringSlice("allowed-cidrs", nil, "comma separated list of CIDRs from which the api-key can be used")
+
 	return cmd
 }
+
+// scopeFromFlags sets the expiration and the restrictions of the api key from
+// the command flags.
+func scopeFromFlags(cmd *cobra.Command, apikey *corev2.APIKey) error {
+	flags := cmd.Flags()
+	expiresIn, err := flags.GetDuration("expires-in")
+	if err != nil {
+		return err
+	}
+	if expiresIn < 0 {
+		return errors.New("expires-in cannot be negative")
+	}
+	if expiresIn > 0 {
+		apikey.ExpiresAt = time.Now().Add(expiresIn).Unix()
+	}
+
+	if apikey.Namespaces, err = flags.GetStringSlice("namespaces"); err != nil {
+		return err
+	}
+	if apikey.AllowedCIDRs, err = flags.GetStringSlice("allowed-cidrs"); err != nil {
+		return err
+	}
+
+	verbs, err := flags.GetStringSlice("verbs")
+	if err != nil {
+		return err
+	}
+	resources, err := flags.GetStringSlice("resources")
+	if err != nil {
+		return err
+	}
+	resourceNames, err := flags.GetStringSlice("resource-names")
+	if err != nil {
+		return err
+	}
+	if len(verbs) == 0 && len(resources) == 0 && len(resourceNames) == 0 {
+		return nil
+	}
+	if len(verbs) == 0 {
+		verbs = []string{corev2.VerbAll}
+	}
+	if len(resources) == 0 {
+		resources = []string{corev2.ResourceAll}
+	}
+	apikey.Rules = []corev2.Rule{{
+		Verbs:         verbs,
+		Resources:     resources,
+		ResourceNames: resourceNames,
+	}}
+	return nil
+}
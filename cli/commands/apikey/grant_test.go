@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/stretchr/testify/assert"
@@ -64,3 +65,31 @@ func TestGrantCommandServerError(t *testing.T) {
 	assert.Error(err)
 	assert.Equal("err", err.Error())
 }
+
+func TestGrantCommandWithScope(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	var apikey *corev2.APIKey
+	client.On("PostAPIKey", mock.Anything, mock.Anything).Return("location", nil).Run(func(args mock.Arguments) {
+		apikey = args.Get(1).(*corev2.APIKey)
+	})
+
+	cmd := GrantCommand(cli)
+	require.NoError(t, cmd.Flags().Set("expires-in", "1h"))
+	require.NoError(t, cmd.Flags().Set("namespaces", "dev,qa"))
+	require.NoError(t, cmd.Flags().Set("verbs", "get,list"))
+	require.NoError(t, cmd.Flags().Set("resources", "checks"))
+	require.NoError(t, cmd.Flags().Set("allowed-cidrs", "10.0.0.0/8"))
+	out, err := test.RunCmd(cmd, []string{"user1"})
+
+	require.NoError(t, err)
+	assert.Regexp(t, "Created: location", out)
+	require.NotNil(t, apikey)
+	assert.NotZero(t, apikey.ExpiresAt)
+	assert.Equal(t, []string{"dev", "qa"}, apikey.Namespaces)
+	assert.Equal(t, []string{"10.0.0.0/8"}, apikey.AllowedCIDRs)
+	require.Len(t, apikey.Rules, 1)
+	assert.Equal(t, []string{"get", "list"}, apikey.Rules[0].Verbs)
+	assert.Equal(t, []string{"checks"}, apikey.Rules[0].Resources)
+	assert.Empty(t, apikey.Rules[0].ResourceNames)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -51,6 +52,18 @@ func printToList(v interface{}, writer io.Writer) error {
 	if !ok {
 		return fmt.Errorf("%t is not an APIKey", v)
 	}
+	expiresAt := "Never"
+	if r.ExpiresAt > 0 {
+		expiresAt = time.Unix(r.ExpiresAt, 0).String()
+	}
+	lastUsedAt := "Never"
+	if r.LastUsedAt > 0 {
+		lastUsedAt = time.Unix(r.LastUsedAt, 0).String()
+	}
+	rules := make([]string, 0, len(r.Rules))
+	for _, rule := range r.Rules {
+		rules = append(rules, fmt.Sprintf("%s on %s", strings.Join(rule.Verbs, ","), strings.Join(rule.Resources, ",")))
+	}
 	cfg := &list.Config{
 		Title: r.Name,
 		Rows: []*list.Row{
@@ -66,6 +79,26 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Created At",
 				Value: time.Unix(r.CreatedAt, 0).String(),
 			},
+			{
+				Label: "Expires At",
+				Value: expiresAt,
+			},
+			{
+				Label: "Last Used At",
+				Value: lastUsedAt,
+			},
+			{
+				Label: "Namespaces",
+				Value: strings.Join(r.Namespaces, ", "),
+			},
+			{
+				Label: "Rules",
+				Value: strings.Join(rules, "; "),
+			},
+			{
+				Label: "Allowed CIDRs",
+				Value: strings.Join(r.AllowedCIDRs, ", "),
+			},
 		},
 	}
 
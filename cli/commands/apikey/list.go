@@ -90,6 +90,19 @@ func printToTable(results interface{}, writer io.Writer) {
 				return timeutil.HumanTimestamp(apikey.CreatedAt)
 			},
 		},
+		{
+			Title: "Expires At",
+			CellTransformer: func(data interface{}) string {
+				apikey, ok := data.(corev2.APIKey)
+				if !ok {
+					return cli.TypeError
+				}
+				if apikey.ExpiresAt == 0 {
+					return "Never"
+				}
+				return timeutil.HumanTimestamp(apikey.ExpiresAt)
+			},
+		},
 	})
 
 	table.Render(writer, results)
@@ -0,0 +1,102 @@
+package apply
+
+import (
+	"errors"
+	"net/http"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/spf13/cobra"
+)
+
+var description = `sensuctl apply
+
+Bring resources to the state declared in files, URLs or STDIN. The changes made
+to the declaration of a resource since it was last applied are merged into its
+live state, so that the fields modified on the server but not in the files are
+kept, and the fields removed from the files are removed. The changes are
+previewed before being applied; updated resources are shown as a diff between
+their live and applied state.
+
+With --prune, the resources holding the label given with --label that are no
+longer declared are deleted, in the namespaces of the declared resources and
+the configured namespace:
+$ sensuctl apply -r -f checks/ --prune --label sensu.io/managed_by=git
+
+With --dry-run, nothing is changed and the command exits with status 2 when the
+resources differ from their declaration, which allows drift to fail CI jobs.
+`
+
+// Command applies generic Sensu resources.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply [-r] [[-f URL] ... ] [--prune --label KEY=VALUE] [--dry-run]",
+		Short: "Apply resources from file or URL (path, file://, http[s]://), or STDIN otherwise, optionally pruning the ones no longer declared.",
+		Long:  description,
+		RunE:  execute(cli),
+	}
+
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs to apply resources from")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("prune", false, "Delete the resources holding the label that are no longer declared")
+	_ = cmd.Flags().String("label", corev2.ManagedByLabel+"=sensuctl", "Label set on the applied resources and selecting the ones to prune, as key=value")
+	_ = cmd.Flags().Bool("dry-run", false, "Only print the changes, exiting with status 2 if there are any")
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+
+		label, err := cmd.Flags().GetString("label")
+		if err != nil {
+			return err
+		}
+		key, value, err := resource.ParseLabel(label)
+		if err != nil {
+			return err
+		}
+		prune, err := cmd.Flags().GetBool("prune")
+		if err != nil {
+			return err
+		}
+		if prune && !cmd.Flags().Changed("label") {
+			// Pruning with the default label would delete everything created
+			// with sensuctl that is not part of the applied files
+			return errors.New("--prune requires an explicit --label")
+		}
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+		processor := &resource.Applier{
+			LabelKey:   key,
+			LabelValue: value,
+			Prune:      prune,
+			DryRun:     dryRun,
+			Namespace:  cli.Config.Namespace(),
+			Out:        cmd.OutOrStdout(),
+		}
+
+		t := &http.Transport{}
+		t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+		client := &http.Client{Transport: t}
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		if len(inputs) == 0 {
+			return resource.ProcessStdin(cli, client, processor)
+		}
+		recurse, err := cmd.Flags().GetBool("recursive")
+		if err != nil {
+			return err
+		}
+		return resource.Process(cli, client, inputs, recurse, processor)
+	}
+}
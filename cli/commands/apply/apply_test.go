@@ -0,0 +1,34 @@
+package apply
+
+import (
+	"testing"
+
+	cmdtesting "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand(t *testing.T) {
+	cmd := Command(cmdtesting.NewMockCLI())
+
+	assert.Equal(t, "apply", cmd.Name())
+	assert.NotNil(t, cmd.Flags().Lookup("prune"))
+	assert.NotNil(t, cmd.Flags().Lookup("label"))
+	assert.NotNil(t, cmd.Flags().Lookup("dry-run"))
+}
+
+func TestCommandPruneRequiresLabel(t *testing.T) {
+	cmd := Command(cmdtesting.NewMockCLI())
+	require.NoError(t, cmd.Flags().Set("prune", "true"))
+
+	_, err := cmdtesting.RunCmd(cmd, nil)
+	assert.EqualError(t, err, "--prune requires an explicit --label")
+}
+
+func TestCommandInvalidLabel(t *testing.T) {
+	cmd := Command(cmdtesting.NewMockCLI())
+	require.NoError(t, cmd.Flags().Set("label", "git"))
+
+	_, err := cmdtesting.RunCmd(cmd, nil)
+	assert.Error(t, err)
+}
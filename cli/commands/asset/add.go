@@ -8,6 +8,7 @@ import (
 
 	"github.com/sensu/sensu-go/bonsai"
 	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/spf13/cobra"
 
 	goversion "github.com/hashicorp/go-version"
@@ -16,6 +17,9 @@ import (
 )
 
 var rename string
+
+const indexFlag = "index"
+
 var help string = `
 You have successfully added the Sensu asset resource, but the asset will not get downloaded until
 it's invoked by another Sensu resource (ex. check). To add this runtime asset to the appropriate
@@ -25,11 +29,12 @@ resource, populate the "runtime_assets" field with`
 func AddCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add [NAME]",
-		Short: "adds an asset definition fetched from Bonsai",
+		Short: "adds an asset definition fetched from Bonsai or a private index",
 		RunE:  addCommandExecute(cli),
 	}
 
 	cmd.Flags().StringVarP(&rename, "rename", "r", "", "rename the asset to the provided string after fetching it from Bonsai")
+	cmd.Flags().StringSlice(indexFlag, nil, "URL or path of a private asset index, in which the asset is looked up before Bonsai. This flag can be invoked multiple times (env SENSU_INDEX)")
 
 	return cmd
 }
@@ -57,7 +62,11 @@ func addCommandExecute(cli *cli.SensuCli) func(cmd *cobra.Command, args []string
 			}
 		}
 
-		bonsaiClient := bonsai.New(bonsai.Config{})
+		v, err := helpers.InitViper(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		bonsaiClient := bonsai.NewClientWithIndexes(bonsai.Config{}, v.GetStringSlice(indexFlag))
 		bonsaiAsset, err := bonsaiClient.FetchAsset(bAsset.Namespace, bAsset.Name)
 		if err != nil {
 			return err
@@ -40,6 +40,7 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 
 	_ = cmd.Flags().StringP("sha512", "", "", "SHA-512 checksum of the asset's archive")
 	_ = cmd.Flags().StringP("url", "u", "", "the URL of the asset")
+	_ = cmd.Flags().String("signature-url", "", "the URL of the detached, prehashed (minisign -H) minisign signature of the asset's archive")
 	_ = cmd.Flags().StringSlice("filter", []string{}, "queries used by an entity to determine if it should include the asset")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
@@ -145,6 +146,10 @@ func (cfgPtr *ConfigureAsset) administerQuestionnaire() error {
 			Prompt:   &survey.Input{Message: "SHA-512 Checksum:"},
 			Validate: survey.Required,
 		},
+		{
+			Name:   "signature-url",
+			Prompt: &survey.Input{Message: "Signature URL:"},
+		},
 		{
 			Name:   "filters",
 			Prompt: &survey.Input{Message: "Filters:"},
@@ -159,6 +164,7 @@ func (cfgPtr *ConfigureAsset) configureFromFlags() {
 	cfgPtr.setNamespace()
 	cfgPtr.setSha512()
 	cfgPtr.setURL()
+	cfgPtr.setSignatureURL()
 	cfgPtr.setFilters()
 }
 
@@ -196,6 +202,14 @@ func (cfgPtr *ConfigureAsset) setURL() {
 	}
 }
 
+func (cfgPtr *ConfigureAsset) setSignatureURL() {
+	if url, err := cfgPtr.Flags.GetString("signature-url"); err != nil {
+		panic(err)
+	} else {
+		cfgPtr.cfg.SignatureURL = url
+	}
+}
+
 func (cfgPtr *ConfigureAsset) setFilters() {
 	if filters, err := cfgPtr.Flags.GetStringSlice("filter"); err != nil {
 		panic(err)
@@ -212,11 +226,12 @@ func (cfgPtr *ConfigureAsset) addError(err error) {
 
 // Config represents configurable attributes of an asset
 type Config struct {
-	Name      string
-	Namespace string
-	Sha512    string
-	URL       string
-	Filters   string
+	Name         string
+	Namespace    string
+	Sha512       string
+	URL          string
+	SignatureURL string `survey:"signature-url"`
+	Filters      string
 }
 
 // Copy applies configured details to given asset
@@ -225,5 +240,6 @@ func (cfgPtr *Config) Copy(asset *types.Asset) {
 	asset.Namespace = cfgPtr.Namespace
 	asset.Sha512 = cfgPtr.Sha512
 	asset.URL = cfgPtr.URL
+	asset.SignatureURL = cfgPtr.SignatureURL
 	asset.Filters = helpers.SafeSplitCSV(cfgPtr.Filters)
 }
@@ -87,6 +87,7 @@ func TestConfigureAsset(t *testing.T) {
 	flags.StringSlice("filter", []string{}, "")
 	flags.String("sha512", "25e01b962045f4f5b624c3e47e782bef65c6c82602524dc569a8431b76cc1f57639d267380a7ec49f70876339ae261704fc51ed2fc520513cf94bc45ed7f6e17", "")
 	flags.String("url", "http://lol", "")
+	flags.String("signature-url", "http://lol.minisig", "")
 
 	// Too many args
 	cfg := ConfigureAsset{Flags: flags, Args: []string{"one", "too many"}, Namespace: "default"}
@@ -23,7 +23,7 @@ import (
 func OutdatedCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "outdated",
-		Short: "lists any assets installed from Bonsai that have newer versions available",
+		Short: "lists any assets installed from Bonsai or private indexes that have newer versions available",
 		RunE:  outdatedCommandExecute(cli),
 	}
 
@@ -32,6 +32,7 @@ func OutdatedCommand(cli *cli.SensuCli) *cobra.Command {
 	helpers.AddFieldSelectorFlag(cmd.Flags())
 	helpers.AddLabelSelectorFlag(cmd.Flags())
 	helpers.AddChunkSizeFlag(cmd.Flags())
+	cmd.Flags().StringSlice(indexFlag, nil, "URL or path of a private asset index to check the assets added from it against. Assets added from an index which is not listed are refused. This flag can be invoked multiple times (env SENSU_INDEX)")
 
 	return cmd
 }
@@ -61,10 +62,14 @@ func outdatedCommandExecute(cli *cli.SensuCli) func(cmd *cobra.Command, args []s
 			return err
 		}
 
+		v, err := helpers.InitViper(cmd.Flags())
+		if err != nil {
+			return err
+		}
 		bonsaiClient := bonsai.New(bonsai.Config{})
 
 		// Determine which local assets are outdated
-		outdatedAssets, err := outdatedAssets(results, bonsaiClient)
+		outdatedAssets, err := outdatedAssets(results, bonsaiClient, v.GetStringSlice(indexFlag))
 		if err != nil {
 			return err
 		}
@@ -79,10 +84,29 @@ func outdatedCommandExecute(cli *cli.SensuCli) func(cmd *cobra.Command, args []s
 	}
 }
 
+// newIndexClient returns the client of the private index of an asset
+var newIndexClient = func(location string) bonsai.Client {
+	return bonsai.NewIndexClient(bonsai.IndexConfig{Location: location})
+}
+
+// indexIsTrusted returns whether the index location is one of the indexes
+// given by the user
+func indexIsTrusted(location string, indexes []string) bool {
+	for _, index := range indexes {
+		if location == index {
+			return true
+		}
+	}
+	return false
+}
+
 // outdatedAssets compares the local Bonsai assets against the latest versions
-// on Bonsai and returns a list of assets that can be upgraded
-func outdatedAssets(assets []corev2.Asset, client bonsai.Client) ([]bonsai.OutdatedAsset, error) {
+// on Bonsai, or on their private index, and returns a list of assets that can
+// be upgraded. The index of an asset is read from its annotations, which any
+// user allowed to edit assets can set, so only the given indexes are queried.
+func outdatedAssets(assets []corev2.Asset, client bonsai.Client, indexes []string) ([]bonsai.OutdatedAsset, error) {
 	outdatedAssets := []bonsai.OutdatedAsset{}
+	indexClients := map[string]bonsai.Client{}
 
 	for _, asset := range assets {
 		annotations := asset.GetObjectMeta().Annotations
@@ -106,7 +130,18 @@ func outdatedAssets(assets []corev2.Asset, client bonsai.Client) ([]bonsai.Outda
 				return nil, fmt.Errorf("could not parse version %q of asset %s: %s", bonsaiVersion, asset.Name, err)
 			}
 
-			bonsaiAsset, err := client.FetchAsset(bonsaiNamespace, bonsaiName)
+			assetClient := client
+			if location := annotations[bonsai.IndexAnnotation]; location != "" {
+				if !indexIsTrusted(location, indexes) {
+					return nil, fmt.Errorf("asset %s was added from the index %q, pass it with --%s to check it", asset.Name, location, indexFlag)
+				}
+				if _, ok := indexClients[location]; !ok {
+					indexClients[location] = newIndexClient(location)
+				}
+				assetClient = indexClients[location]
+			}
+
+			bonsaiAsset, err := assetClient.FetchAsset(bonsaiNamespace, bonsaiName)
 			if err != nil {
 				return nil, fmt.Errorf("could not fetch asset %s: %s", asset.Name, err)
 			}
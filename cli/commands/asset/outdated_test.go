@@ -269,7 +269,7 @@ func Test_outdatedAssets(t *testing.T) {
 				tt.clientFunc(client)
 			}
 
-			got, err := outdatedAssets(tt.assets, client)
+			got, err := outdatedAssets(tt.assets, client, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("outdatedAssets() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -280,3 +280,46 @@ func Test_outdatedAssets(t *testing.T) {
 		})
 	}
 }
+
+func Test_outdatedAssetsIndex(t *testing.T) {
+	indexAsset := corev2.Asset{
+		ObjectMeta: corev2.ObjectMeta{
+			Name: "foo",
+			Annotations: map[string]string{
+				bonsai.URLAnnotation:       "https://assets.example.com/index.yml",
+				bonsai.IndexAnnotation:     "https://assets.example.com/index.yml",
+				bonsai.VersionAnnotation:   "1.1.0",
+				bonsai.NamespaceAnnotation: "internal",
+				bonsai.NameAnnotation:      "foo",
+			},
+		},
+	}
+
+	indexClient := &mockedBonsaiClient{}
+	indexClient.On("FetchAsset", "internal", "foo").Return(
+		&bonsai.Asset{Versions: []*bonsai.AssetVersionGrouping{{Version: "1.2.0"}}},
+		nil,
+	)
+	defer func(f func(string) bonsai.Client) { newIndexClient = f }(newIndexClient)
+	newIndexClient = func(location string) bonsai.Client {
+		assert.Equal(t, "https://assets.example.com/index.yml", location)
+		return indexClient
+	}
+
+	// The private index of the asset is refused unless given by the user
+	_, err := outdatedAssets([]corev2.Asset{indexAsset}, &mockedBonsaiClient{}, nil)
+	assert.Error(t, err)
+	_, err = outdatedAssets([]corev2.Asset{indexAsset}, &mockedBonsaiClient{}, []string{"https://evil.example.com/index.yml"})
+	assert.Error(t, err)
+
+	// The private index of the asset is used rather than Bonsai
+	got, err := outdatedAssets([]corev2.Asset{indexAsset}, &mockedBonsaiClient{}, []string{"https://assets.example.com/index.yml"})
+	assert.NoError(t, err)
+	assert.Equal(t, []bonsai.OutdatedAsset{{
+		BonsaiName:      "foo",
+		BonsaiNamespace: "internal",
+		AssetName:       "foo",
+		CurrentVersion:  "1.1.0",
+		LatestVersion:   "1.2.0",
+	}}, got)
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/apikey"
+	"github.com/sensu/sensu-go/cli/commands/apply"
 	"github.com/sensu/sensu-go/cli/commands/asset"
 	"github.com/sensu/sensu-go/cli/commands/check"
 	"github.com/sensu/sensu-go/cli/commands/cluster"
@@ -15,6 +16,7 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/create"
 	"github.com/sensu/sensu-go/cli/commands/delete"
 	"github.com/sensu/sensu-go/cli/commands/describetype"
+	"github.com/sensu/sensu-go/cli/commands/diff"
 	"github.com/sensu/sensu-go/cli/commands/dump"
 	"github.com/sensu/sensu-go/cli/commands/edit"
 	"github.com/sensu/sensu-go/cli/commands/entity"
@@ -23,6 +25,7 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/filter"
 	"github.com/sensu/sensu-go/cli/commands/handler"
 	"github.com/sensu/sensu-go/cli/commands/hook"
+	"github.com/sensu/sensu-go/cli/commands/lint"
 	"github.com/sensu/sensu-go/cli/commands/logout"
 	"github.com/sensu/sensu-go/cli/commands/mutator"
 	"github.com/sensu/sensu-go/cli/commands/namespace"
@@ -63,6 +66,9 @@ func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 		user.HelpCommand(cli),
 		silenced.HelpCommand(cli),
 		create.CreateCommand(cli),
+		apply.Command(cli),
+		diff.Command(cli),
+		lint.Command(cli),
 		delete.DeleteCommand(cli),
 		cluster.HelpCommand(cli),
 		edit.Command(cli),
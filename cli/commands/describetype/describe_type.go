@@ -7,9 +7,11 @@ import (
 	"strconv"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/client/config"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/commands/hooks"
 	"github.com/sensu/sensu-go/cli/elements/table"
 	"github.com/sensu/sensu-go/cli/resource"
 	"github.com/sensu/sensu-go/types"
@@ -26,6 +28,10 @@ $ sensuctl describe-type checks,handlers
 
 You can also use the 'all' qualifier to describe all available types:
 $ sensuctl describe-type all
+
+With --schema, the JSON Schema of the types is printed instead, which editors
+and other tools can use to validate resource files:
+$ sensuctl describe-type core/v2.CheckConfig --schema > check.schema.json
 `
 
 type apiResource struct {
@@ -43,10 +49,15 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 		Short: "Print details about the supported API resources types",
 		Long:  description,
 		RunE:  execute(cli),
+		// The types are described without contacting the server
+		Annotations: map[string]string{
+			hooks.ConfigurationRequirement: hooks.ConfigurationNotRequired,
+		},
 	}
 
 	format := cli.Config.Format()
 	_ = cmd.Flags().StringP("format", "", format, fmt.Sprintf(`format of data returned ("%s"|"%s")`, config.FormatWrappedJSON, config.FormatYAML))
+	_ = cmd.Flags().Bool("schema", false, "Print the JSON Schema of the types")
 
 	return cmd
 }
@@ -65,6 +76,14 @@ func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
 			return err
 		}
 
+		schema, err := cmd.Flags().GetBool("schema")
+		if err != nil {
+			return err
+		}
+		if schema {
+			return printSchema(requested, getFormat(cli, cmd), cmd.OutOrStdout())
+		}
+
 		for _, resource := range requested {
 			wrapped := types.WrapResource(resource)
 
@@ -104,6 +123,19 @@ func getFormat(cli *cli.SensuCli, cmd *cobra.Command) string {
 	return format
 }
 
+// printSchema prints the JSON Schema of resources, in YAML or JSON otherwise
+func printSchema(resources []corev2.Resource, format string, w io.Writer) error {
+	values := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		values = append(values, resource)
+	}
+	schema := openapi.ResourceSchema(values...)
+	if format == config.FormatYAML {
+		return helpers.PrintYAML(schema, w)
+	}
+	return helpers.PrintJSON(schema, w)
+}
+
 // isNamespaced is a hack to determine whether a resource is global or
 // namespaced, by relying on the SetNamespace method, which is a no-op for
 // global resources, and inspecting the resulting namespace
@@ -1,10 +1,13 @@
 package describetype
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/sensu/sensu-go/backend/apid/openapi"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCommand(t *testing.T) {
@@ -43,3 +46,23 @@ func TestListFlags(t *testing.T) {
 	flag := cmd.Flag("format")
 	assert.NotNil(flag)
 }
+
+func TestSchema(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("schema", "true"))
+
+	out, err := test.RunCmd(cmd, []string{"core/v2.CheckConfig"})
+	require.NoError(t, err)
+	var schema openapi.Schema
+	require.NoError(t, json.Unmarshal([]byte(out), &schema))
+	assert.Equal(t, "core/v2.CheckConfig", schema.Title)
+	assert.Contains(t, schema.Properties, "spec")
+
+	out, err = test.RunCmd(cmd, []string{"checks,core/v3.EntityConfig"})
+	require.NoError(t, err)
+	schema = openapi.Schema{}
+	require.NoError(t, json.Unmarshal([]byte(out), &schema))
+	require.Len(t, schema.OneOf, 2)
+	assert.Equal(t, "core/v3.EntityConfig", schema.OneOf[1].Title)
+}
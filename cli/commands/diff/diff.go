@@ -0,0 +1,185 @@
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+var description = `sensuctl diff
+
+Show the changes that creating resources from files, URLs or STDIN would make,
+without changing anything. Like sensuctl create, the declared resources replace
+the live ones, so the fields missing from the files show as removed.
+Server-managed fields such as created_by are ignored. Example:
+$ sensuctl diff -f checks.yml
+
+The changes that sensuctl apply would make instead, merging the declared
+resources into the live ones, are shown with --apply:
+$ sensuctl diff -f checks.yml --apply
+
+The tabular format prints a unified diff per resource, the other formats print
+the changed fields of each resource:
+$ sensuctl diff -f checks.yml --format yaml
+
+The resources that apply --prune would delete can be previewed too:
+$ sensuctl diff -r -f checks/ --apply --prune --label sensu.io/managed_by=git
+`
+
+// Command shows the differences between resource files and the live state of
+// the resources.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [-r] [[-f URL] ... ] [--apply [--prune --label KEY=VALUE]]",
+		Short: "Show the differences between resources from file or URL (path, file://, http[s]://), or STDIN otherwise, and their live state",
+		Long:  description,
+		RunE:  execute(cli),
+	}
+
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs to read resources from")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("apply", false, "Show the changes sensuctl apply would make, merging the resources into their live state")
+	_ = cmd.Flags().Bool("prune", false, "Show the resources holding the label that are no longer declared, with --apply")
+	_ = cmd.Flags().String("label", corev2.ManagedByLabel+"=sensuctl", "Label set on the resources and selecting the ones to prune, as key=value")
+	_ = cmd.Flags().Bool("exit-code", false, fmt.Sprintf("Exit with status %d if there are differences", resource.ExitDrift))
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+// ResourceDiff is the structured representation of the changes to a resource.
+type ResourceDiff struct {
+	Resource string                 `json:"resource" yaml:"resource"`
+	Action   resource.ChangeAction  `json:"action" yaml:"action"`
+	Changes  []resource.FieldChange `json:"changes,omitempty" yaml:"changes,omitempty"`
+}
+
+// differ is a resource.Processor printing the changes to the resources.
+type differ struct {
+	applier  *resource.Applier
+	format   string
+	exitCode bool
+	out      io.Writer
+}
+
+func (d *differ) Process(client client.GenericClient, resources []*types.Wrapper) error {
+	changes, err := d.applier.Plan(client, resources)
+	if err != nil {
+		return err
+	}
+
+	var count int
+	switch d.format {
+	case config.FormatJSON, config.FormatWrappedJSON, config.FormatYAML:
+		diffs := []ResourceDiff{}
+		for _, change := range changes {
+			if change.Action == resource.ActionNone {
+				continue
+			}
+			fields, err := change.FieldChanges()
+			if err != nil {
+				return err
+			}
+			diffs = append(diffs, ResourceDiff{
+				Resource: change.Name(),
+				Action:   change.Action,
+				Changes:  fields,
+			})
+		}
+		count = len(diffs)
+		if d.format == config.FormatYAML {
+			err = helpers.PrintYAML(diffs, d.out)
+		} else {
+			err = helpers.PrintJSON(diffs, d.out)
+		}
+	default:
+		count, err = resource.PrintChanges(d.out, changes)
+	}
+	if err != nil {
+		return err
+	}
+
+	if d.exitCode && count > 0 {
+		return &resource.DriftError{Changes: count}
+	}
+	return nil
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+
+		label, err := cmd.Flags().GetString("label")
+		if err != nil {
+			return err
+		}
+		key, value, err := resource.ParseLabel(label)
+		if err != nil {
+			return err
+		}
+		prune, err := cmd.Flags().GetBool("prune")
+		if err != nil {
+			return err
+		}
+		apply, err := cmd.Flags().GetBool("apply")
+		if err != nil {
+			return err
+		}
+		if prune && !apply {
+			return errors.New("--prune requires --apply")
+		}
+		if prune && !cmd.Flags().Changed("label") {
+			return errors.New("--prune requires an explicit --label")
+		}
+		exitCode, err := cmd.Flags().GetBool("exit-code")
+		if err != nil {
+			return err
+		}
+		format := cli.Config.Format()
+		if flag := helpers.GetChangedStringValueViper(flags.Format, cmd.Flags()); flag != "" {
+			format = flag
+		}
+		processor := &differ{
+			applier: &resource.Applier{
+				LabelKey:   key,
+				LabelValue: value,
+				Prune:      prune,
+				Namespace:  cli.Config.Namespace(),
+				Replace:    !apply,
+			},
+			format:   format,
+			exitCode: exitCode,
+			out:      cmd.OutOrStdout(),
+		}
+
+		t := &http.Transport{}
+		t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+		client := &http.Client{Transport: t}
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		if len(inputs) == 0 {
+			return resource.ProcessStdin(cli, client, processor)
+		}
+		recurse, err := cmd.Flags().GetBool("recursive")
+		if err != nil {
+			return err
+		}
+		return resource.Process(cli, client, inputs, recurse, processor)
+	}
+}
@@ -0,0 +1,145 @@
+package diff
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	mockclient "github.com/sensu/sensu-go/cli/client/testing"
+	cmdtesting "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/command"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T, format string) (*cli.SensuCli, string, func()) {
+	cli := cmdtesting.NewMockCLI()
+	cli.Config.(*mockclient.MockConfig).On("Format").Return(format)
+
+	live := corev2.FixtureCheckConfig("check")
+	live.Interval = 120
+	live.Timeout = 30
+	lastApplied := corev2.FixtureCheckConfig("check")
+	lastApplied.Interval = 120
+	b, err := json.Marshal(types.WrapResource(lastApplied))
+	require.NoError(t, err)
+	live.Annotations = map[string]string{resource.LastAppliedAnnotation: string(b)}
+	live.CreatedBy = "admin"
+	live.Labels = map[string]string{corev2.ManagedByLabel: "sensuctl"}
+	client := cli.Client.(*mockclient.MockClient)
+	client.On("Get", live.URIPath(), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*corev2.CheckConfig) = *live
+	})
+
+	td, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	b, err = json.Marshal(types.WrapResource(corev2.FixtureCheckConfig("check")))
+	require.NoError(t, err)
+	fp := filepath.Join(td, "check.json")
+	require.NoError(t, ioutil.WriteFile(fp, b, 0644))
+
+	return cli, fp, func() { _ = os.RemoveAll(td) }
+}
+
+func TestDiffTabular(t *testing.T) {
+	cli, fp, cleanup := setup(t, "tabular")
+	defer cleanup()
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	out, err := cmdtesting.RunCmd(cmd, nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "~ core/v2.CheckConfig default/check\n")
+	assert.Contains(t, out, "-  interval: 120\n+  interval: 60\n")
+	assert.NotContains(t, out, "created_by")
+	assert.Contains(t, out, "0 to create, 1 to update, 0 to delete, 0 unchanged\n")
+	cli.Client.(*mockclient.MockClient).AssertNotCalled(t, "PutResource", mock.Anything)
+}
+
+func TestDiffStructured(t *testing.T) {
+	cli, fp, cleanup := setup(t, "tabular")
+	defer cleanup()
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	require.NoError(t, cmd.Flags().Set("format", "json"))
+	out, err := cmdtesting.RunCmd(cmd, nil)
+	require.NoError(t, err)
+
+	var diffs []ResourceDiff
+	require.NoError(t, json.Unmarshal([]byte(out), &diffs))
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "core/v2.CheckConfig default/check", diffs[0].Resource)
+	assert.Equal(t, resource.ActionUpdate, diffs[0].Action)
+	assert.Equal(t, []resource.FieldChange{
+		{Path: "spec.interval", Live: float64(120), Applied: float64(60)},
+		{Path: "spec.timeout", Live: float64(30), Applied: float64(0)},
+	}, diffs[0].Changes)
+}
+
+func TestDiffApply(t *testing.T) {
+	cli, fp, cleanup := setup(t, "tabular")
+	defer cleanup()
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	require.NoError(t, cmd.Flags().Set("format", "json"))
+	require.NoError(t, cmd.Flags().Set("apply", "true"))
+	out, err := cmdtesting.RunCmd(cmd, nil)
+	require.NoError(t, err)
+
+	// The timeout set on the server only is kept by apply
+	var diffs []ResourceDiff
+	require.NoError(t, json.Unmarshal([]byte(out), &diffs))
+	require.Len(t, diffs, 1)
+	assert.Equal(t, []resource.FieldChange{
+		{Path: "spec.interval", Live: float64(120), Applied: float64(60)},
+	}, diffs[0].Changes)
+}
+
+func TestDiffPruneRequiresApply(t *testing.T) {
+	cli, fp, cleanup := setup(t, "tabular")
+	defer cleanup()
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	require.NoError(t, cmd.Flags().Set("prune", "true"))
+	require.NoError(t, cmd.Flags().Set("label", "sensu.io/managed_by=git"))
+	_, err := cmdtesting.RunCmd(cmd, nil)
+	assert.Error(t, err)
+}
+
+func TestDiffYAML(t *testing.T) {
+	cli, fp, cleanup := setup(t, "yaml")
+	defer cleanup()
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	out, err := cmdtesting.RunCmd(cmd, nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "resource: core/v2.CheckConfig default/check\n")
+	assert.Contains(t, out, "path: spec.interval\n")
+}
+
+func TestDiffExitCode(t *testing.T) {
+	cli, fp, cleanup := setup(t, "tabular")
+	defer cleanup()
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	require.NoError(t, cmd.Flags().Set("exit-code", "true"))
+	_, err := cmdtesting.RunCmd(cmd, nil)
+	require.Error(t, err)
+	status, ok := err.(command.CommandErrorer)
+	require.True(t, ok)
+	assert.Equal(t, resource.ExitDrift, status.ExitStatus())
+}
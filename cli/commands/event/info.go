@@ -9,13 +9,17 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/sensu/sensu-go/cli/elements/list"
+	"github.com/sensu/sensu-go/cli/elements/table"
 	"github.com/sensu/sensu-go/types"
 	"github.com/spf13/cobra"
 )
 
+const flagHandlers = "handlers"
+
 // InfoCommand defines new event info command
 func InfoCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
@@ -28,22 +32,34 @@ func InfoCommand(cli *cli.SensuCli) *cobra.Command {
 				return errors.New("invalid argument(s) received")
 			}
 
-			// Fetch event from API
+			// Determine the format to use to output the data
+			flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
+			format := cli.Config.Format()
+
 			entity := args[0]
 			check := args[1]
+
+			// Fetch the handler executions of the event from API if requested
+			if handlers, _ := cmd.Flags().GetBool(flagHandlers); handlers {
+				executions, err := cli.Client.FetchHandlerExecutions(entity, check)
+				if err != nil {
+					return err
+				}
+				return helpers.PrintFormatted(flag, format, executions, cmd.OutOrStdout(), printHandlerExecutionsToTable)
+			}
+
+			// Fetch event from API
 			event, err := cli.Client.FetchEvent(entity, check)
 			if err != nil {
 				return err
 			}
 
-			// Determine the format to use to output the data
-			flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
-			format := cli.Config.Format()
 			return helpers.PrintFormatted(flag, format, event, cmd.OutOrStdout(), printToList)
 		},
 	}
 
 	helpers.AddFormatFlag(cmd.Flags())
+	cmd.Flags().Bool(flagHandlers, false, "show the recorded handler executions of the event")
 
 	return cmd
 }
@@ -113,3 +129,80 @@ func printToList(v interface{}, writer io.Writer) error {
 
 	return list.Print(writer, cfg)
 }
+
+func printHandlerExecutionsToTable(v interface{}, writer io.Writer) error {
+	executions, ok := v.([]*corev2.HandlerExecution)
+	if !ok {
+		return fmt.Errorf("%t is not a list of handler executions", v)
+	}
+
+	table := table.New([]*table.Column{
+		{
+			Title:       "Handler",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				execution, ok := data.(*corev2.HandlerExecution)
+				if !ok {
+					return cli.TypeError
+				}
+				return execution.Name
+			},
+		},
+		{
+			Title: "Executed",
+			CellTransformer: func(data interface{}) string {
+				execution, ok := data.(*corev2.HandlerExecution)
+				if !ok {
+					return cli.TypeError
+				}
+				return time.Unix(execution.Executed, 0).String()
+			},
+		},
+		{
+			Title: "Duration",
+			CellTransformer: func(data interface{}) string {
+				execution, ok := data.(*corev2.HandlerExecution)
+				if !ok {
+					return cli.TypeError
+				}
+				return time.Duration(execution.Duration * float64(time.Second)).Round(time.Millisecond).String()
+			},
+		},
+		{
+			Title: "Status",
+			CellTransformer: func(data interface{}) string {
+				execution, ok := data.(*corev2.HandlerExecution)
+				if !ok {
+					return cli.TypeError
+				}
+				return strconv.Itoa(int(execution.Status))
+			},
+		},
+		{
+			Title: "Output",
+			CellTransformer: func(data interface{}) string {
+				execution, ok := data.(*corev2.HandlerExecution)
+				if !ok {
+					return cli.TypeError
+				}
+				if execution.Error != "" {
+					return execution.Error
+				}
+				return strings.TrimSuffix(execution.Output, "\n")
+			},
+		},
+		{
+			Title: "Event UUID",
+			CellTransformer: func(data interface{}) string {
+				execution, ok := data.(*corev2.HandlerExecution)
+				if !ok {
+					return cli.TypeError
+				}
+				return execution.EventID
+			},
+		},
+	})
+
+	table.Render(writer, executions)
+	return nil
+}
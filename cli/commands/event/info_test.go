@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/sensu/sensu-go/types"
@@ -78,3 +79,24 @@ func TestInfoCommandRunEClosureWithErr(t *testing.T) {
 	assert.Equal(t, "error", err.Error())
 	assert.Empty(t, out)
 }
+
+func TestInfoCommandRunEClosureWithHandlers(t *testing.T) {
+	event := types.FixtureEvent("foo", "check_foo")
+	execution := corev2.NewHandlerExecution(event, "pagerduty")
+	execution.Output = "incident created"
+
+	cli := test.NewMockCLI()
+	cli.Client.(*client.MockClient).
+		On("FetchHandlerExecutions", "foo", "check_foo").
+		Return([]*corev2.HandlerExecution{execution}, nil)
+	cli.Config.(*client.MockConfig).On("Format").Return("tabular")
+
+	cmd := InfoCommand(cli)
+	require.NoError(t, cmd.Flags().Set("handlers", "true"))
+
+	out, err := test.RunCmd(cmd, []string{"foo", "check_foo"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "pagerduty")
+	assert.Contains(t, out, "incident created")
+	assert.Contains(t, out, execution.EventID)
+}
@@ -71,6 +71,8 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd.Flags().StringP("timeout", "i", "", "execution duration timeout in seconds (hard stop)")
 	cmd.Flags().StringP("type", "t", typeDefault, "type of handler (pipe, tcp, udp, or set)")
 	cmd.Flags().StringP("runtime-assets", "r", "", "comma separated list of assets this handler depends on")
+	cmd.Flags().String("retries", "", "number of times a failed pipe, tcp or udp handler execution is retried")
+	cmd.Flags().String("retry-backoff", "", "delay in seconds before the first retry, doubled on each subsequent retry")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/spf13/cobra"
+)
+
+// FailuresCommand defines the parent command of the failed handler
+// executions commands
+func FailuresCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failures",
+		Short: "manage handler executions that exhausted their retries",
+		RunE:  helpers.DefaultSubCommandRunE,
+	}
+
+	cmd.AddCommand(
+		FailuresListCommand(cli),
+		FailuresRetryCommand(cli),
+		FailuresPurgeCommand(cli),
+	)
+
+	return cmd
+}
+
+// FailuresListCommand adds a command that lists the failed handler executions
+func FailuresListCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list [HANDLER]",
+		Short:        "list handler executions that exhausted their retries",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			failures, err := cli.Client.ListHandlerFailures(cli.Config.Namespace())
+			if err != nil {
+				return err
+			}
+
+			// Only keep the failures of the given handler
+			if len(args) == 1 {
+				filtered := []*corev2.HandlerFailure{}
+				for _, failure := range failures {
+					if failure.Handler == args[0] {
+						filtered = append(filtered, failure)
+					}
+				}
+				failures = filtered
+			}
+
+			flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
+			return helpers.PrintFormatted(flag, cli.Config.Format(), failures, cmd.OutOrStdout(), printFailuresToTable)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+// FailuresRetryCommand adds a command that queues a failed handler execution
+// for another retry
+func FailuresRetryCommand(cli *cli.SensuCli) *cobra.Command {
+	return &cobra.Command{
+		Use:          "retry [ID]",
+		Short:        "retry a handler execution that exhausted its retries",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			if err := cli.Client.RetryHandlerFailure(cli.Config.Namespace(), args[0]); err != nil {
+				return err
+			}
+
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Queued for retry")
+			return err
+		},
+	}
+}
+
+// FailuresPurgeCommand adds a command that deletes a failed handler
+// execution, or all of them when no ID is given
+func FailuresPurgeCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "purge [ID]",
+		Short:        "delete handler executions that exhausted their retries",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			id := ""
+			if len(args) == 1 {
+				id = args[0]
+			}
+
+			if skipConfirm, _ := cmd.Flags().GetBool("skip-confirm"); !skipConfirm {
+				name := id
+				if name == "" {
+					name = "all"
+				}
+				if confirmed := helpers.ConfirmDeleteResource(name, "handler failure"); !confirmed {
+					fmt.Fprintln(cmd.OutOrStdout(), "Canceled")
+					return nil
+				}
+			}
+
+			if err := cli.Client.PurgeHandlerFailures(cli.Config.Namespace(), id); err != nil {
+				return err
+			}
+
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Purged")
+			return err
+		},
+	}
+
+	cmd.Flags().Bool("skip-confirm", false, "skip interactive confirmation prompt")
+
+	return cmd
+}
+
+func printFailuresToTable(v interface{}, writer io.Writer) error {
+	failures, ok := v.([]*corev2.HandlerFailure)
+	if !ok {
+		return fmt.Errorf("%t is not a list of handler failures", v)
+	}
+
+	table := table.New([]*table.Column{
+		{
+			Title:       "ID",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				failure, ok := data.(*corev2.HandlerFailure)
+				if !ok {
+					return cli.TypeError
+				}
+				return failure.Name
+			},
+		},
+		{
+			Title: "Handler",
+			CellTransformer: func(data interface{}) string {
+				failure, ok := data.(*corev2.HandlerFailure)
+				if !ok {
+					return cli.TypeError
+				}
+				return failure.Handler
+			},
+		},
+		{
+			Title: "Event",
+			CellTransformer: func(data interface{}) string {
+				failure, ok := data.(*corev2.HandlerFailure)
+				if !ok {
+					return cli.TypeError
+				}
+				if failure.Event == nil || failure.Event.Entity == nil {
+					return ""
+				}
+				if failure.Event.HasCheck() {
+					return failure.Event.Entity.Name + "/" + failure.Event.Check.Name
+				}
+				return failure.Event.Entity.Name
+			},
+		},
+		{
+			Title: "Attempts",
+			CellTransformer: func(data interface{}) string {
+				failure, ok := data.(*corev2.HandlerFailure)
+				if !ok {
+					return cli.TypeError
+				}
+				return strconv.FormatUint(uint64(failure.Attempts), 10)
+			},
+		},
+		{
+			Title: "Last Attempt",
+			CellTransformer: func(data interface{}) string {
+				failure, ok := data.(*corev2.HandlerFailure)
+				if !ok {
+					return cli.TypeError
+				}
+				return time.Unix(failure.LastAttempt, 0).String()
+			},
+		},
+		{
+			Title: "Error",
+			CellTransformer: func(data interface{}) string {
+				failure, ok := data.(*corev2.HandlerFailure)
+				if !ok {
+					return cli.TypeError
+				}
+				return failure.Error
+			},
+		},
+	})
+
+	table.Render(writer, failures)
+	return nil
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	clientmock "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailuresListCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*clientmock.MockConfig)
+	config.On("Format").Return("tabular")
+	client := cli.Client.(*clientmock.MockClient)
+	failures := []*corev2.HandlerFailure{
+		corev2.NewHandlerFailure(corev2.FixtureHandler("slack"), corev2.FixtureEvent("entity1", "check1"), nil, errors.New("slack is down")),
+		corev2.NewHandlerFailure(corev2.FixtureHandler("pagerduty"), corev2.FixtureEvent("entity1", "check1"), nil, errors.New("pagerduty is down")),
+	}
+	client.On("ListHandlerFailures", "default").Return(failures, nil)
+
+	cmd := FailuresListCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"slack"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "slack is down")
+	assert.Contains(t, out, "entity1/check1")
+	assert.NotContains(t, out, "pagerduty is down")
+}
+
+func TestFailuresListCommandWithServerErr(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*clientmock.MockClient)
+	client.On("ListHandlerFailures", "default").Return([]*corev2.HandlerFailure(nil), errors.New("oh noes"))
+
+	cmd := FailuresListCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+	assert.Empty(t, out)
+	assert.EqualError(t, err, "oh noes")
+}
+
+func TestFailuresRetryCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*clientmock.MockClient)
+	client.On("RetryHandlerFailure", "default", "abc").Return(nil)
+
+	cmd := FailuresRetryCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"abc"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Queued for retry")
+
+	out, err = test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+	assert.Regexp(t, "Usage", out)
+}
+
+func TestFailuresPurgeCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*clientmock.MockClient)
+	client.On("PurgeHandlerFailures", "default", "abc").Return(nil)
+	client.On("PurgeHandlerFailures", "default", "").Return(nil)
+
+	cmd := FailuresPurgeCommand(cli)
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	out, err := test.RunCmd(cmd, []string{"abc"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Purged")
+
+	out, err = test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Purged")
+	client.AssertExpectations(t)
+}
+
+func TestFailuresPurgeCommandFailConfirm(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := FailuresPurgeCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+	assert.Contains(t, out, "Canceled")
+	assert.NoError(t, err)
+}
@@ -18,6 +18,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd.AddCommand(
 		CreateCommand(cli),
 		DeleteCommand(cli),
+		FailuresCommand(cli),
 		InfoCommand(cli),
 		ListCommand(cli),
 		UpdateCommand(cli),
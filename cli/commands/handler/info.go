@@ -95,6 +95,14 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Timeout",
 				Value: strconv.FormatInt(int64(handler.Timeout), 10),
 			},
+			{
+				Label: "Retries",
+				Value: strconv.FormatUint(uint64(handler.Retries), 10),
+			},
+			{
+				Label: "Retry Backoff",
+				Value: strconv.FormatUint(uint64(handler.RetryBackoff), 10),
+			},
 			{
 				Label: "Filters",
 				Value: strings.Join(handler.Filters, ", "),
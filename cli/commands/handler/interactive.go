@@ -23,6 +23,8 @@ type handlerOpts struct {
 	Type          string `survey:"type"`
 	Namespace     string
 	RuntimeAssets string `survey:"assets"`
+	Retries       string
+	RetryBackoff  string
 }
 
 const (
@@ -47,6 +49,8 @@ func (opts *handlerOpts) withHandler(handler *types.Handler) {
 	opts.Timeout = strconv.FormatUint(uint64(handler.Timeout), 10)
 	opts.Type = handler.Type
 	opts.RuntimeAssets = strings.Join(handler.RuntimeAssets, ",")
+	opts.Retries = strconv.FormatUint(uint64(handler.Retries), 10)
+	opts.RetryBackoff = strconv.FormatUint(uint64(handler.RetryBackoff), 10)
 
 	if handler.Socket != nil {
 		opts.SocketHost = handler.Socket.Host
@@ -65,6 +69,8 @@ func (opts *handlerOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Timeout, _ = flags.GetString("timeout")
 	opts.Type, _ = flags.GetString("type")
 	opts.RuntimeAssets, _ = flags.GetString("runtime-assets")
+	opts.Retries, _ = flags.GetString("retries")
+	opts.RetryBackoff, _ = flags.GetString("retry-backoff")
 
 	if namespace := helpers.GetChangedStringValueViper("namespace", flags); namespace != "" {
 		opts.Namespace = namespace
@@ -237,6 +243,15 @@ func (opts *handlerOpts) Copy(handler *types.Handler) {
 		handler.Timeout = 0
 	}
 
+	if len(opts.Retries) > 0 {
+		r, _ := strconv.ParseUint(opts.Retries, 10, 32)
+		handler.Retries = uint32(r)
+	}
+	if len(opts.RetryBackoff) > 0 {
+		b, _ := strconv.ParseUint(opts.RetryBackoff, 10, 32)
+		handler.RetryBackoff = uint32(b)
+	}
+
 	if len(opts.SocketHost) > 0 && len(opts.SocketPort) > 0 {
 		p, _ := strconv.ParseUint(opts.SocketPort, 10, 32)
 		handler.Socket = &types.HandlerSocket{
@@ -0,0 +1,111 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/commands/hooks"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/spf13/cobra"
+)
+
+var description = `sensuctl lint
+
+Validate resource files without sending them to the server. The problems are
+reported with their file, line and column:
+$ sensuctl lint -r -f checks/
+
+Unknown fields, invalid values, cron schedules, time windows and javascript
+expressions are reported, as well as references to handlers, filters,
+mutators, assets and pipelines that are not defined in the files. With
+--remote, the referenced resources are also looked up on the server.
+`
+
+// Command validates resource files.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint [-r] [[-f FILE] ... ] [--remote]",
+		Short: "Validate resources from files or directories, or STDIN otherwise, without applying them",
+		Long:  description,
+		RunE:  execute(cli),
+		// Linting is done offline, unless --remote is given
+		Annotations: map[string]string{
+			hooks.ConfigurationRequirement: hooks.ConfigurationNotRequired,
+		},
+	}
+
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files or directories to validate resources from")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("remote", false, "Look up the referenced resources on the server when they are not defined in the files")
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+
+		linter := &resource.Linter{Namespace: cli.Config.Namespace()}
+		remote, err := cmd.Flags().GetBool("remote")
+		if err != nil {
+			return err
+		}
+		if remote {
+			linter.Remote = cli.Client
+		}
+
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		recurse, err := cmd.Flags().GetBool("recursive")
+		if err != nil {
+			return err
+		}
+		if len(inputs) == 0 {
+			if err := linter.AddFile("stdin", os.Stdin); err != nil {
+				return err
+			}
+		}
+		for _, input := range inputs {
+			if err := linter.AddPath(input, recurse); err != nil {
+				return err
+			}
+		}
+
+		diagnostics := linter.Lint()
+		format := cli.Config.Format()
+		if flag := helpers.GetChangedStringValueViper(flags.Format, cmd.Flags()); flag != "" {
+			format = flag
+		}
+		switch format {
+		case config.FormatJSON, config.FormatWrappedJSON:
+			err = helpers.PrintJSON(diagnostics, cmd.OutOrStdout())
+		case config.FormatYAML:
+			err = helpers.PrintYAML(diagnostics, cmd.OutOrStdout())
+		default:
+			for _, d := range diagnostics {
+				if _, err = fmt.Fprintln(cmd.OutOrStdout(), d); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(diagnostics) > 0 {
+			return fmt.Errorf("%d problem(s) found", len(diagnostics))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,79 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mockclient "github.com/sensu/sensu-go/cli/client/testing"
+	cmdtesting "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const check = `type: CheckConfig
+metadata:
+  name: check-cpu
+spec:
+  command: check-cpu.sh
+  %s: 60
+  subscriptions: [linux]
+`
+
+func writeCheck(t *testing.T, dir, intervalField string) string {
+	fp := filepath.Join(dir, "check.yml")
+	require.NoError(t, ioutil.WriteFile(fp, []byte(fmt.Sprintf(check, intervalField)), 0644))
+	return fp
+}
+
+func TestLint(t *testing.T) {
+	td, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(td)
+
+	cli := cmdtesting.NewMockCLI()
+	cli.Config.(*mockclient.MockConfig).On("Format").Return("tabular")
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", writeCheck(t, td, "interval")))
+	out, err := cmdtesting.RunCmd(cmd, nil)
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	cmd = Command(cli)
+	fp := writeCheck(t, td, "intervall")
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	out, err = cmdtesting.RunCmd(cmd, nil)
+	assert.EqualError(t, err, "1 problem(s) found")
+	assert.Equal(t, fp+`:6:3: unknown field "intervall" in spec, did you mean "interval"?`+"\n", out)
+}
+
+func TestLintJSONFormat(t *testing.T) {
+	td, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(td)
+
+	cli := cmdtesting.NewMockCLI()
+	cli.Config.(*mockclient.MockConfig).On("Format").Return("tabular")
+
+	cmd := Command(cli)
+	fp := writeCheck(t, td, "intervall")
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	require.NoError(t, cmd.Flags().Set("format", "json"))
+	out, err := cmdtesting.RunCmd(cmd, nil)
+	assert.Error(t, err)
+
+	var diagnostics []resource.Diagnostic
+	require.NoError(t, json.Unmarshal([]byte(out), &diagnostics))
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, resource.Diagnostic{
+		File:    fp,
+		Line:    6,
+		Column:  3,
+		Message: `unknown field "intervall" in spec, did you mean "interval"?`,
+	}, diagnostics[0])
+}
@@ -0,0 +1,140 @@
+package namespace
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/spf13/cobra"
+)
+
+var cloneDescription = `sensuctl namespace clone
+
+Copy the resources of a namespace to another one, all of them or none. The
+namespace of the copies, and of the namespaced references they hold, is set to
+the destination namespace. Existing resources of the destination namespace are
+updated.
+
+Checks, check overrides, assets, filters, handlers, hooks, mutators, pipelines,
+roles and role bindings are copied, unless --types restricts the copy to some
+of them:
+$ sensuctl namespace clone template team-a --types checks,handlers,roles
+
+With --dry-run, nothing is changed and the resources that would be copied are
+printed.
+`
+
+// CloneCommand adds command that allows users to clone namespaces
+func CloneCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "clone [SRC] [DST] [--types TYPES] [--label-selector SELECTOR] [--dry-run]",
+		Short:        "copy the resources of a namespace to another namespace",
+		Long:         cloneDescription,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			typesSpec, err := cmd.Flags().GetString("types")
+			if err != nil {
+				return err
+			}
+			opts := client.CloneOptions{}
+			if typesSpec != "all" {
+				resources, err := resource.GetResourceRequests(typesSpec, resource.All)
+				if err != nil {
+					return err
+				}
+				for _, r := range resources {
+					opts.Types = append(opts.Types, r.RBACName())
+				}
+			}
+			if opts.LabelSelector, err = cmd.Flags().GetString(flags.LabelSelector); err != nil {
+				return err
+			}
+			if opts.DryRun, err = cmd.Flags().GetBool("dry-run"); err != nil {
+				return err
+			}
+
+			report, err := cli.Client.CloneNamespace(args[0], args[1], opts)
+			if report != nil {
+				flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
+				format := cli.Config.Format()
+				if perr := helpers.PrintFormatted(flag, format, report, cmd.OutOrStdout(), printCloneReport); perr != nil && err == nil {
+					err = perr
+				}
+			}
+			return err
+		},
+	}
+
+	_ = cmd.Flags().String("types", "all", "Comma separated list of the types of resources to copy")
+	_ = cmd.Flags().Bool("dry-run", false, "Only print the resources that would be copied")
+	helpers.AddLabelSelectorFlag(cmd.Flags())
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printCloneReport(v interface{}, writer io.Writer) error {
+	report, ok := v.(*client.CloneReport)
+	if !ok {
+		return fmt.Errorf("%t is not a CloneReport", v)
+	}
+	if len(report.Results) > 0 {
+		table := table.New([]*table.Column{
+			{
+				Title:       "Type",
+				ColumnStyle: table.PrimaryTextStyle,
+				CellTransformer: func(data interface{}) string {
+					result, ok := data.(client.CloneResult)
+					if !ok {
+						return cli.TypeError
+					}
+					return result.APIVersion + "." + result.Type
+				},
+			},
+			{
+				Title: "Name",
+				CellTransformer: func(data interface{}) string {
+					result, ok := data.(client.CloneResult)
+					if !ok {
+						return cli.TypeError
+					}
+					return result.Name
+				},
+			},
+			{
+				Title: "Action",
+				CellTransformer: func(data interface{}) string {
+					result, ok := data.(client.CloneResult)
+					if !ok {
+						return cli.TypeError
+					}
+					if result.Error != "" {
+						return result.Error
+					}
+					return result.Action
+				},
+			},
+		})
+		table.Render(writer, report.Results)
+	}
+
+	var err error
+	switch {
+	case report.Applied:
+		_, err = fmt.Fprintf(writer, "Copied %d resources\n", len(report.Results))
+	case report.DryRun && report.Message == "":
+		_, err = fmt.Fprintf(writer, "Dry run: %d resources would be copied\n", len(report.Results))
+	}
+	return err
+}
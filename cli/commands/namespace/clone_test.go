@@ -0,0 +1,98 @@
+package namespace
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli/client"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := CloneCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("clone", cmd.Use)
+	assert.Regexp("namespace", cmd.Short)
+}
+
+func TestCloneCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	report := &client.CloneReport{
+		DryRun: true,
+		Results: []client.CloneResult{
+			{APIVersion: "core/v2", Type: "CheckConfig", Namespace: "team", Name: "check", Action: "created"},
+		},
+	}
+	opts := client.CloneOptions{
+		Types:         []string{"checks", "roles"},
+		LabelSelector: "env == prod",
+		DryRun:        true,
+	}
+	cli.Client.(*clienttest.MockClient).
+		On("CloneNamespace", "template", "team", opts).
+		Return(report, nil)
+
+	cmd := CloneCommand(cli)
+	require.NoError(t, cmd.Flags().Set("types", "checks,core/v2.Role"))
+	require.NoError(t, cmd.Flags().Set("label-selector", "env == prod"))
+	require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+	out, err := test.RunCmd(cmd, []string{"template", "team"})
+
+	assert.NoError(err)
+	assert.Contains(out, "core/v2.CheckConfig")
+	assert.Contains(out, "created")
+	assert.Contains(out, "Dry run: 1 resources would be copied")
+}
+
+func TestCloneCommandRunEClosureWithErr(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	report := &client.CloneReport{
+		Message: "some resources can't be applied, none were",
+		Results: []client.CloneResult{
+			{APIVersion: "core/v2", Type: "Role", Namespace: "team", Name: "role", Error: "unauthorized"},
+		},
+	}
+	cli.Client.(*clienttest.MockClient).
+		On("CloneNamespace", "template", "team", client.CloneOptions{}).
+		Return(report, errors.New(report.Message))
+
+	cmd := CloneCommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+	out, err := test.RunCmd(cmd, []string{"template", "team"})
+
+	assert.Error(err)
+	assert.Contains(out, "unauthorized")
+}
+
+func TestCloneCommandRunMissingArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := CloneCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"template"})
+
+	assert.Contains(out, "Usage")
+	assert.Error(err)
+}
+
+func TestCloneCommandInvalidTypes(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := CloneCommand(cli)
+	require.NoError(t, cmd.Flags().Set("types", "nope"))
+	_, err := test.RunCmd(cmd, []string{"template", "team"})
+
+	assert.Error(t, err)
+}
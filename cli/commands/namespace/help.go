@@ -16,8 +16,10 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 
 	// Add sub-commands
 	cmd.AddCommand(
+		CloneCommand(cli),
 		CreateCommand(cli),
 		DeleteCommand(cli),
+		InfoCommand(cli),
 		ListCommand(cli),
 	)
 
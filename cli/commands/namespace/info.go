@@ -0,0 +1,86 @@
+package namespace
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/list"
+	"github.com/spf13/cobra"
+)
+
+// InfoCommand defines the 'namespace info' subcommand
+func InfoCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "info [NAME]",
+		Short:        "show the usage of the quotas of a namespace",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			// Fetch the usage from API
+			name := args[0]
+			r, err := cli.Client.FetchQuotaUsage(name)
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			flag := helpers.GetChangedStringValueViper("format", cmd.Flags())
+			format := cli.Config.Format()
+			return helpers.PrintFormatted(flag, format, r, cmd.OutOrStdout(), printToList)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printToList(v interface{}, writer io.Writer) error {
+	usage, ok := v.(*corev2.QuotaUsage)
+	if !ok {
+		return fmt.Errorf("%t is not a QuotaUsage", v)
+	}
+	cfg := &list.Config{
+		Title: usage.Namespace,
+		Rows: []*list.Row{
+			{
+				Label: "Name",
+				Value: usage.Namespace,
+			},
+			{
+				Label: "Quotas",
+				Value: strings.Join(usage.Quotas, ", "),
+			},
+		},
+	}
+	for _, resource := range usage.Resources {
+		limit := "unlimited"
+		if resource.Limit > 0 {
+			limit = strconv.FormatUint(uint64(resource.Limit), 10)
+		}
+		cfg.Rows = append(cfg.Rows, &list.Row{
+			Label: strings.Title(resource.Resource),
+			Value: fmt.Sprintf("%d / %s", resource.Used, limit),
+		})
+	}
+	interval := "unlimited"
+	if usage.MinCheckInterval > 0 {
+		interval = strconv.FormatUint(uint64(usage.MinCheckInterval), 10) + "s"
+	}
+	cfg.Rows = append(cfg.Rows, &list.Row{
+		Label: "Min Check Interval",
+		Value: interval,
+	})
+
+	return list.Print(writer, cfg)
+}
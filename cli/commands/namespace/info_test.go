@@ -0,0 +1,88 @@
+package namespace
+
+import (
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfoCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := InfoCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("info", cmd.Use)
+	assert.Regexp("namespace", cmd.Short)
+}
+
+func TestInfoCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	usage := corev2.NewQuotaUsage("acme", []*corev2.Quota{corev2.FixtureQuota("quota")})
+	client.On("FetchQuotaUsage", "acme").Return(usage, nil)
+
+	cmd := InfoCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"acme"})
+
+	assert.NotEmpty(out)
+	assert.Contains(out, "acme")
+	assert.Nil(err)
+}
+
+func TestInfoCommandRunMissingArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := InfoCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.NotEmpty(out)
+	assert.Contains(out, "Usage")
+	assert.Error(err)
+}
+
+func TestInfoCommandRunEClosureWithTable(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	usage := corev2.NewQuotaUsage("acme", []*corev2.Quota{corev2.FixtureQuota("quota")})
+	usage.Resources[0].Used = 42
+	client.On("FetchQuotaUsage", "acme").Return(usage, nil)
+
+	cmd := InfoCommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+
+	out, err := test.RunCmd(cmd, []string{"acme"})
+
+	assert.NotEmpty(out)
+	assert.Contains(out, "Quotas")
+	assert.Contains(out, "42 / 100")
+	assert.Contains(out, "Min Check Interval")
+	assert.Nil(err)
+}
+
+func TestInfoCommandRunEClosureWithErr(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchQuotaUsage", "acme").Return((*corev2.QuotaUsage)(nil), errors.New("my-err"))
+
+	cmd := InfoCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"acme"})
+
+	assert.Empty(out)
+	assert.Error(err)
+	assert.Equal("my-err", err.Error())
+}
@@ -0,0 +1,676 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// LastAppliedAnnotation is the annotation holding the configuration of a
+// resource as it was last applied, used to tell the changes made in the
+// resource files from the ones made on the server.
+const LastAppliedAnnotation = "sensu.io/last-applied-configuration"
+
+// ExitDrift is the exit status of a dry run that found changes to apply.
+const ExitDrift = 2
+
+// pruneChunkSize is the page size used to list the resources to prune.
+var pruneChunkSize = 100
+
+// ChangeAction is the action needed to bring a resource to its declared state.
+type ChangeAction string
+
+const (
+	// ActionNone is used for resources matching their declaration
+	ActionNone ChangeAction = "unchanged"
+
+	// ActionCreate is used for declared resources that do not exist
+	ActionCreate ChangeAction = "create"
+
+	// ActionUpdate is used for resources differing from their declaration
+	ActionUpdate ChangeAction = "update"
+
+	// ActionDelete is used for resources that are no longer declared
+	ActionDelete ChangeAction = "delete"
+)
+
+// Change is the difference between the live state of a resource and the state
+// applying its declaration brings it to.
+type Change struct {
+	Action ChangeAction
+
+	// Declared is the resource as declared, nil for deletions
+	Declared *types.Wrapper
+
+	// Live is the resource as it exists on the server, nil for creations
+	Live *types.Wrapper
+
+	// Applied is the resource as it is put on the server: the declared
+	// resource for creations, the live resource merged with the changes made
+	// to the declaration since it was last applied for updates, and nil for
+	// deletions
+	Applied *types.Wrapper
+}
+
+// Name identifies the resource of the change, by its type, namespace and name.
+func (c *Change) Name() string {
+	w := c.Declared
+	if w == nil {
+		w = c.Live
+	}
+	meta := compat.GetObjectMeta(w.Value)
+	name := meta.Name
+	if meta.Namespace != "" {
+		name = meta.Namespace + "/" + name
+	}
+	return fmt.Sprintf("%s.%s %s", w.APIVersion, w.Type, name)
+}
+
+// Diff returns the unified diff between the live and the applied resource,
+// both rendered to YAML after removing the fields managed by the server.
+func (c *Change) Diff() (string, error) {
+	var live, applied string
+	var err error
+	if c.Live != nil {
+		if live, err = renderYAML(c.Live); err != nil {
+			return "", err
+		}
+	}
+	if c.Applied != nil {
+		if applied, err = renderYAML(c.Applied); err != nil {
+			return "", err
+		}
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(live),
+		B:        difflib.SplitLines(applied),
+		FromFile: "live",
+		ToFile:   "applied",
+		Context:  3,
+	})
+}
+
+// FieldChange is the change of a field of a resource. Path locates the field
+// in the wrapped resource, e.g. spec.subscriptions[0].
+type FieldChange struct {
+	Path    string      `json:"path" yaml:"path"`
+	Live    interface{} `json:"live,omitempty" yaml:"live,omitempty"`
+	Applied interface{} `json:"applied,omitempty" yaml:"applied,omitempty"`
+}
+
+// FieldChanges returns the fields that differ between the live and the
+// applied resource, ignoring the fields managed by the server. All the fields
+// are returned for creations and deletions.
+func (c *Change) FieldChanges() ([]FieldChange, error) {
+	var live, applied map[string]interface{}
+	var err error
+	if c.Live != nil {
+		if live, err = normalize(c.Live); err != nil {
+			return nil, err
+		}
+	}
+	if c.Applied != nil {
+		if applied, err = normalize(c.Applied); err != nil {
+			return nil, err
+		}
+	}
+	changes := []FieldChange{}
+	diffValues("", toInterface(live), toInterface(applied), &changes)
+	return changes, nil
+}
+
+// toInterface avoids turning nil maps into non-nil interfaces
+func toInterface(m map[string]interface{}) interface{} {
+	if m == nil {
+		return nil
+	}
+	return m
+}
+
+// diffValues appends the differences between a and b to changes, recursing
+// into objects and arrays so that only the changed leaves are reported.
+func diffValues(path string, a, b interface{}, changes *[]FieldChange) {
+	if reflect.DeepEqual(a, b) {
+		return
+	}
+	mapA, okA := a.(map[string]interface{})
+	mapB, okB := b.(map[string]interface{})
+	if (okA || a == nil) && (okB || b == nil) && (okA || okB) {
+		keys := map[string]bool{}
+		for k := range mapA {
+			keys[k] = true
+		}
+		for k := range mapB {
+			keys[k] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			diffValues(fieldPath(path, k), mapA[k], mapB[k], changes)
+		}
+		return
+	}
+	sliceA, okA := a.([]interface{})
+	sliceB, okB := b.([]interface{})
+	if okA && okB {
+		for i := 0; i < len(sliceA) || i < len(sliceB); i++ {
+			var x, y interface{}
+			if i < len(sliceA) {
+				x = sliceA[i]
+			}
+			if i < len(sliceB) {
+				y = sliceB[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", path, i), x, y, changes)
+		}
+		return
+	}
+	*changes = append(*changes, FieldChange{Path: path, Live: a, Applied: b})
+}
+
+// fieldPath appends key to path, quoting keys that are not plain identifiers,
+// like most label names.
+func fieldPath(path, key string) string {
+	if strings.ContainsAny(key, `./[]" `) {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// DriftError is returned by a dry run that found changes to apply.
+type DriftError struct {
+	Changes int
+}
+
+func (e *DriftError) Error() string {
+	return fmt.Sprintf("%d resource(s) differ from their declaration", e.Changes)
+}
+
+// ExitStatus implements command.CommandErrorer.
+func (e *DriftError) ExitStatus() int {
+	return ExitDrift
+}
+
+// Applier is a Processor that brings the resources on the server to their
+// declared state. It prints a preview of the changes, puts the resources that
+// applying their declaration changes and, when pruning, deletes the resources
+// holding its label that are no longer declared.
+type Applier struct {
+	// LabelKey and LabelValue are the label set on the applied resources,
+	// and used to select the resources to prune
+	LabelKey   string
+	LabelValue string
+
+	// Prune enables the deletion of the resources that are no longer declared
+	Prune bool
+
+	// DryRun only prints the changes, and fails with a DriftError if any
+	DryRun bool
+
+	// Namespace is the namespace pruned in addition to the ones of the
+	// declared resources
+	Namespace string
+
+	// Replace plans putting the declared resources as they are, as sensuctl
+	// create does, rather than merging them into the live resources
+	Replace bool
+
+	// Out receives the preview of the changes
+	Out io.Writer
+}
+
+// Plan labels the resources and returns the changes needed to apply them,
+// including the deletions when pruning.
+func (a *Applier) Plan(client client.GenericClient, resources []*types.Wrapper) ([]*Change, error) {
+	for _, resource := range resources {
+		a.label(resource)
+	}
+
+	plan := Changes
+	if a.Replace {
+		plan = ReplaceChanges
+	}
+	changes, err := plan(client, resources)
+	if err != nil {
+		return nil, err
+	}
+	if a.Prune {
+		pruned, err := PruneChanges(client, resources, a.LabelKey, a.LabelValue, a.namespaces(resources))
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, pruned...)
+	}
+	return changes, nil
+}
+
+// Process applies the resources.
+func (a *Applier) Process(client client.GenericClient, resources []*types.Wrapper) error {
+	changes, err := a.Plan(client, resources)
+	if err != nil {
+		return err
+	}
+
+	count, err := PrintChanges(a.Out, changes)
+	if err != nil {
+		return err
+	}
+	if a.DryRun {
+		if count > 0 {
+			return &DriftError{Changes: count}
+		}
+		return nil
+	}
+
+	for _, change := range changes {
+		switch change.Action {
+		case ActionCreate, ActionUpdate:
+			if err := setLastApplied(change.Applied, change.Declared); err != nil {
+				return err
+			}
+			if err := client.PutResource(*change.Applied); err != nil {
+				return fmt.Errorf("error putting %s: %s", change.Name(), err)
+			}
+		case ActionDelete:
+			if err := client.Delete(compat.URIPath(change.Live.Value)); err != nil {
+				return fmt.Errorf("error deleting %s: %s", change.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// label marks the resource as managed by sensuctl, or by the value of the
+// applier label if it is the managed_by one, and sets the applier label.
+func (a *Applier) label(resource *types.Wrapper) {
+	managedBy := "sensuctl"
+	if a.LabelKey == corev2.ManagedByLabel {
+		managedBy = a.LabelValue
+	}
+	NewManagedByLabelPutter(managedBy).label(resource)
+	if a.LabelKey == "" || a.LabelKey == corev2.ManagedByLabel {
+		return
+	}
+	resource.ObjectMeta.Labels[a.LabelKey] = a.LabelValue
+	meta := compat.GetObjectMeta(resource.Value)
+	if meta.Labels == nil {
+		meta.Labels = map[string]string{}
+	}
+	meta.Labels[a.LabelKey] = a.LabelValue
+	compat.SetObjectMeta(resource.Value, meta)
+}
+
+// namespaces returns the namespaces to prune
+func (a *Applier) namespaces(resources []*types.Wrapper) []string {
+	seen := map[string]bool{a.Namespace: true}
+	namespaces := []string{a.Namespace}
+	for _, resource := range resources {
+		ns := compat.GetObjectMeta(resource.Value).Namespace
+		if ns != "" && !seen[ns] {
+			seen[ns] = true
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// PrintChanges writes a preview of the changes to w, with the diff of each
+// updated resource, followed by a summary. It returns the number of
+// resources to create, update or delete.
+func PrintChanges(w io.Writer, changes []*Change) (int, error) {
+	counts := map[ChangeAction]int{}
+	for _, change := range changes {
+		counts[change.Action]++
+		switch change.Action {
+		case ActionCreate:
+			fmt.Fprintf(w, "+ %s\n", change.Name())
+		case ActionDelete:
+			fmt.Fprintf(w, "- %s\n", change.Name())
+		case ActionUpdate:
+			fmt.Fprintf(w, "~ %s\n", change.Name())
+			diff, err := change.Diff()
+			if err != nil {
+				return 0, err
+			}
+			fmt.Fprint(w, diff)
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d to create, %d to update, %d to delete, %d unchanged\n",
+		counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete], counts[ActionNone])
+	return counts[ActionCreate] + counts[ActionUpdate] + counts[ActionDelete], err
+}
+
+// Changes fetches the live state of each resource and returns the change
+// applying its declaration makes.
+//
+// The apply is three-way: the changes made to the declaration since it was
+// last applied, as recorded in the LastAppliedAnnotation annotation, are
+// merged into the live resource. The fields that were modified on the server
+// but not in the declaration keep their live value, and the fields removed
+// from the declaration are removed from the resource. Lists are replaced as a
+// whole. A live resource without the annotation gets the declared fields set,
+// and keeps the others.
+func Changes(client client.GenericClient, resources []*types.Wrapper) ([]*Change, error) {
+	return changes(client, resources, merge)
+}
+
+// ReplaceChanges fetches the live state of each resource and returns the
+// change putting its declaration as it is makes, as sensuctl create does: the
+// fields missing from the declaration are reset on the server.
+func ReplaceChanges(client client.GenericClient, resources []*types.Wrapper) ([]*Change, error) {
+	return changes(client, resources, func(declared, live *types.Wrapper) (*types.Wrapper, error) {
+		return declared, nil
+	})
+}
+
+// changes fetches the live state of each resource and returns the change
+// putting the resource returned by apply makes.
+func changes(client client.GenericClient, resources []*types.Wrapper, apply func(declared, live *types.Wrapper) (*types.Wrapper, error)) ([]*Change, error) {
+	changes := make([]*Change, 0, len(resources))
+	for _, resource := range resources {
+		live, err := getLive(client, resource)
+		if err != nil {
+			return nil, err
+		}
+		change := &Change{Declared: resource, Live: live, Applied: resource, Action: ActionCreate}
+		if live != nil {
+			if change.Applied, err = apply(resource, live); err != nil {
+				return nil, err
+			}
+			applied, err := canonical(change.Applied)
+			if err != nil {
+				return nil, err
+			}
+			current, err := canonical(live)
+			if err != nil {
+				return nil, err
+			}
+			change.Action = ActionNone
+			if applied != current {
+				change.Action = ActionUpdate
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// merge returns the live resource with the changes made to the declared
+// resource since it was last applied.
+func merge(declared, live *types.Wrapper) (*types.Wrapper, error) {
+	declaredMap, err := normalize(declared)
+	if err != nil {
+		return nil, err
+	}
+	liveMap, err := normalize(live)
+	if err != nil {
+		return nil, err
+	}
+	var lastAppliedMap map[string]interface{}
+	if lastApplied, ok := compat.GetObjectMeta(live.Value).Annotations[LastAppliedAnnotation]; ok {
+		if err := json.Unmarshal([]byte(lastApplied), &lastAppliedMap); err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %s", LastAppliedAnnotation, err)
+		}
+	}
+
+	b, err := json.Marshal(mergeValues(lastAppliedMap, declaredMap, liveMap))
+	if err != nil {
+		return nil, err
+	}
+	var applied types.Wrapper
+	if err := json.Unmarshal(b, &applied); err != nil {
+		return nil, err
+	}
+	applied.TypeMeta = declared.TypeMeta
+	return &applied, nil
+}
+
+// mergeValues applies the patch from lastApplied to declared to live: the
+// fields that changed between lastApplied and declared are set, recursing into
+// objects, and the fields of lastApplied missing from declared are deleted.
+func mergeValues(lastApplied, declared, live map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(live))
+	for k, v := range live {
+		result[k] = v
+	}
+	for k := range lastApplied {
+		if _, ok := declared[k]; !ok {
+			delete(result, k)
+		}
+	}
+	for k, v := range declared {
+		last, ok := lastApplied[k]
+		if ok && reflect.DeepEqual(last, v) {
+			continue
+		}
+		declaredObject, isObject := v.(map[string]interface{})
+		liveObject, liveIsObject := live[k].(map[string]interface{})
+		if isObject && liveIsObject {
+			lastObject, _ := last.(map[string]interface{})
+			result[k] = mergeValues(lastObject, declaredObject, liveObject)
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// getLive fetches the current state of a resource, or nil if it does not
+// exist.
+func getLive(cl client.GenericClient, resource *types.Wrapper) (*types.Wrapper, error) {
+	path := compat.URIPath(resource.Value)
+
+	// The core/v2 resources are served unwrapped
+	var response interface{}
+	if resource.APIVersion == "core/v2" {
+		response = reflect.New(reflect.Indirect(reflect.ValueOf(resource.Value)).Type()).Interface()
+	} else {
+		response = &types.Wrapper{}
+	}
+	if err := cl.Get(path, response); err != nil {
+		if err, ok := err.(client.APIError); ok && actions.ErrCode(err.Code) == actions.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting %q: %s", path, err)
+	}
+
+	live, ok := response.(*types.Wrapper)
+	if !ok {
+		wrapped := types.WrapResource(response.(corev2.Resource))
+		live = &wrapped
+	}
+	// Compare the resources with the type they were declared with
+	live.TypeMeta = resource.TypeMeta
+	return live, nil
+}
+
+// pruneExempt lists the resources that are never pruned: events are
+// observations rather than configuration, and the tessen configuration cannot
+// be deleted.
+var pruneExempt = map[string]bool{
+	"Event":        true,
+	"TessenConfig": true,
+}
+
+// PruneChanges lists the resources holding the label key=value in the given
+// namespaces and returns the deletion of the ones that are not among the
+// declared resources.
+func PruneChanges(cl client.GenericClient, resources []*types.Wrapper, key, value string, namespaces []string) ([]*Change, error) {
+	declared := make(map[string]bool, len(resources))
+	for _, resource := range resources {
+		declared[compat.URIPath(resource.Value)] = true
+	}
+
+	var changes []*Change
+	listed := map[string]bool{}
+	for _, typ := range All {
+		wrapped := types.WrapResource(typ)
+		if pruneExempt[wrapped.Type] {
+			continue
+		}
+		for _, ns := range namespaces {
+			req := reflect.New(reflect.Indirect(reflect.ValueOf(typ)).Type()).Interface().(corev2.Resource)
+			req.SetNamespace(ns)
+			// Cluster-wide resources have the same path in every namespace
+			path := fmt.Sprintf("%s?types=%s", req.URIPath(), url.QueryEscape(wrapped.Type))
+			if listed[path] {
+				continue
+			}
+			listed[path] = true
+
+			val := reflect.New(reflect.SliceOf(reflect.TypeOf(req)))
+			err := cl.List(path, val.Interface(), &client.ListOptions{
+				LabelSelector: fmt.Sprintf(`%s == "%s"`, key, value),
+				ChunkSize:     pruneChunkSize,
+			}, nil)
+			if err != nil {
+				if err, ok := err.(client.APIError); ok {
+					switch actions.ErrCode(err.Code) {
+					case actions.PaymentRequired, actions.NotFound:
+						continue
+					}
+				}
+				return nil, fmt.Errorf("error listing %s: %s", wrapped.Type, err)
+			}
+
+			val = reflect.Indirect(val)
+			for i := 0; i < val.Len(); i++ {
+				live := val.Index(i).Interface().(corev2.Resource)
+				// Check the label again, in case the server does not support
+				// label selectors and returned every resource
+				if live.GetObjectMeta().Labels[key] != value || declared[live.URIPath()] {
+					continue
+				}
+				wrapped := types.WrapResource(live)
+				changes = append(changes, &Change{Action: ActionDelete, Live: &wrapped})
+			}
+		}
+	}
+
+	// Delete the resources in the reverse order of All, so that namespaces
+	// are deleted after their content
+	for i, j := 0, len(changes)-1; i < j; i, j = i+1, j-1 {
+		changes[i], changes[j] = changes[j], changes[i]
+	}
+	return changes, nil
+}
+
+// setLastApplied records the canonical configuration of the declared resource
+// in the LastAppliedAnnotation annotation of the applied resource.
+func setLastApplied(resource, declared *types.Wrapper) error {
+	config, err := canonical(declared)
+	if err != nil {
+		return err
+	}
+	meta := compat.GetObjectMeta(resource.Value)
+	meta.Annotations = withAnnotation(meta.Annotations, config)
+	compat.SetObjectMeta(resource.Value, meta)
+	resource.ObjectMeta.Annotations = withAnnotation(resource.ObjectMeta.Annotations, config)
+	return nil
+}
+
+func withAnnotation(annotations map[string]string, config string) map[string]string {
+	result := make(map[string]string, len(annotations)+1)
+	for k, v := range annotations {
+		result[k] = v
+	}
+	result[LastAppliedAnnotation] = config
+	return result
+}
+
+// normalize returns the wrapped resource as a map, without the fields
+// managed by the server.
+func normalize(resource *types.Wrapper) (map[string]interface{}, error) {
+	w := *resource
+	w.ObjectMeta = *compat.GetObjectMeta(resource.Value)
+	b, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	meta, _ := result["metadata"].(map[string]interface{})
+	delete(meta, "created_by")
+	if annotations, ok := meta["annotations"].(map[string]interface{}); ok {
+		delete(annotations, LastAppliedAnnotation)
+		if len(annotations) == 0 {
+			delete(meta, "annotations")
+		}
+	}
+	return result, nil
+}
+
+// canonical returns the normalized resource as JSON, with sorted keys.
+func canonical(resource *types.Wrapper) (string, error) {
+	m, err := normalize(resource)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(m)
+	return string(b), err
+}
+
+// renderYAML returns the normalized resource as YAML.
+func renderYAML(resource *types.Wrapper) (string, error) {
+	m, err := normalize(resource)
+	if err != nil {
+		return "", err
+	}
+	b, err := yaml.Marshal(sortedMap(m))
+	return string(b), err
+}
+
+// sortedMap converts m to a yaml.MapSlice with sorted keys, recursively, so
+// that the renderings of two resources can be compared line by line.
+func sortedMap(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		result := make(yaml.MapSlice, 0, len(keys))
+		for _, k := range keys {
+			result = append(result, yaml.MapItem{Key: k, Value: sortedMap(v[k])})
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i := range v {
+			result[i] = sortedMap(v[i])
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// ParseLabel parses a label given as key=value.
+func ParseLabel(label string) (string, string, error) {
+	parts := strings.SplitN(label, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid label %q, expected key=value", label)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,265 @@
+package resource
+
+import (
+	"bytes"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli/client"
+	mockclient "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/command"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var notFound = client.APIError{Message: "not found", Code: uint32(actions.NotFound)}
+
+func labeledCheck(name, label string) *corev2.CheckConfig {
+	check := corev2.FixtureCheckConfig(name)
+	check.Labels = map[string]string{corev2.ManagedByLabel: label}
+	return check
+}
+
+func wrap(r corev2.Resource) *types.Wrapper {
+	w := types.WrapResource(r)
+	return &w
+}
+
+func onGet(c *mockclient.MockClient, live *corev2.CheckConfig) {
+	path := live.URIPath()
+	c.On("Get", path, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*corev2.CheckConfig) = *live
+	})
+}
+
+func onListChecks(t *testing.T, c *mockclient.MockClient, checks ...*corev2.CheckConfig) {
+	path := (&corev2.CheckConfig{ObjectMeta: corev2.ObjectMeta{Namespace: "default"}}).URIPath() + "?types=CheckConfig"
+	c.On("List", path, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		assert.Equal(t, `sensu.io/managed_by == "git"`, args.Get(2).(*client.ListOptions).LabelSelector)
+		*args.Get(1).(*[]*corev2.CheckConfig) = checks
+	})
+	c.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+}
+
+func TestChanges(t *testing.T) {
+	c := &mockclient.MockClient{}
+
+	c.On("Get", corev2.FixtureCheckConfig("created").URIPath(), mock.Anything).Return(notFound)
+
+	unchanged := labeledCheck("unchanged", "git")
+	onGet(c, unchanged)
+
+	updated := labeledCheck("updated", "git")
+	updated.Interval = 120
+	updated.CreatedBy = "admin"
+	onGet(c, updated)
+
+	// Modified on the server since it was last applied
+	lastApplied, err := canonical(wrap(labeledCheck("drifted", "git")))
+	require.NoError(t, err)
+	drifted := labeledCheck("drifted", "git")
+	drifted.Interval = 120
+	drifted.Annotations = map[string]string{LastAppliedAnnotation: lastApplied}
+	onGet(c, drifted)
+
+	changes, err := Changes(c, []*types.Wrapper{
+		wrap(labeledCheck("created", "git")),
+		wrap(labeledCheck("unchanged", "git")),
+		wrap(labeledCheck("updated", "git")),
+		wrap(labeledCheck("drifted", "git")),
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 4)
+
+	assert.Equal(t, ActionCreate, changes[0].Action)
+	assert.Equal(t, ActionNone, changes[1].Action)
+	assert.Equal(t, ActionUpdate, changes[2].Action)
+	// The change made on the server is kept, since the declaration did not
+	// change
+	assert.Equal(t, ActionNone, changes[3].Action)
+
+	diff, err := changes[2].Diff()
+	require.NoError(t, err)
+	assert.Contains(t, diff, "-  interval: 120\n+  interval: 60\n")
+	assert.NotContains(t, diff, "created_by")
+}
+
+func TestChangesThreeWay(t *testing.T) {
+	c := &mockclient.MockClient{}
+
+	lastAppliedCheck := labeledCheck("check", "git")
+	lastAppliedCheck.Labels["region"] = "us-west-2"
+	lastAppliedCheck.Subscriptions = []string{"linux", "windows"}
+	lastApplied, err := canonical(wrap(lastAppliedCheck))
+	require.NoError(t, err)
+
+	live := labeledCheck("check", "git")
+	live.Labels["region"] = "us-west-2"
+	live.Labels["team"] = "ops"
+	live.Subscriptions = []string{"linux", "windows"}
+	live.Interval = 120
+	live.Annotations = map[string]string{LastAppliedAnnotation: lastApplied}
+	onGet(c, live)
+
+	declared := labeledCheck("check", "git")
+	declared.Subscriptions = []string{"linux"}
+	declared.Timeout = 30
+
+	changes, err := Changes(c, []*types.Wrapper{wrap(declared)})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, ActionUpdate, changes[0].Action)
+
+	applied := changes[0].Applied.Value.(*corev2.CheckConfig)
+	// Changed in the declaration
+	assert.Equal(t, []string{"linux"}, applied.Subscriptions)
+	assert.Equal(t, uint32(30), applied.Timeout)
+	// Removed from the declaration
+	assert.NotContains(t, applied.Labels, "region")
+	// Changed on the server only
+	assert.Equal(t, uint32(120), applied.Interval)
+	assert.Equal(t, "ops", applied.Labels["team"])
+
+	fields, err := changes[0].FieldChanges()
+	require.NoError(t, err)
+	assert.Equal(t, []FieldChange{
+		{Path: "metadata.labels.region", Live: "us-west-2"},
+		{Path: "spec.subscriptions[1]", Live: "windows"},
+		{Path: "spec.timeout", Live: float64(0), Applied: float64(30)},
+	}, fields)
+}
+
+func TestPruneChanges(t *testing.T) {
+	c := &mockclient.MockClient{}
+	onListChecks(t, c,
+		labeledCheck("declared", "git"),
+		labeledCheck("removed", "git"),
+		// returned by servers ignoring the label selector
+		labeledCheck("other", "sensuctl"),
+	)
+
+	changes, err := PruneChanges(c, []*types.Wrapper{wrap(labeledCheck("declared", "git"))},
+		corev2.ManagedByLabel, "git", []string{"default"})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, ActionDelete, changes[0].Action)
+	assert.Equal(t, "core/v2.CheckConfig default/removed", changes[0].Name())
+}
+
+func TestApplierDryRun(t *testing.T) {
+	c := &mockclient.MockClient{}
+	c.On("Get", corev2.FixtureCheckConfig("created").URIPath(), mock.Anything).Return(notFound)
+	onListChecks(t, c, labeledCheck("removed", "git"))
+
+	var out bytes.Buffer
+	applier := &Applier{
+		LabelKey:   corev2.ManagedByLabel,
+		LabelValue: "git",
+		Prune:      true,
+		DryRun:     true,
+		Namespace:  "default",
+		Out:        &out,
+	}
+	err := applier.Process(c, []*types.Wrapper{wrap(corev2.FixtureCheckConfig("created"))})
+	require.Error(t, err)
+	status, ok := err.(command.CommandErrorer)
+	require.True(t, ok)
+	assert.Equal(t, ExitDrift, status.ExitStatus())
+
+	assert.Contains(t, out.String(), "+ core/v2.CheckConfig default/created\n")
+	assert.Contains(t, out.String(), "- core/v2.CheckConfig default/removed\n")
+	assert.Contains(t, out.String(), "1 to create, 0 to update, 1 to delete, 0 unchanged\n")
+	c.AssertNotCalled(t, "PutResource", mock.Anything)
+	c.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestApplierProcess(t *testing.T) {
+	c := &mockclient.MockClient{}
+	c.On("Get", corev2.FixtureCheckConfig("created").URIPath(), mock.Anything).Return(notFound)
+	onGet(c, labeledCheck("unchanged", "git"))
+	onListChecks(t, c, labeledCheck("removed", "git"))
+
+	// Modified on the server since it was last applied
+	lastApplied, err := canonical(wrap(labeledCheck("updated", "git")))
+	require.NoError(t, err)
+	updated := labeledCheck("updated", "git")
+	updated.Interval = 120
+	updated.Annotations = map[string]string{LastAppliedAnnotation: lastApplied}
+	onGet(c, updated)
+
+	puts := map[string]*corev2.CheckConfig{}
+	c.On("PutResource", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		check := args.Get(0).(types.Wrapper).Value.(*corev2.CheckConfig)
+		puts[check.Name] = check
+	})
+	c.On("Delete", labeledCheck("removed", "git").URIPath()).Return(nil)
+
+	applier := &Applier{
+		LabelKey:   corev2.ManagedByLabel,
+		LabelValue: "git",
+		Prune:      true,
+		Namespace:  "default",
+		Out:        &bytes.Buffer{},
+	}
+	updatedDeclared := corev2.FixtureCheckConfig("updated")
+	updatedDeclared.Timeout = 30
+	err = applier.Process(c, []*types.Wrapper{
+		wrap(corev2.FixtureCheckConfig("created")),
+		wrap(corev2.FixtureCheckConfig("unchanged")),
+		wrap(updatedDeclared),
+	})
+	require.NoError(t, err)
+
+	c.AssertNumberOfCalls(t, "PutResource", 2)
+	c.AssertCalled(t, "Delete", labeledCheck("removed", "git").URIPath())
+
+	check := puts["created"]
+	require.NotNil(t, check)
+	assert.Equal(t, "git", check.Labels[corev2.ManagedByLabel])
+	assert.Contains(t, check.Annotations[LastAppliedAnnotation], `"name":"created"`)
+
+	check = puts["updated"]
+	require.NotNil(t, check)
+	assert.Equal(t, uint32(30), check.Timeout)
+	assert.Equal(t, uint32(120), check.Interval)
+	assert.Contains(t, check.Annotations[LastAppliedAnnotation], `"timeout":30`)
+	assert.NotContains(t, check.Annotations[LastAppliedAnnotation], `"interval":120`)
+}
+
+func TestParseLabel(t *testing.T) {
+	key, value, err := ParseLabel("sensu.io/managed_by=git")
+	require.NoError(t, err)
+	assert.Equal(t, corev2.ManagedByLabel, key)
+	assert.Equal(t, "git", value)
+
+	for _, label := range []string{"", "git", "=git", "sensu.io/managed_by="} {
+		_, _, err := ParseLabel(label)
+		assert.Error(t, err, label)
+	}
+}
+
+func TestFieldChanges(t *testing.T) {
+	live := labeledCheck("check", "git")
+	live.Subscriptions = []string{"linux", "windows"}
+	live.Labels["example.com/region"] = "us-west-2"
+	declared := labeledCheck("check", "git")
+	declared.Subscriptions = []string{"linux"}
+	declared.Interval = 30
+
+	change := &Change{Action: ActionUpdate, Live: wrap(live), Applied: wrap(declared)}
+	fields, err := change.FieldChanges()
+	require.NoError(t, err)
+	assert.Equal(t, []FieldChange{
+		{Path: `metadata.labels["example.com/region"]`, Live: "us-west-2"},
+		{Path: "spec.interval", Live: float64(60), Applied: float64(30)},
+		{Path: "spec.subscriptions[1]", Live: "windows"},
+	}, fields)
+
+	change = &Change{Action: ActionCreate, Declared: wrap(declared), Applied: wrap(declared)}
+	fields, err = change.FieldChanges()
+	require.NoError(t, err)
+	assert.Contains(t, fields, FieldChange{Path: "metadata.name", Applied: "check"})
+}
@@ -0,0 +1,693 @@
+package resource
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/js"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/compat"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// Diagnostic is a problem found in a resource file.
+type Diagnostic struct {
+	File    string `json:"file" yaml:"file"`
+	Line    int    `json:"line" yaml:"line"`
+	Column  int    `json:"column" yaml:"column"`
+	Message string `json:"message" yaml:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s", d.File, d.Line, d.Column, d.Message)
+}
+
+// builtins lists the filters and mutators provided by the backend, which can
+// be referenced without being defined.
+var builtins = map[string]map[string]bool{
+	"EventFilter": {"is_incident": true, "not_silenced": true, "has_metrics": true},
+	"Mutator":     {"json": true, "only_check_output": true},
+}
+
+// wrapperFields are the fields allowed at the top level of a resource.
+var wrapperFields = []string{"type", "api_version", "metadata", "spec"}
+
+var jsonUnmarshaler = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// Linter validates resource files without modifying anything. It reports
+// syntax errors, unknown fields, invalid values and references to resources
+// that are not defined.
+type Linter struct {
+	// Namespace is the namespace of the resources that do not declare one
+	Namespace string
+
+	// Remote, when set, is used to look up the referenced resources that are
+	// not defined in the linted files
+	Remote client.GenericClient
+
+	documents   []*document
+	diagnostics []Diagnostic
+}
+
+// document is a resource of a linted file.
+type document struct {
+	file     string
+	node     *yaml.Node
+	resource *types.Wrapper
+}
+
+// reference is a resource referenced by another one.
+type reference struct {
+	typ  string
+	name string
+	path []interface{}
+}
+
+// AddFile parses the resources of a file, reporting syntax errors.
+func (l *Linter) AddFile(name string, in io.Reader) error {
+	b, err := ioutil.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	var nodes []*yaml.Node
+	if jsonRe.Match(b) {
+		nodes, err = parseJSONNodes(b)
+	} else {
+		nodes, err = parseYAMLNodes(b)
+	}
+	if err != nil {
+		l.diagnostics = append(l.diagnostics, syntaxDiagnostic(name, err))
+	}
+	for _, node := range nodes {
+		if node.Kind == yaml.SequenceNode {
+			// a JSON array of resources
+			for _, item := range node.Content {
+				l.documents = append(l.documents, &document{file: name, node: item})
+			}
+			continue
+		}
+		l.documents = append(l.documents, &document{file: name, node: node})
+	}
+	return nil
+}
+
+// AddPath adds the file at path, or the files of the directory at path,
+// following subdirectories if recurse is true.
+func (l *Linter) AddPath(path string, recurse bool) error {
+	return walkFiles(path, recurse, func(path string) error {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return l.AddFile(path, f)
+	})
+}
+
+// Lint checks the resources of the added files and returns the problems found,
+// sorted by file and position.
+func (l *Linter) Lint() []Diagnostic {
+	for _, doc := range l.documents {
+		l.lintDocument(doc)
+	}
+	l.checkReferences()
+
+	diagnostics := l.diagnostics
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		a, b := diagnostics[i], diagnostics[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return diagnostics
+}
+
+func (l *Linter) report(doc *document, node *yaml.Node, format string, args ...interface{}) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		File:    doc.file,
+		Line:    node.Line,
+		Column:  node.Column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *Linter) lintDocument(doc *document) {
+	root := doc.node
+	if root.Kind != yaml.MappingNode {
+		l.report(doc, root, "expected a resource, got %s", nodeKind(root))
+		return
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key := root.Content[i]
+		if !containsFold(wrapperFields, key.Value) {
+			l.report(doc, key, "unknown field %q%s", key.Value, suggest(key.Value, wrapperFields))
+		}
+	}
+
+	typeNode := lookup(root, "type")
+	if typeNode == nil {
+		l.report(doc, root, "missing type")
+		return
+	}
+	apiVersion := "core/v2"
+	if node := lookup(root, "api_version"); node != nil && node.Value != "" {
+		apiVersion = node.Value
+	}
+	value, err := types.ResolveRaw(apiVersion, typeNode.Value)
+	if err != nil {
+		l.report(doc, typeNode, "unknown type %s.%s", apiVersion, typeNode.Value)
+		return
+	}
+	spec := lookup(root, "spec")
+	if spec == nil {
+		l.report(doc, root, "missing spec")
+		return
+	}
+	if meta := lookup(root, "metadata"); meta != nil {
+		l.checkFields(doc, meta, reflect.TypeOf(corev2.ObjectMeta{}), "metadata", false)
+	}
+	before := len(l.diagnostics)
+	l.checkFields(doc, spec, reflect.TypeOf(value), "spec", true)
+	if len(l.diagnostics) > before {
+		// The unknown fields would be reported again when decoding
+		return
+	}
+
+	resource, err := decodeNode(root)
+	if err != nil {
+		node := root
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			var path []interface{}
+			for _, elem := range strings.Split(typeErr.Field, ".") {
+				path = append(path, elem)
+			}
+			node = nearest(spec, path...)
+			err = fmt.Errorf("expected a value of type %s, got %s", typeErr.Type, typeErr.Value)
+		}
+		l.report(doc, node, "%s", err)
+		return
+	}
+	doc.resource = resource
+
+	if compat.GetObjectMeta(resource.Value).Namespace == "" {
+		compat.SetNamespace(resource.Value, l.Namespace)
+	}
+
+	before = len(l.diagnostics)
+	l.checkValues(doc, spec)
+	if len(l.diagnostics) > before {
+		// Validate would report the same problems, with less precision
+		return
+	}
+	if err := compat.V2Resource(resource.Value).Validate(); err != nil {
+		node := root
+		if name := nearest(root, "metadata", "name"); name != root {
+			node = name
+		} else if name := nearest(spec, "metadata", "name"); name != spec {
+			node = name
+		}
+		l.report(doc, node, "invalid %s: %s", typeNode.Value, err)
+	}
+}
+
+// checkFields reports the keys of node that are not fields of typ, recursing
+// into the known fields.
+func (l *Linter) checkFields(doc *document, node *yaml.Node, typ reflect.Type, path string, root bool) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	// Types decoding themselves can accept any field, except resources, which
+	// only customize the decoding of their fields
+	if !root && reflect.PtrTo(typ).Implements(jsonUnmarshaler) {
+		return
+	}
+	switch typ.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		fields := jsonFields(typ)
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fieldType, ok := fields[key.Value]
+			if !ok {
+				for _, name := range names {
+					if strings.EqualFold(name, key.Value) {
+						fieldType, ok = fields[name], true
+						break
+					}
+				}
+			}
+			if !ok {
+				l.report(doc, key, "unknown field %q in %s%s", key.Value, path, suggest(key.Value, names))
+				continue
+			}
+			l.checkFields(doc, value, fieldType, fieldPath(path, key.Value), false)
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			l.checkFields(doc, node.Content[i+1], typ.Elem(), fieldPath(path, node.Content[i].Value), false)
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yaml.SequenceNode {
+			return
+		}
+		for i, item := range node.Content {
+			l.checkFields(doc, item, typ.Elem(), fmt.Sprintf("%s[%d]", path, i), false)
+		}
+	}
+}
+
+// checkValues reports the cron schedules, time windows and javascript
+// expressions of the resource that cannot be parsed.
+func (l *Linter) checkValues(doc *document, spec *yaml.Node) {
+	checkJS := func(expressions []string, path ...interface{}) {
+		for i, expr := range expressions {
+			if err := js.ParseExpressions([]string{expr}); err != nil {
+				node := nearest(spec, append(path, i)...)
+				l.report(doc, node, "invalid javascript expression: %s", strings.TrimPrefix(err.Error(), "syntax error in expression 0: "))
+			}
+		}
+	}
+
+	switch value := doc.resource.Value.(type) {
+	case *corev2.CheckConfig:
+		if value.Cron != "" {
+			if _, err := cron.ParseStandard(value.Cron); err != nil {
+				l.report(doc, nearest(spec, "cron"), "invalid cron schedule %q: %s", value.Cron, err)
+			}
+		}
+		if err := value.Subdue.Validate(); err != nil {
+			l.report(doc, nearest(spec, "subdue"), "invalid subdue: %s", err)
+		}
+		if value.ProxyRequests != nil {
+			checkJS(value.ProxyRequests.EntityAttributes, "proxy_requests", "entity_attributes")
+		}
+	case *corev2.EventFilter:
+		if err := value.When.Validate(); err != nil {
+			l.report(doc, nearest(spec, "when"), "invalid time window: %s", err)
+		}
+		checkJS(value.Expressions, "expressions")
+	case *corev2.Mutator:
+		if value.Type == corev2.JavascriptMutator && value.Eval != "" {
+			if err := js.ParseExpressions([]string{value.Eval}); err != nil {
+				l.report(doc, nearest(spec, "eval"), "invalid javascript: %s", strings.TrimPrefix(err.Error(), "syntax error in expression 0: "))
+			}
+		}
+	}
+}
+
+// references returns the resources referenced by a resource.
+func references(resource interface{}) []reference {
+	var refs []reference
+	add := func(typ string, names []string, path ...interface{}) {
+		for i, name := range names {
+			refs = append(refs, reference{typ: typ, name: name, path: append(path[:len(path):len(path)], i)})
+		}
+	}
+	addRef := func(ref *corev2.ResourceReference, path ...interface{}) {
+		if ref == nil || (ref.APIVersion != "" && ref.APIVersion != "core/v2") {
+			return
+		}
+		refs = append(refs, reference{typ: ref.Type, name: ref.Name, path: append(path, "name")})
+	}
+
+	switch value := resource.(type) {
+	case *corev2.CheckConfig:
+		add("Handler", value.Handlers, "handlers")
+		add("Handler", value.OutputMetricHandlers, "output_metric_handlers")
+		add("Asset", value.RuntimeAssets, "runtime_assets")
+		for i, ref := range value.Pipelines {
+			addRef(ref, "pipelines", i)
+		}
+	case *corev2.Handler:
+		add("EventFilter", value.Filters, "filters")
+		add("Handler", value.Handlers, "handlers")
+		add("Asset", value.RuntimeAssets, "runtime_assets")
+		if value.Mutator != "" {
+			refs = append(refs, reference{typ: "Mutator", name: value.Mutator, path: []interface{}{"mutator"}})
+		}
+	case *corev2.EventFilter:
+		add("Asset", value.RuntimeAssets, "runtime_assets")
+	case *corev2.Mutator:
+		add("Asset", value.RuntimeAssets, "runtime_assets")
+	case *corev2.Pipeline:
+		for i, workflow := range value.Workflows {
+			for j, ref := range workflow.Filters {
+				addRef(ref, "workflows", i, "filters", j)
+			}
+			addRef(workflow.Mutator, "workflows", i, "mutator")
+			addRef(workflow.Handler, "workflows", i, "handler")
+		}
+	}
+	return refs
+}
+
+// checkReferences reports the references to resources that are neither
+// defined in the linted files nor, when linting against a server, on the
+// server.
+func (l *Linter) checkReferences() {
+	// Resources with problems are still defined, to avoid reporting the
+	// references to them too
+	defined := map[string]bool{}
+	for _, doc := range l.documents {
+		defined[l.declaredKey(doc.node)] = true
+	}
+
+	remote := map[string]error{}
+	for _, doc := range l.documents {
+		if doc.resource == nil {
+			continue
+		}
+		namespace := compat.GetObjectMeta(doc.resource.Value).Namespace
+		spec := lookup(doc.node, "spec")
+		for _, ref := range references(doc.resource.Value) {
+			key := referenceKey(ref.typ, namespace, ref.name)
+			if defined[key] || builtins[ref.typ][ref.name] {
+				continue
+			}
+			node := nearest(spec, ref.path...)
+			if l.Remote == nil {
+				l.report(doc, node, "%s %q is not defined", ref.typ, ref.name)
+				continue
+			}
+			err, ok := remote[key]
+			if !ok {
+				err = l.lookupRemote(ref.typ, namespace, ref.name)
+				remote[key] = err
+			}
+			if err != nil {
+				l.report(doc, node, "%s", err)
+			}
+		}
+	}
+}
+
+// declaredKey returns the reference key of the resource declared by node,
+// from its outer or inner metadata.
+func (l *Linter) declaredKey(node *yaml.Node) string {
+	meta := lookup(node, "metadata")
+	if lookup(meta, "name") == nil {
+		meta = lookup(lookup(node, "spec"), "metadata")
+	}
+	var typ, name string
+	if n := lookup(node, "type"); n != nil {
+		typ = n.Value
+	}
+	if n := lookup(meta, "name"); n != nil {
+		name = n.Value
+	}
+	namespace := l.Namespace
+	if n := lookup(meta, "namespace"); n != nil && n.Value != "" {
+		namespace = n.Value
+	}
+	return referenceKey(typ, namespace, name)
+}
+
+// lookupRemote returns an error if the referenced resource does not exist on
+// the server.
+func (l *Linter) lookupRemote(typ, namespace, name string) error {
+	value, err := types.ResolveRaw("core/v2", typ)
+	if err != nil {
+		return fmt.Errorf("unknown type %s", typ)
+	}
+	resource := value.(corev2.Resource)
+	resource.SetObjectMeta(corev2.ObjectMeta{Name: name, Namespace: namespace})
+	if err := l.Remote.Get(resource.URIPath(), resource); err != nil {
+		if err, ok := err.(client.APIError); ok && actions.ErrCode(err.Code) == actions.NotFound {
+			return fmt.Errorf("%s %q is neither defined nor found on the server", typ, name)
+		}
+		return fmt.Errorf("could not look up %s %q: %s", typ, name, err)
+	}
+	return nil
+}
+
+func referenceKey(typ, namespace, name string) string {
+	return typ + "/" + namespace + "/" + name
+}
+
+// decodeNode decodes a resource from its YAML node, like Parse does but
+// keeping the check subdue, which is linted.
+func decodeNode(node *yaml.Node) (*types.Wrapper, error) {
+	var value interface{}
+	if err := node.Decode(&value); err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var w types.Wrapper
+	if err := json.Unmarshal(b, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// jsonFields returns the types of the fields of a struct by their JSON name,
+// including the fields of embedded structs.
+func jsonFields(typ reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for k, v := range jsonFields(embedded) {
+					fields[k] = v
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// lookup returns the value of a key of a mapping node, or nil.
+func lookup(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// nearest returns the node at path, made of keys and indexes, or its deepest
+// existing ancestor.
+func nearest(node *yaml.Node, path ...interface{}) *yaml.Node {
+	for _, elem := range path {
+		var next *yaml.Node
+		switch elem := elem.(type) {
+		case string:
+			next = lookup(node, elem)
+			if next == nil {
+				// The path of a type error indexes arrays like fields
+				if i, err := strconv.Atoi(elem); err == nil && node.Kind == yaml.SequenceNode && i < len(node.Content) {
+					next = node.Content[i]
+				}
+			}
+		case int:
+			if node.Kind == yaml.SequenceNode && elem < len(node.Content) {
+				next = node.Content[elem]
+			}
+		}
+		if next == nil {
+			return node
+		}
+		node = next
+	}
+	return node
+}
+
+func nodeKind(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		return "a list"
+	case yaml.ScalarNode:
+		return fmt.Sprintf("%q", node.Value)
+	default:
+		return "an unexpected value"
+	}
+}
+
+func parseYAMLNodes(b []byte) ([]*yaml.Node, error) {
+	var nodes []*yaml.Node
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return nodes, nil
+			}
+			return nodes, err
+		}
+		if len(doc.Content) > 0 {
+			nodes = append(nodes, doc.Content[0])
+		}
+	}
+}
+
+// parseJSONNodes parses a stream of JSON values as YAML nodes, which carry the
+// position of the values in the stream.
+func parseJSONNodes(b []byte) ([]*yaml.Node, error) {
+	var nodes []*yaml.Node
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if err == io.EOF {
+				return nodes, nil
+			}
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				line, column := position(b, int(syntaxErr.Offset))
+				return nodes, fmt.Errorf("line %d, column %d: %s", line, column, err)
+			}
+			return nodes, err
+		}
+		end := int(dec.InputOffset())
+		start := end - len(raw)
+		var doc yaml.Node
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return nodes, err
+		}
+		line, column := position(b, start)
+		shift(&doc, line-1, column-1)
+		if len(doc.Content) > 0 {
+			nodes = append(nodes, doc.Content[0])
+		}
+	}
+}
+
+// position returns the line and column of an offset of b.
+func position(b []byte, offset int) (int, int) {
+	if offset > len(b) {
+		offset = len(b)
+	}
+	before := b[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(before, '\n')
+	return line, column
+}
+
+// shift moves the position of the nodes parsed from a value found at the given
+// line and column offsets of a stream.
+func shift(node *yaml.Node, lines, columns int) {
+	if node.Line == 1 {
+		node.Column += columns
+	}
+	node.Line += lines
+	for _, child := range node.Content {
+		shift(child, lines, columns)
+	}
+}
+
+var syntaxLineRe = regexp.MustCompile(`line (\d+)(?:, column (\d+))?`)
+
+func syntaxDiagnostic(file string, err error) Diagnostic {
+	d := Diagnostic{File: file, Line: 1, Column: 1, Message: err.Error()}
+	if m := syntaxLineRe.FindStringSubmatch(err.Error()); m != nil {
+		d.Line, _ = strconv.Atoi(m[1])
+		if m[2] != "" {
+			d.Column, _ = strconv.Atoi(m[2])
+		}
+	}
+	return d
+}
+
+func containsFold(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// suggest returns a hint naming the closest of names to name, if it is close
+// enough to be a typo.
+func suggest(name string, names []string) string {
+	best, bestDistance := "", 3
+	for _, n := range names {
+		if d := levenshtein(strings.ToLower(name), strings.ToLower(n)); d < bestDistance {
+			best, bestDistance = n, d
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %q?", best)
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,170 @@
+package resource
+
+import (
+	"strings"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	mockclient "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func lint(t *testing.T, linter *Linter, files map[string]string) []string {
+	t.Helper()
+	for name, content := range files {
+		require.NoError(t, linter.AddFile(name, strings.NewReader(content)))
+	}
+	var result []string
+	for _, d := range linter.Lint() {
+		result = append(result, d.String())
+	}
+	return result
+}
+
+func TestLintYAML(t *testing.T) {
+	checks := `type: CheckConfig
+metadata:
+  name: check-cpu
+spec:
+  command: check-cpu.sh
+  intervall: 60
+  subscriptions: [linux]
+---
+type: CheckConfig
+metadata:
+  name: check-mem
+spec:
+  command: check-mem.sh
+  cron: "61 * * * *"
+  subscriptions: [linux]
+  subdue:
+    days:
+      all:
+      - begin: 25:00
+        end: 1:00
+---
+type: CheckConfig
+metadata:
+  name: check-disk
+spec:
+  command: check-disk.sh
+  interval: sixty
+---
+type: CheckConfig
+metadata:
+  name: check-load
+spec:
+  command: check-load.sh
+`
+	diagnostics := lint(t, &Linter{Namespace: "default"}, map[string]string{"checks.yml": checks})
+	assert.Equal(t, []string{
+		`checks.yml:6:3: unknown field "intervall" in spec, did you mean "interval"?`,
+		`checks.yml:14:9: invalid cron schedule "61 * * * *": end of range (61) above maximum (59): 61`,
+		`checks.yml:17:5: invalid subdue: parsing time "25:00": hour out of range`,
+		`checks.yml:27:13: expected a value of type uint32, got string`,
+		`checks.yml:31:9: invalid CheckConfig: check interval must be greater than 0 or a valid cron schedule must be provided`,
+	}, diagnostics)
+}
+
+func TestLintJSON(t *testing.T) {
+	filters := `{
+  "type": "EventFilter",
+  "metadata": {"name": "production"},
+  "spec": {
+    "action": "allow",
+    "expressions": ["event.entity.labels.env == 'production'", "event.check.status = = 1"]
+  }
+}
+{"type": "Mutator", "metadata": {"name": "js"}, "spec": {"type": "javascript", "eval": "data = ;"}}
+`
+	diagnostics := lint(t, &Linter{Namespace: "default"}, map[string]string{"filters.json": filters})
+	assert.Equal(t, []string{
+		`filters.json:6:64: invalid javascript expression: (anonymous): Line 1:22 Unexpected token =`,
+		`filters.json:9:88: invalid javascript: (anonymous): Line 1:8 Unexpected token ;`,
+	}, diagnostics)
+}
+
+func TestLintSyntaxError(t *testing.T) {
+	diagnostics := lint(t, &Linter{}, map[string]string{
+		"bad.yml": "type: CheckConfig\nspec:\n  command: [oops\n",
+	})
+	require.Len(t, diagnostics, 1)
+	assert.True(t, strings.HasPrefix(diagnostics[0], "bad.yml:2:"), diagnostics[0])
+
+	diagnostics = lint(t, &Linter{}, map[string]string{
+		"bad.json": "{\"type\": \"CheckConfig\",\n\"spec\": {]}",
+	})
+	require.Len(t, diagnostics, 1)
+	assert.True(t, strings.HasPrefix(diagnostics[0], "bad.json:2:"), diagnostics[0])
+}
+
+func TestLintUnknownTopLevelField(t *testing.T) {
+	diagnostics := lint(t, &Linter{Namespace: "default"}, map[string]string{
+		"ns.yml": "type: Namespace\nspecs:\n  name: foo\n",
+	})
+	assert.Equal(t, []string{
+		`ns.yml:1:1: missing spec`,
+		`ns.yml:2:1: unknown field "specs", did you mean "spec"?`,
+	}, diagnostics)
+}
+
+var referencing = `type: CheckConfig
+metadata:
+  name: check-cpu
+spec:
+  command: check-cpu.sh
+  interval: 60
+  subscriptions: [linux]
+  handlers: [slack, pagerduty]
+  runtime_assets: [cpu-asset]
+  pipelines:
+  - type: Pipeline
+    api_version: core/v2
+    name: incidents
+---
+type: Handler
+metadata:
+  name: slack
+spec:
+  type: pipe
+  command: slack.sh
+  filters: [is_incident, not_silenced, business-hours]
+  mutator: only_check_output
+`
+
+func TestLintReferences(t *testing.T) {
+	asset := `type: Asset
+metadata:
+  name: cpu-asset
+spec:
+  url: http://example.com/cpu.tar.gz
+  sha512: ` + strings.Repeat("a", 128) + `
+`
+	diagnostics := lint(t, &Linter{Namespace: "default"}, map[string]string{
+		"checks.yml": referencing,
+		"assets.yml": asset,
+	})
+	assert.Equal(t, []string{
+		`checks.yml:8:21: Handler "pagerduty" is not defined`,
+		`checks.yml:13:11: Pipeline "incidents" is not defined`,
+		`checks.yml:21:40: EventFilter "business-hours" is not defined`,
+	}, diagnostics)
+}
+
+func TestLintRemoteReferences(t *testing.T) {
+	c := &mockclient.MockClient{}
+	pagerduty := &corev2.Handler{ObjectMeta: corev2.ObjectMeta{Name: "pagerduty", Namespace: "default"}}
+	c.On("Get", pagerduty.URIPath(), mock.Anything).Return(nil)
+	c.On("Get", mock.Anything, mock.Anything).Return(notFound)
+
+	diagnostics := lint(t, &Linter{Namespace: "default", Remote: c}, map[string]string{
+		"checks.yml": referencing,
+	})
+	assert.Equal(t, []string{
+		`checks.yml:9:20: Asset "cpu-asset" is neither defined nor found on the server`,
+		`checks.yml:13:11: Pipeline "incidents" is neither defined nor found on the server`,
+		`checks.yml:21:40: EventFilter "business-hours" is neither defined nor found on the server`,
+	}, diagnostics)
+}
@@ -71,8 +71,27 @@ func process(client *http.Client, input string, recurse bool) ([]*types.Wrapper,
 // ProcessFile processes a file.
 func ProcessFile(input string, recurse bool) ([]*types.Wrapper, error) {
 	var resources []*types.Wrapper
+	err := walkFiles(input, recurse, func(path string) error {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		res, err := Parse(f)
+		if err != nil {
+			return fmt.Errorf("in %s: %s", input, err)
+		}
+		resources = append(resources, res...)
+		return nil
+	})
+	return resources, err
+}
+
+// walkFiles calls fn with the path of input if it is a file, or of the files
+// it contains if it is a directory, following subdirectories if recurse is
+// true.
+func walkFiles(input string, recurse bool, fn func(path string) error) error {
 	var tld = true
-	err := filepath.Walk(input, func(path string, info os.FileInfo, err error) error {
+	return filepath.Walk(input, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -95,18 +114,8 @@ func ProcessFile(input string, recurse bool) ([]*types.Wrapper, error) {
 			tld = false
 			return nil
 		}
-		f, err := os.Open(path)
-		if err != nil {
-			return err
-		}
-		res, err := Parse(f)
-		if err != nil {
-			return fmt.Errorf("in %s: %s", input, err)
-		}
-		resources = append(resources, res...)
-		return nil
+		return fn(path)
 	})
-	return resources, err
 }
 
 // ProcessURL processes a url.
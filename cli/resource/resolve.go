@@ -22,6 +22,7 @@ var (
 		&corev2.TessenConfig{},
 		&corev2.Asset{},
 		&corev2.CheckConfig{},
+		&corev2.CheckOverride{},
 		&corev2.Entity{},
 		&corev2.Event{},
 		&corev2.EventFilter{},
@@ -29,6 +30,7 @@ var (
 		&corev2.HookConfig{},
 		&corev2.Mutator{},
 		&corev2.Pipeline{},
+		&corev2.Quota{},
 		&corev2.Role{},
 		&corev2.RoleBinding{},
 		&corev2.Silenced{},
@@ -25,6 +25,8 @@ func main() {
 	rootCmd.AddCommand(cmd.VersionCommand())
 	rootCmd.AddCommand(cmd.InitCommand())
 	rootCmd.AddCommand(cmd.UpgradeCommand())
+	rootCmd.AddCommand(cmd.BackupCommand())
+	rootCmd.AddCommand(cmd.RestoreCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		if err == seeds.ErrAlreadyInitialized {
@@ -94,6 +94,10 @@ type ExecutionRequest struct {
 
 	// InProgressMu is the mutex for the InProgress map.
 	InProgressMu *sync.Mutex
+
+	// Sandbox describes the resource limits and the isolation applied to the
+	// command process, if any.
+	Sandbox *Sandbox
 }
 
 // ExecutionResponse provides the response information of an ExecutionRequest.
@@ -169,11 +173,30 @@ func (e *ExecutionRequest) Execute(ctx context.Context, execution ExecutionReque
 		timer.Stop()
 		timer = time.NewTimer(time.Duration(execution.Timeout) * time.Second)
 	}
+	var releaseSandbox func() error
+	if execution.Sandbox != nil {
+		release, err := prepareSandbox(cmd, execution.Sandbox)
+		if err != nil {
+			return resp, fmt.Errorf("could not prepare the sandbox: %s", err)
+		}
+		releaseSandbox = release
+	}
 	if err := cmd.Start(); err != nil {
+		if releaseSandbox != nil {
+			_ = releaseSandbox()
+		}
 		// Something unexpected happened when attempting to
 		// fork/exec, return immediately.
 		return resp, err
 	}
+	if releaseSandbox != nil {
+		if err := releaseSandbox(); err != nil {
+			// Never run the command outside of its sandbox
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return resp, fmt.Errorf("could not sandbox the command: %s", err)
+		}
+	}
 
 	waitCh := make(chan struct{})
 	var err error
@@ -0,0 +1,26 @@
+package command
+
+// Sandbox describes the resource limits and the isolation applied to the
+// process of a command execution. It is only enforced on Linux.
+type Sandbox struct {
+	// CPUTime is the maximum CPU time of the process, in seconds.
+	CPUTime uint64
+
+	// Memory is the maximum size of the virtual memory of the process, in
+	// bytes.
+	Memory uint64
+
+	// OpenFiles is the maximum number of files the process can open.
+	OpenFiles uint64
+
+	// User is the name or the ID of the user the process runs as.
+	User string
+
+	// Group is the name or the ID of the group the process runs as, which
+	// defaults to the primary group of the user.
+	Group string
+
+	// Cgroup is the path of the cgroup v2, relative to the root of the cgroup
+	// hierarchy, the process is placed in.
+	Cgroup string
+}
@@ -0,0 +1,157 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// cgroupRoot is the mount point of the cgroup v2 hierarchy
+var cgroupRoot = "/sys/fs/cgroup"
+
+// sandboxGate makes the shell wait until the sandbox is applied to its process
+// before running the command. Resource limits and cgroups can only be applied
+// to a running process, so the shell reads from an additional file descriptor
+// that gets written to once the process is sandboxed.
+const sandboxGate = "read -r _ <&3; exec 3<&-; "
+
+// prepareSandbox configures the command to run as the user and the group of the
+// sandbox, and to wait for the sandbox to be applied. The returned function
+// applies the sandbox to the started process and releases it, or only closes
+// the gate if the process did not start.
+func prepareSandbox(cmd *exec.Cmd, sandbox *Sandbox) (func() error, error) {
+	credential, err := sandboxCredential(sandbox)
+	if err != nil {
+		return nil, err
+	}
+	if credential != nil {
+		if cmd.SysProcAttr == nil {
+			cmd.SysProcAttr = &syscall.SysProcAttr{}
+		}
+		cmd.SysProcAttr.Credential = credential
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	cmd.ExtraFiles = append(cmd.ExtraFiles, r)
+	cmd.Args[len(cmd.Args)-1] = sandboxGate + cmd.Args[len(cmd.Args)-1]
+
+	return func() error {
+		// The child process holds its own copy of the read end
+		_ = r.Close()
+		defer w.Close()
+		if cmd.Process == nil {
+			return nil
+		}
+		if err := applySandbox(cmd.Process.Pid, sandbox); err != nil {
+			return err
+		}
+		_, err := w.Write([]byte("\n"))
+		return err
+	}, nil
+}
+
+// sandboxCredential resolves the user and the group of the sandbox
+func sandboxCredential(sandbox *Sandbox) (*syscall.Credential, error) {
+	if sandbox.User == "" {
+		return nil, nil
+	}
+	u, err := lookupUser(sandbox.User)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uid for user %q: %s", sandbox.User, err)
+	}
+	gidStr := u.Gid
+	if sandbox.Group != "" {
+		g, err := lookupGroup(sandbox.Group)
+		if err != nil {
+			return nil, err
+		}
+		gidStr = g.Gid
+	}
+	gid, err := strconv.ParseUint(gidStr, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gid for group %q: %s", sandbox.Group, err)
+	}
+	return &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)}, nil
+}
+
+func lookupUser(name string) (*user.User, error) {
+	u, err := user.Lookup(name)
+	if err == nil {
+		return u, nil
+	}
+	if _, convErr := strconv.Atoi(name); convErr == nil {
+		return user.LookupId(name)
+	}
+	return nil, err
+}
+
+func lookupGroup(name string) (*user.Group, error) {
+	g, err := user.LookupGroup(name)
+	if err == nil {
+		return g, nil
+	}
+	if _, convErr := strconv.Atoi(name); convErr == nil {
+		return user.LookupGroupId(name)
+	}
+	return nil, err
+}
+
+// applySandbox sets the resource limits of the process and places it in the
+// cgroup of the sandbox
+func applySandbox(pid int, sandbox *Sandbox) error {
+	limits := []struct {
+		resource int
+		value    uint64
+		name     string
+	}{
+		{unix.RLIMIT_CPU, sandbox.CPUTime, "cpu time"},
+		{unix.RLIMIT_AS, sandbox.Memory, "memory"},
+		{unix.RLIMIT_NOFILE, sandbox.OpenFiles, "open files"},
+	}
+	for _, limit := range limits {
+		if limit.value == 0 {
+			continue
+		}
+		rlimit := &unix.Rlimit{Cur: limit.value, Max: limit.value}
+		if err := prlimit(pid, limit.resource, rlimit); err != nil {
+			return fmt.Errorf("could not limit the %s: %s", limit.name, err)
+		}
+	}
+
+	if sandbox.Cgroup != "" {
+		procs := filepath.Join(cgroupRoot, sandbox.Cgroup, "cgroup.procs")
+		f, err := os.OpenFile(procs, os.O_WRONLY, 0)
+		if err != nil {
+			return fmt.Errorf("could not place the process in cgroup %q: %s", sandbox.Cgroup, err)
+		}
+		defer f.Close()
+		if _, err := f.WriteString(strconv.Itoa(pid)); err != nil {
+			return fmt.Errorf("could not place the process in cgroup %q: %s", sandbox.Cgroup, err)
+		}
+	}
+
+	return nil
+}
+
+// prlimit sets the resource limit of another process
+func prlimit(pid int, resource int, rlimit *unix.Rlimit) error {
+	_, _, errno := unix.RawSyscall6(unix.SYS_PRLIMIT64, uintptr(pid), uintptr(resource), uintptr(unsafe.Pointer(rlimit)), 0, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
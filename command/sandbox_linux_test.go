@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteSandboxLimits(t *testing.T) {
+	execution := ExecutionRequest{
+		Command: "ulimit -n; ulimit -t",
+		Sandbox: &Sandbox{OpenFiles: 32, CPUTime: 5},
+	}
+
+	resp, err := execution.Execute(context.Background(), execution)
+	require.NoError(t, err)
+	assert.Equal(t, 0, resp.Status)
+	assert.Equal(t, "32\n5\n", resp.Output)
+}
+
+func TestExecuteSandboxCPUTime(t *testing.T) {
+	execution := ExecutionRequest{
+		Command: "while :; do :; done",
+		Timeout: 10,
+		Sandbox: &Sandbox{CPUTime: 1},
+	}
+
+	resp, err := execution.Execute(context.Background(), execution)
+	require.NoError(t, err)
+	// The shell is killed by SIGXCPU before the execution times out
+	assert.NotEqual(t, TimeoutExitStatus, resp.Status)
+	assert.Less(t, resp.Duration, float64(10))
+}
+
+func TestExecuteSandboxStdin(t *testing.T) {
+	execution := ExecutionRequest{
+		Command: "cat",
+		Input:   "foo",
+		Sandbox: &Sandbox{OpenFiles: 32},
+	}
+
+	resp, err := execution.Execute(context.Background(), execution)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", resp.Output)
+}
+
+func TestExecuteSandboxCgroup(t *testing.T) {
+	root, err := ioutil.TempDir("", "cgroup")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	defer func(orig string) { cgroupRoot = orig }(cgroupRoot)
+	cgroupRoot = root
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "sensu"), 0755))
+	procs := filepath.Join(root, "sensu", "cgroup.procs")
+	require.NoError(t, ioutil.WriteFile(procs, nil, 0644))
+
+	execution := ExecutionRequest{
+		Command: "echo $$",
+		Sandbox: &Sandbox{Cgroup: "sensu"},
+	}
+	resp, err := execution.Execute(context.Background(), execution)
+	require.NoError(t, err)
+	b, err := ioutil.ReadFile(procs)
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(resp.Output), string(b))
+
+	// The command never runs outside of its sandbox
+	execution.Sandbox.Cgroup = "missing"
+	execution.Command = "echo foo"
+	resp, err = execution.Execute(context.Background(), execution)
+	assert.Error(t, err)
+	assert.Empty(t, resp.Output)
+}
+
+func TestExecuteSandboxUser(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("dropping privileges requires root")
+	}
+	nobody, err := user.Lookup("nobody")
+	if err != nil {
+		t.Skip("user nobody does not exist")
+	}
+
+	execution := ExecutionRequest{
+		Command: "id -u",
+		Sandbox: &Sandbox{User: "nobody"},
+	}
+	resp, err := execution.Execute(context.Background(), execution)
+	require.NoError(t, err)
+	assert.Equal(t, nobody.Uid+"\n", resp.Output)
+
+	execution.Sandbox.User = "sensu-missing-user"
+	_, err = execution.Execute(context.Background(), execution)
+	assert.Error(t, err)
+}
@@ -0,0 +1,12 @@
+// +build !linux
+
+package command
+
+import (
+	"os/exec"
+)
+
+// prepareSandbox is a no-op, since sandboxes are only supported on Linux
+func prepareSandbox(cmd *exec.Cmd, sandbox *Sandbox) (func() error, error) {
+	return func() error { return nil }, nil
+}
@@ -0,0 +1,19 @@
+package mockstore
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// CreateHandlerExecution ...
+func (s *MockStore) CreateHandlerExecution(ctx context.Context, execution *corev2.HandlerExecution, ttl int64) error {
+	args := s.Called(ctx, execution, ttl)
+	return args.Error(0)
+}
+
+// GetHandlerExecutions ...
+func (s *MockStore) GetHandlerExecutions(ctx context.Context, entityName, checkName string) ([]*corev2.HandlerExecution, error) {
+	args := s.Called(ctx, entityName, checkName)
+	return args.Get(0).([]*corev2.HandlerExecution), args.Error(1)
+}
@@ -0,0 +1,19 @@
+package mockstore
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// CheckQuota ...
+func (s *MockStore) CheckQuota(ctx context.Context, namespace, resource string) error {
+	args := s.Called(ctx, namespace, resource)
+	return args.Error(0)
+}
+
+// GetQuotaUsage ...
+func (s *MockStore) GetQuotaUsage(ctx context.Context, namespace string) (*corev2.QuotaUsage, error) {
+	args := s.Called(ctx, namespace)
+	return args.Get(0).(*corev2.QuotaUsage), args.Error(1)
+}
@@ -8,6 +8,13 @@ import (
 	"github.com/sensu/sensu-go/backend/store/patch"
 )
 
+// ApplyResources ...
+func (s *MockStore) ApplyResources(ctx context.Context, resources []corev2.Resource, dryRun bool) ([]store.ApplyAction, error) {
+	args := s.Called(ctx, resources, dryRun)
+	actions, _ := args.Get(0).([]store.ApplyAction)
+	return actions, args.Error(1)
+}
+
 // CreateResource ...
 func (s *MockStore) CreateResource(ctx context.Context, resource corev2.Resource) error {
 	args := s.Called(ctx, resource)
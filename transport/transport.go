@@ -33,6 +33,11 @@ const (
 	// MessageTypeEntityConfig is the message type sent for entity config updates
 	MessageTypeEntityConfig = "entity_config"
 
+	// MessageTypeAssetPrefetch is the message type sent for the assets required
+	// by the checks targeting an agent, which it fetches ahead of their
+	// execution
+	MessageTypeAssetPrefetch = "asset_prefetch"
+
 	// HeaderKeyAgentName is the HTTP request header specifying the Agent name
 	HeaderKeyAgentName = "Sensu-AgentName"
 